@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/andreychh/tgen/meta"
+	"github.com/spf13/cobra"
+)
+
+// NewBatchCommand returns the "batch" subcommand, which runs several of the
+// other subcommands in one process. Every argument is one invocation, written
+// as it would follow "tgen" on its own and split into words as a shell splits
+// them, so that a path holding spaces is quoted as it would be on its own:
+//
+//	tgen batch "go -s api.html -o ./go" "pythonv2 -s 'Bot API.html' -o ./py"
+//
+// Every invocation shares runs, so a page several of them read is fetched and
+// run through the chain once, however many targets render it. The invocations
+// run in the order given, and the first to fail stops the batch. A batch given
+// --deterministic-errors hands it on to every invocation.
+func NewBatchCommand(m meta.Meta, runs Runs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "batch <invocation>...",
+		Short: "Run several subcommands over one run of the pipeline",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return batchAction(cmd, args, m, runs)
+		},
+	}
+}
+
+func batchAction(cmd *cobra.Command, args []string, m meta.Meta, runs Runs) error {
+	for _, invocation := range args {
+		words, err := split(invocation)
+		if err != nil {
+			return fmt.Errorf("reading %q: %w", invocation, err)
+		}
+		deterministic, err := cmd.Flags().GetBool(deterministicErrors)
+		if err != nil {
+			return err
+		}
+		if deterministic {
+			words = append([]string{"--" + deterministicErrors}, words...)
+		}
+		root := newRootCommand(m, runs)
+		root.SetArgs(words)
+		root.SetOut(cmd.OutOrStdout())
+		root.SetErr(cmd.ErrOrStderr())
+		root.SilenceErrors = true
+		root.SilenceUsage = true
+		err = root.Execute()
+		if err != nil {
+			return fmt.Errorf("running %q: %w", invocation, err)
+		}
+	}
+	return nil
+}
+
+// split returns the words of invocation as a POSIX shell splits them: on
+// unquoted blanks, with single quotes keeping everything they enclose, double
+// quotes keeping everything but a backslash escaping a quote or a backslash,
+// and a backslash outside quotes keeping the character after it. It fails on a
+// quote left open or a backslash ending the invocation.
+func split(invocation string) ([]string, error) {
+	s := splitter{runes: []rune(invocation), at: 0}
+	var words []string
+	for {
+		s.blanks()
+		if s.done() {
+			return words, nil
+		}
+		word, err := s.word()
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, word)
+	}
+}
+
+// splitter reads the words of one invocation a rune at a time.
+type splitter struct {
+	runes []rune
+	at    int
+}
+
+// done reports whether every rune has been read.
+func (s *splitter) done() bool {
+	return s.at == len(s.runes)
+}
+
+// next returns the rune at hand and moves past it.
+func (s *splitter) next() rune {
+	r := s.runes[s.at]
+	s.at++
+	return r
+}
+
+// blanks moves past the blanks separating two words.
+func (s *splitter) blanks() {
+	for !s.done() && unicode.IsSpace(s.runes[s.at]) {
+		s.at++
+	}
+}
+
+// word returns the word at hand, up to the first unquoted blank.
+func (s *splitter) word() (string, error) {
+	var b strings.Builder
+	for !s.done() && !unicode.IsSpace(s.runes[s.at]) {
+		var err error
+		switch r := s.next(); r {
+		case '\'':
+			err = s.quoted(&b, '\'', "")
+		case '"':
+			err = s.quoted(&b, '"', `"\`)
+		case '\\':
+			if s.done() {
+				return "", errors.New("backslash ending the invocation")
+			}
+			b.WriteRune(s.next())
+		default:
+			b.WriteRune(r)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// quoted writes to b everything up to the quote closing the one just read, a
+// backslash keeping the character after it when that is one of escapable.
+func (s *splitter) quoted(b *strings.Builder, quote rune, escapable string) error {
+	for !s.done() {
+		r := s.next()
+		switch {
+		case r == quote:
+			return nil
+		case r == '\\' && !s.done() && strings.ContainsRune(escapable, s.runes[s.at]):
+			b.WriteRune(s.next())
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return fmt.Errorf("%c quote left open", quote)
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchCommand(t *testing.T) {
+	t.Run("runs every invocation over the page read once", func(t *testing.T) {
+		dir := t.TempDir()
+		location := filepath.Join(dir, "api.html")
+		require.NoError(t, os.WriteFile(location, page(t), 0o600))
+		runs := NewRuns()
+		_, err := runs.Page(location)
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(location))
+		root := newRootCommand(snapshot().Meta(), runs)
+		root.SetErr(io.Discard)
+		root.SetArgs([]string{
+			"batch",
+			"graph -s " + location + " -f json -o " + filepath.Join(dir, "graph.json"),
+			"go -s " + location + " -o " + filepath.Join(dir, "go"),
+		})
+		require.NoError(t, root.Execute(), "a batch must run over a page it has read even once the page is gone")
+		assert.FileExists(t, filepath.Join(dir, "graph.json"), "a batch must run its first invocation")
+		assert.FileExists(t, filepath.Join(dir, "go", "api.go"), "a batch must run its second invocation")
+	})
+	t.Run("reads a quoted path holding spaces as one word", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "Bot API")
+		require.NoError(t, os.Mkdir(dir, 0o700))
+		location := filepath.Join(dir, "api page.html")
+		require.NoError(t, os.WriteFile(location, page(t), 0o600))
+		root := newRootCommand(snapshot().Meta(), NewRuns())
+		root.SetErr(io.Discard)
+		root.SetArgs([]string{
+			"batch",
+			`graph -s "` + location + `" -f json -o '` + filepath.Join(dir, "graph out.json") + `'`,
+		})
+		require.NoError(t, root.Execute(), "a batch must read a quoted path as one argument")
+		assert.FileExists(t, filepath.Join(dir, "graph out.json"), "a batch must write to a quoted path holding spaces")
+	})
+	t.Run("hands --deterministic-errors on to every invocation", func(t *testing.T) {
+		location := filepath.Join(t.TempDir(), "api.html")
+		broken := breakRow(t, breakRow(t, page(t), "is_bot", "Boolean"), "title", "String")
+		require.NoError(t, os.WriteFile(location, broken, 0o600))
+		root := newRootCommand(snapshot().Meta(), NewRuns())
+		root.SetOut(io.Discard)
+		root.SetErr(io.Discard)
+		root.SetArgs([]string{"--deterministic-errors", "batch", "graph -s " + location})
+		assert.ErrorContains(t, root.Execute(), "{chat title}",
+			"an invocation of a batch given --deterministic-errors must run the keyed chain")
+	})
+	t.Run("stops at the invocation that fails and names it", func(t *testing.T) {
+		root := newRootCommand(snapshot().Meta(), NewRuns())
+		root.SetOut(io.Discard)
+		root.SetErr(io.Discard)
+		root.SetArgs([]string{"batch", "graph -s testdata/corpus/synthetic-10.2/page.html -f svg"})
+		err := root.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `running "graph -s testdata/corpus/synthetic-10.2/page.html -f svg"`,
+			"a batch must name the invocation that failed")
+	})
+}
+
+func TestSplit(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "splits on blanks", in: "go  -s api.html\t-o ./go", want: []string{"go", "-s", "api.html", "-o", "./go"}},
+		{name: "keeps a single-quoted word whole", in: `-s 'Bot API.html'`, want: []string{"-s", "Bot API.html"}},
+		{name: "keeps a double-quoted word whole", in: `-s "Bot API.html"`, want: []string{"-s", "Bot API.html"}},
+		{name: "joins quoted and bare parts of one word", in: `-o ./"my bots"/go`, want: []string{"-o", "./my bots/go"}},
+		{name: "escapes a blank outside quotes", in: `-s Bot\ API.html`, want: []string{"-s", "Bot API.html"}},
+		{name: "escapes a quote inside double quotes", in: `"say \"hi\""`, want: []string{`say "hi"`}},
+		{name: "keeps a backslash inside single quotes", in: `'C:\bots'`, want: []string{`C:\bots`}},
+		{name: "keeps an empty quoted word", in: `--namespace ""`, want: []string{"--namespace", ""}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := split(tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got, "split must read an invocation as a shell splits it")
+		})
+	}
+}
+
+func TestSplit_unterminated(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+	}{
+		{name: "fails on a single quote left open", in: `-s 'api.html`},
+		{name: "fails on a double quote left open", in: `-s "api.html`},
+		{name: "fails on a backslash ending the invocation", in: `-s api.html\`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := split(tc.in)
+			assert.Error(t, err, "split must fail on an invocation a shell would not finish reading")
+		})
+	}
+}
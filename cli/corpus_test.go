@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/meta"
+	legacy "github.com/andreychh/tgen/model/ir"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/pipeline/parsed"
+	"github.com/andreychh/tgen/model/spec/gq"
+	"github.com/andreychh/tgen/model/spec/overlays"
+	"github.com/andreychh/tgen/output"
+	"github.com/andreychh/tgen/parity"
+	"github.com/andreychh/tgen/registry"
+	"github.com/andreychh/tgen/targets"
+	"github.com/andreychh/tgen/targets/csharp"
+	"github.com/andreychh/tgen/targets/golang"
+	"github.com/andreychh/tgen/targets/graph"
+	"github.com/andreychh/tgen/targets/kotlin"
+	"github.com/andreychh/tgen/targets/proto"
+	"github.com/andreychh/tgen/targets/python"
+	"github.com/andreychh/tgen/targets/pythonv2"
+	"github.com/andreychh/tgen/targets/swift"
+)
+
+//nolint:gochecknoglobals // The flag is how go test is told to rewrite the corpus.
+var update = flag.Bool("update", false, "rewrite the golden files of the corpus from the current output")
+
+// corpus is the directory holding one directory per page the generator is
+// checked against: the page, and under golden what every stage and every target
+// made of it when the files were last rewritten.
+//
+// The pages there now are synthetic: a reduced set of objects and methods laid
+// out as the documentation is, each in a directory named synthetic- and the
+// release it imitates, so that no one reads its golden files as the output for
+// that release. They pin what tgen makes of the layouts and phrasings they hold
+// and of nothing else, so a regression only a real page would show goes
+// unnoticed until a page archived from a release is put in a directory named
+// after that release alone.
+const corpus = "testdata/corpus"
+
+// pinned is the binary metadata the golden files are rendered with. A header
+// names the tgen build that wrote it, which differs on every build; the corpus
+// pins it to a build that names nothing, so that only a change to what is
+// generated changes a golden file.
+type pinned struct{}
+
+func (pinned) Get(string) (string, bool) {
+	return "", false
+}
+
+// snapshot is the moment the golden files claim to have been rendered at.
+func snapshot() meta.Snapshot {
+	return meta.NewSnapshotAt(meta.NewMeta(pinned{}), time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// dump writes every table spec holds, one record per line in the order the
+// table iterates them, and every other field of spec as it is.
+func dump(spec any) string {
+	var b strings.Builder
+	value := reflect.ValueOf(spec)
+	for at := range value.NumField() {
+		_, _ = fmt.Fprintf(&b, "# %s\n", value.Type().Field(at).Name)
+		table := each(value.Field(at), func(key, record any) {
+			_, _ = fmt.Fprintf(&b, "%v: %+v\n", key, record)
+		})
+		if !table {
+			_, _ = fmt.Fprintf(&b, "%+v\n", value.Field(at).Interface())
+		}
+	}
+	return b.String()
+}
+
+// render writes into dir what every stage the corpus covers and every target
+// makes of content: the tables of the first pass, the records of the last
+// stage, where those records disagree with the legacy chain, and the files of
+// every target under a directory of its own.
+func render(t *testing.T, content []byte, dir string) {
+	t.Helper()
+	doc := document(t, content)
+	page, err := parsed.NewPage(doc).Specification()
+	require.NoError(t, err, "a page of the corpus must parse")
+	spec, err := NewPipeline(doc).Specification()
+	require.NoError(t, err, "a page of the corpus must pass through the chain")
+	records := ir.NewSpecification(spec)
+	definitions, err := records.Definitions()
+	require.NoError(t, err, "a page of the corpus must read as records")
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "%+v\n", records.Release())
+	for _, definition := range definitions {
+		_, _ = fmt.Fprintf(&b, "%T %+v\n", definition, definition)
+	}
+	at := snapshot()
+	dist, err := pythonv2.NewDistribution("telegram-bot-api")
+	require.NoError(t, err, "the corpus distribution must be a project name")
+	renderers := map[string]func() (output.Artifacts, error){
+		"go": golang.NewFacade(golang.NewPass(golang.NewGeneration(
+			golang.NewSpecification(records), "api", golang.Reflection, targets.NewSnapshot(at),
+		))).Artifacts,
+		"go-explicit": golang.NewPass(golang.NewGeneration(
+			golang.NewSpecification(records), "api", golang.Explicit, targets.NewSnapshot(at),
+		)).Artifacts,
+		"pythonv2": pythonv2.NewPass(pythonv2.NewGeneration(
+			pythonv2.NewSpecification(records), pythonv2.Pydantic, targets.NewSnapshot(at),
+		)).Artifacts,
+		"pythonv2-dataclasses": pythonv2.NewWheel(pythonv2.NewPass(pythonv2.NewGeneration(
+			pythonv2.NewSpecification(records), pythonv2.Dataclasses, targets.NewSnapshot(at),
+		)), dist).Artifacts,
+		"pythonv2-msgspec": pythonv2.NewWheel(pythonv2.NewPass(pythonv2.NewGeneration(
+			pythonv2.NewSpecification(records), pythonv2.Msgspec, targets.NewSnapshot(at),
+		)), dist).Artifacts,
+		"kotlin": kotlin.NewPass(kotlin.NewGeneration(
+			kotlin.NewSpecification(records), "api", targets.NewSnapshot(at),
+		)).Artifacts,
+		"csharp": csharp.NewPass(csharp.NewGeneration(
+			csharp.NewSpecification(records), "Api", targets.NewSnapshot(at),
+		)).Artifacts,
+		"swift": swift.NewPass(swift.NewGeneration(
+			swift.NewSpecification(records), targets.NewSnapshot(at),
+		)).Artifacts,
+		"proto": proto.NewPass(proto.NewGeneration(
+			proto.NewSpecification(records, registry.New()), "telegram", targets.NewSnapshot(at),
+		)).Artifacts,
+		"python": python.NewPass(
+			legacy.NewSpecification(overlays.NewSpecification(gq.NewSpecificationFromDocument(doc))), at,
+		).Artifacts,
+	}
+	old, err := parity.NewLegacy(legacy.NewSpecification(overlays.NewSpecification(gq.NewSpecificationFromDocument(doc)))).Facts()
+	require.NoError(t, err, "the legacy chain must read a page of the corpus")
+	current, err := parity.NewNanopass(records).Facts()
+	require.NoError(t, err, "the records of a page of the corpus must read as facts")
+	for name, artifacts := range renderers {
+		files, err := artifacts()
+		require.NoError(t, err, "the %s target must render a page of the corpus", name)
+		require.NoError(t, output.NewFileset(files).Emit(filepath.Join(dir, name)),
+			"the %s target must write its files", name)
+	}
+	require.NoError(t, output.NewFileset(output.Artifacts{
+		"parsed.txt":       text(dump(page)),
+		"ir.txt":           text(b.String()),
+		"graph/graph.json": graph.NewJSON(graph.NewGraph(spec)),
+		"parity.txt":       parity.NewReport(old, current),
+	}).Emit(dir), "the stages must write their dumps")
+}
+
+// text is a view of a dump written as it is.
+type text string
+
+func (s text) Render(w io.Writer) error {
+	_, err := io.WriteString(w, string(s))
+	return err
+}
+
+// files reads every file under dir, keyed by its path relative to dir.
+func files(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	out := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relative, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		out[filepath.ToSlash(relative)] = string(content)
+		return nil
+	})
+	require.NoError(t, err, "the files under %q must be readable", dir)
+	return out
+}
+
+func TestCorpus(t *testing.T) {
+	releases, err := os.ReadDir(corpus)
+	require.NoError(t, err, "the corpus must be readable")
+	for _, release := range releases {
+		t.Run(release.Name(), func(t *testing.T) {
+			dir := filepath.Join(corpus, release.Name())
+			content, err := os.ReadFile(filepath.Join(dir, "page.html"))
+			require.NoError(t, err, "every release of the corpus must hold its page")
+			rendered := t.TempDir()
+			render(t, content, rendered)
+			golden := filepath.Join(dir, "golden")
+			if *update {
+				require.NoError(t, os.RemoveAll(golden), "the golden files must be removable")
+				require.NoError(t, os.CopyFS(golden, os.DirFS(rendered)), "the golden files must be writable")
+				return
+			}
+			want := files(t, golden)
+			got := files(t, rendered)
+			for path, content := range want {
+				assert.Equal(t, content, got[path], "%s must render as its golden file; rerun with -update if the change is meant", path)
+			}
+			for path := range got {
+				assert.Contains(t, want, path, "%s has no golden file; rerun with -update if the change is meant", path)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andreychh/tgen/meta"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/output"
+	"github.com/andreychh/tgen/targets"
+	"github.com/andreychh/tgen/targets/csharp"
+	"github.com/spf13/cobra"
+)
+
+// NewCSharpCommand returns the "csharp" subcommand. Like the Kotlin one it
+// takes the namespace from the start: a C# namespace is named after whoever
+// owns it, so no default fits a project the way "api" fits a Go directory.
+func NewCSharpCommand(m meta.Meta, runs Runs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "csharp",
+		Short: "Generate C# client code",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return csharpAction(cmd, args, m, runs)
+		},
+	}
+	cmd.Flags().StringP(
+		"spec",
+		"s",
+		"https://core.telegram.org/bots/api",
+		"URL or local path to the Telegram Bot API HTML specification",
+	)
+	cmd.Flags().StringP(
+		"out",
+		"o",
+		"./Api",
+		"Output directory for the generated C# files",
+	)
+	cmd.Flags().StringP(
+		"namespace",
+		"n",
+		"Api",
+		"Namespace the generated C# files declare",
+	)
+	return cmd
+}
+
+func csharpAction(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
+	snapshot := meta.NewSnapshot(m)
+	location := cmd.Flag("spec").Value.String()
+	page, err := runs.Page(location)
+	if err != nil {
+		return err
+	}
+	spec, err := specification(cmd, runs, page)
+	if err != nil {
+		return fmt.Errorf("running the pipeline over %q: %w", location, err)
+	}
+	artifacts, err := csharp.NewPass(
+		csharp.NewGeneration(
+			csharp.NewSpecification(ir.NewSpecification(spec)),
+			cmd.Flag("namespace").Value.String(),
+			targets.NewSnapshot(snapshot),
+		),
+	).Artifacts()
+	if err != nil {
+		return err
+	}
+	out := cmd.Flag("out").Value.String()
+	err = output.NewFileset(artifacts).Emit(out)
+	if err != nil {
+		return fmt.Errorf("generating files in directory %q: %w", out, err)
+	}
+	_, err = fmt.Fprintf(
+		cmd.ErrOrStderr(),
+		"done in %s\n",
+		snapshot.Elapsed().Round(time.Millisecond),
+	)
+	return err
+}
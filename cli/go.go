@@ -4,15 +4,12 @@
 package cli
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/PuerkitoBio/goquery"
 	"github.com/andreychh/tgen/meta"
 	ir "github.com/andreychh/tgen/model/ir/v2"
 	"github.com/andreychh/tgen/output"
-	"github.com/andreychh/tgen/source"
 	"github.com/andreychh/tgen/targets"
 	"github.com/andreychh/tgen/targets/golang"
 	"github.com/spf13/cobra"
@@ -21,12 +18,12 @@ import (
 // NewGoCommand returns the "go" subcommand.
 //
 // TODO #43: Add an option to specify the Go package name.
-func NewGoCommand(m meta.Meta) *cobra.Command {
+func NewGoCommand(m meta.Meta, runs Runs) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "go",
 		Short: "Generate Go client code",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return goAction(cmd, args, m)
+			return goAction(cmd, args, m, runs)
 		},
 	}
 	cmd.Flags().StringP(
@@ -41,34 +38,49 @@ func NewGoCommand(m meta.Meta) *cobra.Command {
 		"./api",
 		"Output directory for the generated Go files",
 	)
+	cmd.Flags().StringP(
+		"marshaling",
+		"m",
+		string(golang.Reflection),
+		"How the types marshal JSON: reflection, leaving it to encoding/json, or explicit, writing every codec out",
+	)
+	cmd.Flags().BoolP(
+		"bot",
+		"b",
+		false,
+		"Also generate a Bot calling every method as a Go method, its BotAPI interface and a MockBot",
+	)
 	return cmd
 }
 
-func goAction(cmd *cobra.Command, _ []string, m meta.Meta) error {
+func goAction(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
 	snapshot := meta.NewSnapshot(m)
 	location := cmd.Flag("spec").Value.String()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-	reader, err := source.NewLocationSource(location).Open(ctx)
+	page, err := runs.Page(location)
 	if err != nil {
-		return fmt.Errorf("opening source %q: %w", location, err)
+		return err
 	}
-	defer func() { _ = reader.Close() }()
-	doc, err := goquery.NewDocumentFromReader(reader)
+	marshaling, err := golang.NewMarshaling(cmd.Flag("marshaling").Value.String())
 	if err != nil {
-		return fmt.Errorf("parsing HTML from %q: %w", location, err)
+		return err
 	}
-	spec, err := NewPipeline(doc).Specification()
+	spec, err := specification(cmd, runs, page)
 	if err != nil {
 		return fmt.Errorf("running the pipeline over %q: %w", location, err)
 	}
-	artifacts, err := golang.NewPass(
+	pass := golang.NewPass(
 		golang.NewGeneration(
 			golang.NewSpecification(ir.NewSpecification(spec)),
 			"api",
+			marshaling,
 			targets.NewSnapshot(snapshot),
 		),
-	).Artifacts()
+	)
+	bot, err := cmd.Flags().GetBool("bot")
+	if err != nil {
+		return fmt.Errorf("reading the bot flag: %w", err)
+	}
+	artifacts, err := goArtifacts(pass, bot)
 	if err != nil {
 		return err
 	}
@@ -84,3 +96,12 @@ func goAction(cmd *cobra.Command, _ []string, m meta.Meta) error {
 	)
 	return err
 }
+
+// goArtifacts returns the files pass renders, with the Bot facade beside them
+// when bot is set.
+func goArtifacts(pass golang.Pass, bot bool) (output.Artifacts, error) {
+	if bot {
+		return golang.NewFacade(pass).Artifacts()
+	}
+	return pass.Artifacts()
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/andreychh/tgen/meta"
+	"github.com/andreychh/tgen/output"
+	"github.com/andreychh/tgen/targets/graph"
+	"github.com/spf13/cobra"
+)
+
+// NewGraphCommand returns the "graph" subcommand.
+func NewGraphCommand(m meta.Meta, runs Runs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Export the dependency graph of the definitions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return graphAction(cmd, args, m, runs)
+		},
+	}
+	cmd.Flags().StringP(
+		"spec",
+		"s",
+		"https://core.telegram.org/bots/api",
+		"URL or local path to the Telegram Bot API HTML specification",
+	)
+	cmd.Flags().StringP(
+		"out",
+		"o",
+		"",
+		"Output file for the graph; standard output when empty",
+	)
+	cmd.Flags().StringP(
+		"format",
+		"f",
+		"dot",
+		"Format of the graph: dot, mermaid or json",
+	)
+	cmd.Flags().String(
+		"focus",
+		"",
+		"Name or reference of the definition to focus on, such as InputMediaPhoto",
+	)
+	cmd.Flags().Int(
+		"depth",
+		1,
+		"Number of edges to walk from the focused definition",
+	)
+	return cmd
+}
+
+func graphAction(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
+	snapshot := meta.NewSnapshot(m)
+	location := cmd.Flag("spec").Value.String()
+	page, err := runs.Page(location)
+	if err != nil {
+		return err
+	}
+	spec, err := specification(cmd, runs, page)
+	if err != nil {
+		return fmt.Errorf("running the pipeline over %q: %w", location, err)
+	}
+	g := graph.NewGraph(spec)
+	if focus := cmd.Flag("focus").Value.String(); focus != "" {
+		depth, err := cmd.Flags().GetInt("depth")
+		if err != nil {
+			return err
+		}
+		ref, err := g.Resolve(focus)
+		if err != nil {
+			return fmt.Errorf("focusing on %q: %w", focus, err)
+		}
+		g, err = g.Focus(ref, depth)
+		if err != nil {
+			return fmt.Errorf("focusing on %q: %w", focus, err)
+		}
+	}
+	view, err := graphView(cmd.Flag("format").Value.String(), g)
+	if err != nil {
+		return err
+	}
+	err = graphEmit(cmd, view)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(
+		cmd.ErrOrStderr(),
+		"done in %s\n",
+		snapshot.Elapsed().Round(time.Millisecond),
+	)
+	return err
+}
+
+// graphView returns the view writing g in the named format.
+func graphView(format string, g graph.Graph) (output.View, error) {
+	switch format {
+	case "dot":
+		return graph.NewDOT(g), nil
+	case "mermaid":
+		return graph.NewMermaid(g), nil
+	case "json":
+		return graph.NewJSON(g), nil
+	default:
+		return nil, fmt.Errorf("unknown graph format %q, want dot, mermaid or json", format)
+	}
+}
+
+// graphEmit writes view to the file the out flag names, or to standard output
+// when it names none.
+func graphEmit(cmd *cobra.Command, view output.View) error {
+	out := cmd.Flag("out").Value.String()
+	if out == "" {
+		return view.Render(cmd.OutOrStdout())
+	}
+	file, err := os.Create(out)
+	if err != nil {
+		return fmt.Errorf("creating file %q: %w", out, err)
+	}
+	defer func() { _ = file.Close() }()
+	err = view.Render(file)
+	if err != nil {
+		return fmt.Errorf("rendering graph to %q: %w", out, err)
+	}
+	return nil
+}
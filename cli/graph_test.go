@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphCommand_focus(t *testing.T) {
+	cases := []struct {
+		name  string
+		focus string
+	}{
+		{name: "focuses on a definition named as the page names it", focus: "InputMediaPhoto"},
+		{name: "focuses on a definition named by its reference", focus: "inputmediaphoto"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			root := newRootCommand(snapshot().Meta(), NewRuns())
+			root.SetOut(&out)
+			root.SetErr(io.Discard)
+			root.SetArgs([]string{
+				"graph", "-s", "testdata/corpus/synthetic-10.2/page.html", "-f", "mermaid", "--focus", tc.focus, "--depth", "1",
+			})
+			require.NoError(t, root.Execute(), "the graph command must resolve the focus against names and references")
+			assert.Contains(t, out.String(), "inputmediaphoto", "the graph command must draw the focused definition")
+			assert.NotContains(t, out.String(), "getme", "the graph command must leave out what lies beyond the depth")
+		})
+	}
+}
+
+func TestGraphCommand_focusUnknown(t *testing.T) {
+	root := newRootCommand(snapshot().Meta(), NewRuns())
+	root.SetOut(io.Discard)
+	root.SetErr(io.Discard)
+	root.SetArgs([]string{"graph", "-s", "testdata/corpus/synthetic-10.2/page.html", "--focus", "NoSuchThing"})
+	assert.ErrorContains(t, root.Execute(), `no definition "NoSuchThing"`,
+		"the graph command must name a focus no definition goes by")
+}
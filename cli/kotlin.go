@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andreychh/tgen/meta"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/output"
+	"github.com/andreychh/tgen/targets"
+	"github.com/andreychh/tgen/targets/kotlin"
+	"github.com/spf13/cobra"
+)
+
+// NewKotlinCommand returns the "kotlin" subcommand. Unlike the Go one it takes
+// the package name from the start: a Kotlin package is named after whoever owns
+// it, so no default fits a project the way "api" fits a Go directory.
+func NewKotlinCommand(m meta.Meta, runs Runs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kotlin",
+		Short: "Generate Kotlin client code",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return kotlinAction(cmd, args, m, runs)
+		},
+	}
+	cmd.Flags().StringP(
+		"spec",
+		"s",
+		"https://core.telegram.org/bots/api",
+		"URL or local path to the Telegram Bot API HTML specification",
+	)
+	cmd.Flags().StringP(
+		"out",
+		"o",
+		"./api",
+		"Output directory for the generated Kotlin files",
+	)
+	cmd.Flags().StringP(
+		"package",
+		"p",
+		"api",
+		"Package the generated Kotlin files declare",
+	)
+	return cmd
+}
+
+func kotlinAction(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
+	snapshot := meta.NewSnapshot(m)
+	location := cmd.Flag("spec").Value.String()
+	page, err := runs.Page(location)
+	if err != nil {
+		return err
+	}
+	spec, err := specification(cmd, runs, page)
+	if err != nil {
+		return fmt.Errorf("running the pipeline over %q: %w", location, err)
+	}
+	artifacts, err := kotlin.NewPass(
+		kotlin.NewGeneration(
+			kotlin.NewSpecification(ir.NewSpecification(spec)),
+			cmd.Flag("package").Value.String(),
+			targets.NewSnapshot(snapshot),
+		),
+	).Artifacts()
+	if err != nil {
+		return err
+	}
+	out := cmd.Flag("out").Value.String()
+	err = output.NewFileset(artifacts).Emit(out)
+	if err != nil {
+		return fmt.Errorf("generating files in directory %q: %w", out, err)
+	}
+	_, err = fmt.Fprintf(
+		cmd.ErrOrStderr(),
+		"done in %s\n",
+		snapshot.Elapsed().Round(time.Millisecond),
+	)
+	return err
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andreychh/tgen/meta"
+	legacy "github.com/andreychh/tgen/model/ir"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/spec/gq"
+	"github.com/andreychh/tgen/model/spec/overlays"
+	"github.com/andreychh/tgen/parity"
+	"github.com/spf13/cobra"
+)
+
+// NewParityCommand returns the "parity" subcommand, which runs the legacy
+// chain behind "python" and the nanopass pipeline behind "pythonv2" over one
+// page and lists where the two disagree. It is how the gaps left before
+// pythonv2 can take the name over are counted.
+//
+// TODO #259: Remove once "python" renders from the nanopass pipeline.
+func NewParityCommand(m meta.Meta, runs Runs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "parity",
+		Short: "Report where the legacy chain and the nanopass pipeline disagree",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return parityAction(cmd, args, m, runs)
+		},
+	}
+	cmd.Flags().StringP(
+		"spec",
+		"s",
+		"https://core.telegram.org/bots/api",
+		"URL or local path to the Telegram Bot API HTML specification",
+	)
+	cmd.Flags().StringP(
+		"out",
+		"o",
+		"",
+		"Output file for the report; standard output when empty",
+	)
+	return cmd
+}
+
+func parityAction(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
+	snapshot := meta.NewSnapshot(m)
+	location := cmd.Flag("spec").Value.String()
+	page, err := runs.Page(location)
+	if err != nil {
+		return err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+	if err != nil {
+		return fmt.Errorf("parsing HTML from %q: %w", location, err)
+	}
+	old, err := parity.NewLegacy(
+		legacy.NewSpecification(overlays.NewSpecification(gq.NewSpecificationFromDocument(doc))),
+	).Facts()
+	if err != nil {
+		return fmt.Errorf("running the legacy chain over %q: %w", location, err)
+	}
+	spec, err := specification(cmd, runs, page)
+	if err != nil {
+		return fmt.Errorf("running the pipeline over %q: %w", location, err)
+	}
+	current, err := parity.NewNanopass(ir.NewSpecification(spec)).Facts()
+	if err != nil {
+		return fmt.Errorf("running the pipeline over %q: %w", location, err)
+	}
+	err = parityEmit(cmd, parity.NewReport(old, current))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(
+		cmd.ErrOrStderr(),
+		"done in %s\n",
+		snapshot.Elapsed().Round(time.Millisecond),
+	)
+	return err
+}
+
+// parityEmit writes report to the file the out flag names, or to standard
+// output when it names none.
+func parityEmit(cmd *cobra.Command, report parity.Report) error {
+	out := cmd.Flag("out").Value.String()
+	if out == "" {
+		return report.Render(cmd.OutOrStdout())
+	}
+	file, err := os.Create(out)
+	if err != nil {
+		return fmt.Errorf("creating file %q: %w", out, err)
+	}
+	defer func() { _ = file.Close() }()
+	err = report.Render(file)
+	if err != nil {
+		return fmt.Errorf("rendering report to %q: %w", out, err)
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
 	"github.com/andreychh/tgen/model/pipeline/attached"
 	"github.com/andreychh/tgen/model/pipeline/classified"
 	"github.com/andreychh/tgen/model/pipeline/corrected"
@@ -21,13 +23,28 @@ import (
 
 // Pipeline represents the nanopass chain read end to end: every pass from the
 // documentation page to the tables a target renders.
+//
+// Every table iterates in the order the page lists its records, so a pass
+// rejecting a page reports the record the page lists first. A keyed Pipeline
+// sorts the tables of the page by key before the first pass reads them, and
+// every pass after keeps that order, so the failure it reports is the one of the
+// record with the least key: two pages listing the same records in different
+// orders fail alike, which is what a failure compared across releases needs.
 type Pipeline struct {
-	doc *goquery.Document
+	doc   *goquery.Document
+	keyed bool
 }
 
-// NewPipeline creates a Pipeline over a parsed documentation page.
+// NewPipeline creates a Pipeline over a parsed documentation page, running
+// every pass in the order the page lists its records.
 func NewPipeline(doc *goquery.Document) Pipeline {
-	return Pipeline{doc: doc}
+	return Pipeline{doc: doc, keyed: false}
+}
+
+// NewKeyedPipeline creates a Pipeline over a parsed documentation page, running
+// every pass in the order of the keys of its records.
+func NewKeyedPipeline(doc *goquery.Document) Pipeline {
+	return Pipeline{doc: doc, keyed: true}
 }
 
 // Specification returns the tables a target renders, as the last pass of the
@@ -38,6 +55,9 @@ func (p Pipeline) Specification() (separated.Specification, error) {
 	if err != nil {
 		return separated.Specification{}, fmt.Errorf("parsing the page: %w", err)
 	}
+	if p.keyed {
+		page = keyed(page)
+	}
 	discriminators, err := classified.NewPass(page).Specification()
 	if err != nil {
 		return separated.Specification{}, fmt.Errorf("classifying discriminators: %w", err)
@@ -76,3 +96,22 @@ func (p Pipeline) Specification() (separated.Specification, error) {
 	}
 	return spec, nil
 }
+
+// keyed returns spec with every table sorted by key: definitions by reference,
+// fields and parameters by owner and then key, and variants by union and then
+// variant.
+func keyed(spec parsed.Specification) parsed.Specification {
+	return parsed.Specification{
+		Definitions: pipeline.NewSortedTable(
+			spec.Definitions,
+			pipeline.NewKeyOrder[model.Reference, parsed.Definition](),
+		).Apply(),
+		Fields: pipeline.NewSortedTable(spec.Fields, pipeline.NewFieldKeyOrder[parsed.Field]()).Apply(),
+		Params: pipeline.NewSortedTable(spec.Params, pipeline.NewFieldKeyOrder[parsed.Param]()).Apply(),
+		Variants: pipeline.NewSortedTable(
+			spec.Variants,
+			pipeline.NewVariantKeyOrder[parsed.Variant](),
+		).Apply(),
+		Release: spec.Release,
+	}
+}
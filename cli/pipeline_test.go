@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/model/pipeline/attached"
+	"github.com/andreychh/tgen/model/pipeline/classified"
+	"github.com/andreychh/tgen/model/pipeline/corrected"
+	"github.com/andreychh/tgen/model/pipeline/directed"
+	"github.com/andreychh/tgen/model/pipeline/flattened"
+	"github.com/andreychh/tgen/model/pipeline/parsed"
+	"github.com/andreychh/tgen/model/pipeline/resolved"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+	"github.com/andreychh/tgen/model/pipeline/typed"
+	"github.com/andreychh/tgen/model/pipeline/unified"
+)
+
+// runs is how many times a test runs the chain to tell an order kept from one
+// that held by chance. Go starts iterating a map at a random entry, so a table
+// iterating in map order differs between two runs far more often than not.
+const runs = 8
+
+// page reads the documentation page the tests run the chain over.
+func page(t *testing.T) []byte {
+	t.Helper()
+	content, err := os.ReadFile("testdata/corpus/synthetic-10.2/page.html")
+	require.NoError(t, err, "the test page must be readable")
+	return content
+}
+
+// document parses content as the chain reads it.
+func document(t *testing.T, content []byte) *goquery.Document {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(content))
+	require.NoError(t, err, "the test page must be HTML")
+	return doc
+}
+
+// trace runs every pass of the chain over doc, as [Pipeline.Specification]
+// does, and returns the order every table of every pass iterates in, keyed by
+// the pass and the table.
+func trace(t *testing.T, doc *goquery.Document) map[string][]string {
+	t.Helper()
+	out := make(map[string][]string)
+	page, err := parsed.NewPage(doc).Specification()
+	require.NoError(t, err, "the test page must parse")
+	record(out, "parsed", page)
+	discriminators, err := classified.NewPass(page).Specification()
+	require.NoError(t, err, "the test page must classify")
+	record(out, "classified", discriminators)
+	fields, err := unified.NewPass(discriminators).Specification()
+	require.NoError(t, err, "the test page must unify")
+	record(out, "unified", fields)
+	types, err := typed.NewPass(fields).Specification()
+	require.NoError(t, err, "the test page must type")
+	record(out, "typed", types)
+	returns, err := resolved.NewPass(types).Specification()
+	require.NoError(t, err, "the test page must resolve")
+	record(out, "resolved", returns)
+	corrections, err := corrected.NewPass(returns).Specification()
+	require.NoError(t, err, "the test page must correct")
+	record(out, "corrected", corrections)
+	forms, err := flattened.NewPass(corrections).Specification()
+	require.NoError(t, err, "the test page must flatten")
+	record(out, "flattened", forms)
+	files, err := attached.NewPass(forms).Specification()
+	require.NoError(t, err, "the test page must attach")
+	record(out, "attached", files)
+	directions, err := directed.NewPass(files).Specification()
+	require.NoError(t, err, "the test page must direct")
+	record(out, "directed", directions)
+	spec, err := separated.NewPass(directions).Specification()
+	require.NoError(t, err, "the test page must separate")
+	record(out, "separated", spec)
+	return out
+}
+
+// record adds to out the keys of every table spec holds, in the order the table
+// iterates them.
+func record(out map[string][]string, pass string, spec any) {
+	value := reflect.ValueOf(spec)
+	for at := range value.NumField() {
+		keys := make([]string, 0)
+		table := each(value.Field(at), func(key, _ any) {
+			keys = append(keys, fmt.Sprint(key))
+		})
+		if table {
+			out[pass+"."+value.Type().Field(at).Name] = keys
+		}
+	}
+}
+
+// each hands visit every key and record of table, in the order the table
+// iterates them, and reports whether table is a table at all. A specification
+// of every pass is a struct of tables of its own key and record types, so a
+// table is found by the one method every table has.
+func each(table reflect.Value, visit func(key, record any)) bool {
+	all := table.MethodByName("All")
+	if !all.IsValid() {
+		return false
+	}
+	seq := all.Call(nil)[0]
+	yield := reflect.MakeFunc(seq.Type().In(0), func(args []reflect.Value) []reflect.Value {
+		visit(args[0].Interface(), args[1].Interface())
+		return []reflect.Value{reflect.ValueOf(true)}
+	})
+	seq.Call([]reflect.Value{yield})
+	return true
+}
+
+func TestPipeline_Specification(t *testing.T) {
+	t.Run("iterates every table of every pass alike on every run", func(t *testing.T) {
+		content := page(t)
+		want := trace(t, document(t, content))
+		assert.Contains(t, want, "parsed.Fields", "the trace must cover the tables of the first pass")
+		assert.Contains(t, want, "separated.Methods", "the trace must cover the tables of the last pass")
+		for range runs {
+			assert.Equal(t, want, trace(t, document(t, content)),
+				"a table must iterate in the same order on every run over one page")
+		}
+	})
+	t.Run("reports the same failure on every run", func(t *testing.T) {
+		broken := bytes.ReplaceAll(page(t), []byte("<td>Integer</td>"), []byte("<td>Integer Integer</td>"))
+		_, err := NewPipeline(document(t, broken)).Specification()
+		require.Error(t, err, "a page with malformed types must fail the chain")
+		want := err.Error()
+		assert.True(t, strings.HasPrefix(want, "typing fields: "), "the malformed types must fail the typing pass")
+		for range runs {
+			_, err := NewPipeline(document(t, broken)).Specification()
+			assert.EqualError(t, err, want, "a broken page must fail the same way on every run")
+		}
+	})
+	t.Run("renders the same tables keyed as listed", func(t *testing.T) {
+		content := page(t)
+		listed, err := NewPipeline(document(t, content)).Specification()
+		require.NoError(t, err, "the test page must run through the chain")
+		keyed, err := NewKeyedPipeline(document(t, content)).Specification()
+		require.NoError(t, err, "the test page must run through the keyed chain")
+		assert.Equal(t, contents(listed), contents(keyed), "the keyed chain must only change the order of the tables")
+	})
+	t.Run("reports the failure of the least key when keyed", func(t *testing.T) {
+		broken := breakRow(t, page(t), "is_bot", "Boolean")
+		broken = breakRow(t, broken, "title", "String")
+		_, err := NewPipeline(document(t, broken)).Specification()
+		require.Error(t, err, "a page with malformed types must fail the chain")
+		assert.Contains(t, err.Error(), "{user is_bot}", "the chain must report the record the page lists first")
+		for range runs {
+			_, err = NewKeyedPipeline(document(t, broken)).Specification()
+			require.Error(t, err, "a page with malformed types must fail the keyed chain")
+			assert.Contains(t, err.Error(), "{chat title}", "the keyed chain must report the record of the least key")
+		}
+	})
+}
+
+// breakRow returns content with the type of the field named key, typed as typ,
+// written twice, which no pass reads as a type.
+func breakRow(t *testing.T, content []byte, key, typ string) []byte {
+	t.Helper()
+	row := []byte("<td>" + key + "</td>\n<td>" + typ + "</td>")
+	require.Equal(t, 1, bytes.Count(content, row), "the test page must hold the row once")
+	return bytes.Replace(content, row, []byte("<td>"+key+"</td>\n<td>"+typ+" "+typ+"</td>"), 1)
+}
+
+// contents returns every record of every table spec holds, keyed by the table
+// and then by the key of the record, which leaves the order the tables iterate
+// in out of the comparison.
+func contents(spec separated.Specification) map[string]map[string]any {
+	out := make(map[string]map[string]any)
+	value := reflect.ValueOf(spec)
+	for at := range value.NumField() {
+		records := make(map[string]any)
+		if each(value.Field(at), func(key, record any) { records[fmt.Sprint(key)] = record }) {
+			out[value.Type().Field(at).Name] = records
+		}
+	}
+	return out
+}
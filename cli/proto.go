@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/andreychh/tgen/meta"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/output"
+	"github.com/andreychh/tgen/registry"
+	"github.com/andreychh/tgen/targets"
+	"github.com/andreychh/tgen/targets/proto"
+	"github.com/spf13/cobra"
+)
+
+// NewProtoCommand returns the "proto" subcommand. It reads the registry an
+// earlier run left in the output directory before writing anything there, so
+// pointing it at the directory the schema is kept in is what keeps the numbers
+// of the fields it already holds; a directory holding no registry starts one
+// from nothing.
+func NewProtoCommand(m meta.Meta, runs Runs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proto",
+		Short: "Generate a Protocol Buffers schema",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return protoAction(cmd, args, m, runs)
+		},
+	}
+	cmd.Flags().StringP(
+		"spec",
+		"s",
+		"https://core.telegram.org/bots/api",
+		"URL or local path to the Telegram Bot API HTML specification",
+	)
+	cmd.Flags().StringP(
+		"out",
+		"o",
+		"./proto",
+		"Output directory for the generated schema and its registry",
+	)
+	cmd.Flags().StringP(
+		"package",
+		"p",
+		"telegram",
+		"Package the generated schema declares",
+	)
+	return cmd
+}
+
+func protoAction(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
+	snapshot := meta.NewSnapshot(m)
+	location := cmd.Flag("spec").Value.String()
+	out := cmd.Flag("out").Value.String()
+	reg, err := readRegistry(filepath.Join(out, proto.RegistryFile))
+	if err != nil {
+		return err
+	}
+	page, err := runs.Page(location)
+	if err != nil {
+		return err
+	}
+	spec, err := specification(cmd, runs, page)
+	if err != nil {
+		return fmt.Errorf("running the pipeline over %q: %w", location, err)
+	}
+	artifacts, err := proto.NewPass(
+		proto.NewGeneration(
+			proto.NewSpecification(ir.NewSpecification(spec), reg),
+			cmd.Flag("package").Value.String(),
+			targets.NewSnapshot(snapshot),
+		),
+	).Artifacts()
+	if err != nil {
+		return err
+	}
+	err = output.NewFileset(artifacts).Emit(out)
+	if err != nil {
+		return fmt.Errorf("generating files in directory %q: %w", out, err)
+	}
+	_, err = fmt.Fprintf(
+		cmd.ErrOrStderr(),
+		"done in %s\n",
+		snapshot.Elapsed().Round(time.Millisecond),
+	)
+	return err
+}
+
+// readRegistry returns the registry written to path, or an empty one when
+// nothing is written there yet.
+func readRegistry(path string) (registry.Registry, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return registry.New(), nil
+	}
+	if err != nil {
+		return registry.Registry{}, fmt.Errorf("reading registry %q: %w", path, err)
+	}
+	reg, err := registry.Parse(data)
+	if err != nil {
+		return registry.Registry{}, fmt.Errorf("parsing registry %q: %w", path, err)
+	}
+	return reg, nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/registry"
+)
+
+func TestProtoCommand(t *testing.T) {
+	cases := []struct {
+		name  string
+		files map[string]string
+		want  registry.Entry
+	}{
+		{
+			name:  "numbers a directory holding nothing from scratch",
+			files: map[string]string{},
+			want:  registry.Entry{Number: 2},
+		},
+		{
+			name:  "numbers a new field after the highest the registry a run left held",
+			files: map[string]string{"registry.json": `{"fields": {"chat": {"id": {"number": 4}}}}`},
+			want:  registry.Entry{Number: 5},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tc.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+			root := newRootCommand(snapshot().Meta(), NewRuns())
+			root.SetErr(io.Discard)
+			root.SetArgs([]string{"proto", "-s", "testdata/corpus/synthetic-10.2/page.html", "-o", dir})
+			require.NoError(t, root.Execute())
+			data, err := os.ReadFile(filepath.Join(dir, "registry.json"))
+			require.NoError(t, err, "the proto command must write its registry")
+			reg, err := registry.Parse(data)
+			require.NoError(t, err)
+			got, ok := reg.Field(model.FieldKey{Owner: "chat", Key: "type"})
+			require.True(t, ok)
+			assert.Equal(t, tc.want, got, "the proto command must number a new field after the highest its owner held before")
+		})
+	}
+}
@@ -4,15 +4,12 @@
 package cli
 
 import (
-	"context"
 	"fmt"
 	"time"
 
-	"github.com/PuerkitoBio/goquery"
 	"github.com/andreychh/tgen/meta"
 	ir "github.com/andreychh/tgen/model/ir/v2"
 	"github.com/andreychh/tgen/output"
-	"github.com/andreychh/tgen/source"
 	"github.com/andreychh/tgen/targets"
 	"github.com/andreychh/tgen/targets/pythonv2"
 	"github.com/spf13/cobra"
@@ -24,12 +21,12 @@ import (
 // takes that name over when it does.
 //
 // TODO #259: Render Python from the nanopass pipeline instead of model/spec.
-func NewPythonV2Command(m meta.Meta) *cobra.Command {
+func NewPythonV2Command(m meta.Meta, runs Runs) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pythonv2",
 		Short: "Generate Python client code from the nanopass pipeline",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return pythonV2Action(cmd, args, m)
+			return pythonV2Action(cmd, args, m, runs)
 		},
 	}
 	cmd.Flags().StringP(
@@ -44,33 +41,44 @@ func NewPythonV2Command(m meta.Meta) *cobra.Command {
 		"./api",
 		"Output directory for the generated Python files",
 	)
+	cmd.Flags().StringP(
+		"backend",
+		"b",
+		string(pythonv2.Pydantic),
+		"Library the models are declared with: pydantic, dataclasses or msgspec",
+	)
+	cmd.Flags().StringP(
+		"distribution",
+		"d",
+		"",
+		"Project name to lay the files out as a distribution under, with pyproject.toml, py.typed and stubs",
+	)
 	return cmd
 }
 
-func pythonV2Action(cmd *cobra.Command, _ []string, m meta.Meta) error {
+func pythonV2Action(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
 	snapshot := meta.NewSnapshot(m)
 	location := cmd.Flag("spec").Value.String()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-	reader, err := source.NewLocationSource(location).Open(ctx)
+	page, err := runs.Page(location)
 	if err != nil {
-		return fmt.Errorf("opening source %q: %w", location, err)
+		return err
 	}
-	defer func() { _ = reader.Close() }()
-	doc, err := goquery.NewDocumentFromReader(reader)
+	backend, err := pythonv2.NewBackend(cmd.Flag("backend").Value.String())
 	if err != nil {
-		return fmt.Errorf("parsing HTML from %q: %w", location, err)
+		return err
 	}
-	spec, err := NewPipeline(doc).Specification()
+	spec, err := specification(cmd, runs, page)
 	if err != nil {
 		return fmt.Errorf("running the pipeline over %q: %w", location, err)
 	}
-	artifacts, err := pythonv2.NewPass(
+	pass := pythonv2.NewPass(
 		pythonv2.NewGeneration(
 			pythonv2.NewSpecification(ir.NewSpecification(spec)),
+			backend,
 			targets.NewSnapshot(snapshot),
 		),
-	).Artifacts()
+	)
+	artifacts, err := pythonV2Artifacts(pass, cmd.Flag("distribution").Value.String())
 	if err != nil {
 		return err
 	}
@@ -86,3 +94,16 @@ func pythonV2Action(cmd *cobra.Command, _ []string, m meta.Meta) error {
 	)
 	return err
 }
+
+// pythonV2Artifacts returns the files pass renders, laid out as the source tree
+// of the distribution named name, or as the package alone when name is empty.
+func pythonV2Artifacts(pass pythonv2.Pass, name string) (output.Artifacts, error) {
+	if name == "" {
+		return pass.Artifacts()
+	}
+	dist, err := pythonv2.NewDistribution(name)
+	if err != nil {
+		return nil, err
+	}
+	return pythonv2.NewWheel(pass, dist).Artifacts()
+}
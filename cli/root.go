@@ -8,17 +8,39 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// deterministicErrors is the name of the flag running the keyed chain.
+const deterministicErrors = "deterministic-errors"
+
 // NewRootCommand returns the primary application command ("tgen").
 func NewRootCommand() *cobra.Command {
-	metadata := meta.NewMeta(meta.NewDetectedSource())
+	return newRootCommand(meta.NewMeta(meta.NewDetectedSource()), NewRuns())
+}
+
+// newRootCommand returns the primary application command, its subcommands
+// sharing runs. A batch builds one for every invocation it runs, over the runs
+// of the process.
+func newRootCommand(metadata meta.Meta, runs Runs) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "tgen",
 		Short:   "Generate strongly-typed Telegram Bot API clients",
 		Version: metadata.Release().Version(),
 	}
 	cmd.SetVersionTemplate(NewVersionMessage(metadata).String())
-	cmd.AddCommand(NewGoCommand(metadata))
+	cmd.PersistentFlags().Bool(
+		deterministicErrors,
+		false,
+		"Run the pipeline in the order of the keys of the page, so that it fails on the same record "+
+			"whatever order the page lists them in",
+	)
+	cmd.AddCommand(NewGoCommand(metadata, runs))
 	cmd.AddCommand(NewPythonCommand(metadata))
-	cmd.AddCommand(NewPythonV2Command(metadata))
+	cmd.AddCommand(NewPythonV2Command(metadata, runs))
+	cmd.AddCommand(NewKotlinCommand(metadata, runs))
+	cmd.AddCommand(NewCSharpCommand(metadata, runs))
+	cmd.AddCommand(NewSwiftCommand(metadata, runs))
+	cmd.AddCommand(NewProtoCommand(metadata, runs))
+	cmd.AddCommand(NewGraphCommand(metadata, runs))
+	cmd.AddCommand(NewParityCommand(metadata, runs))
+	cmd.AddCommand(NewBatchCommand(metadata, runs))
 	return cmd
 }
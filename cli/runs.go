@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andreychh/tgen/model/pipeline"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+	"github.com/andreychh/tgen/source"
+	"github.com/spf13/cobra"
+)
+
+// Runs is the [Pipeline] run over every page one process reads, remembered by
+// the digest of the page, and the pages themselves, remembered by where they
+// were read from. A page is parsed, typed and corrected the same way whichever
+// target renders it, so a process rendering several targets from one page —
+// which "tgen batch" is — fetches it and pays for the chain once.
+//
+// The chain is remembered whole rather than stage by stage: every stage runs
+// over what the one before it produced from the same page, so the digest of the
+// page already tells every stage's input apart.
+//
+// A run of the keyed chain is remembered apart from a run of the chain over the
+// same page, the two iterating their tables in different orders.
+type Runs struct {
+	memo  pipeline.Memo[separated.Specification]
+	keyed pipeline.Memo[separated.Specification]
+	pages pipeline.Memo[[]byte]
+}
+
+// NewRuns creates a Runs remembering nothing yet.
+func NewRuns() Runs {
+	return Runs{
+		memo:  pipeline.NewMemo[separated.Specification](),
+		keyed: pipeline.NewMemo[separated.Specification](),
+		pages: pipeline.NewMemo[[]byte](),
+	}
+}
+
+// Page returns the bytes of the documentation page at location, a URL or a
+// local path, reading it unless it was read already. It fails when the page
+// cannot be read.
+func (r Runs) Page(location string) ([]byte, error) {
+	return r.pages.Value(pipeline.NewDigest([]byte(location)), func() ([]byte, error) {
+		return readPage(location)
+	})
+}
+
+// Specification returns the tables a target renders for page, running the
+// chain over it unless a run over the same bytes is remembered. It fails when
+// the page is no HTML or when any pass rejects it.
+func (r Runs) Specification(page []byte) (separated.Specification, error) {
+	return r.memo.Value(pipeline.NewDigest(page), func() (separated.Specification, error) {
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+		if err != nil {
+			return separated.Specification{}, fmt.Errorf("parsing HTML: %w", err)
+		}
+		return NewPipeline(doc).Specification()
+	})
+}
+
+// KeyedSpecification returns the tables a target renders for page as
+// [Runs.Specification] does, but from the keyed chain, which reports the
+// failure of the record with the least key rather than the one the page lists
+// first. It fails when the page is no HTML or when any pass rejects it.
+func (r Runs) KeyedSpecification(page []byte) (separated.Specification, error) {
+	return r.keyed.Value(pipeline.NewDigest(page), func() (separated.Specification, error) {
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+		if err != nil {
+			return separated.Specification{}, fmt.Errorf("parsing HTML: %w", err)
+		}
+		return NewKeyedPipeline(doc).Specification()
+	})
+}
+
+// specification returns the tables a target renders for page, from the keyed
+// chain when cmd was given --deterministic-errors and from the chain otherwise.
+func specification(cmd *cobra.Command, runs Runs, page []byte) (separated.Specification, error) {
+	deterministic, err := cmd.Flags().GetBool(deterministicErrors)
+	if err != nil {
+		return separated.Specification{}, err
+	}
+	if deterministic {
+		return runs.KeyedSpecification(page)
+	}
+	return runs.Specification(page)
+}
+
+// readPage returns the bytes of the documentation page at location, a URL or a
+// local path, giving up after 30 seconds.
+func readPage(location string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	reader, err := source.NewLocationSource(location).Open(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening source %q: %w", location, err)
+	}
+	defer func() { _ = reader.Close() }()
+	page, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading source %q: %w", location, err)
+	}
+	return page, nil
+}
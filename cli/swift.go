@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andreychh/tgen/meta"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/output"
+	"github.com/andreychh/tgen/targets"
+	"github.com/andreychh/tgen/targets/swift"
+	"github.com/spf13/cobra"
+)
+
+// NewSwiftCommand returns the "swift" subcommand. It takes no name for what it
+// writes: a Swift file declares no module, so the files land in whichever target
+// of a package the output directory is, and that target is what names them. The
+// default is the directory SwiftPM looks a target named Telegram up in.
+func NewSwiftCommand(m meta.Meta, runs Runs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swift",
+		Short: "Generate Swift client code",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return swiftAction(cmd, args, m, runs)
+		},
+	}
+	cmd.Flags().StringP(
+		"spec",
+		"s",
+		"https://core.telegram.org/bots/api",
+		"URL or local path to the Telegram Bot API HTML specification",
+	)
+	cmd.Flags().StringP(
+		"out",
+		"o",
+		"./Sources/Telegram",
+		"Output directory for the generated Swift files",
+	)
+	return cmd
+}
+
+func swiftAction(cmd *cobra.Command, _ []string, m meta.Meta, runs Runs) error {
+	snapshot := meta.NewSnapshot(m)
+	location := cmd.Flag("spec").Value.String()
+	page, err := runs.Page(location)
+	if err != nil {
+		return err
+	}
+	spec, err := specification(cmd, runs, page)
+	if err != nil {
+		return fmt.Errorf("running the pipeline over %q: %w", location, err)
+	}
+	artifacts, err := swift.NewPass(
+		swift.NewGeneration(
+			swift.NewSpecification(ir.NewSpecification(spec)),
+			targets.NewSnapshot(snapshot),
+		),
+	).Artifacts()
+	if err != nil {
+		return err
+	}
+	out := cmd.Flag("out").Value.String()
+	err = output.NewFileset(artifacts).Emit(out)
+	if err != nil {
+		return fmt.Errorf("generating files in directory %q: %w", out, err)
+	}
+	_, err = fmt.Fprintf(
+		cmd.ErrOrStderr(),
+		"done in %s\n",
+		snapshot.Elapsed().Round(time.Millisecond),
+	)
+	return err
+}
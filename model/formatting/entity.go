@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+// Package formatting reads the record of MessageEntity as the kinds of entity a
+// message text can be marked with, which is what a target writes a formatting
+// builder from.
+//
+// The documentation does not declare the kinds as a type. It lists them in the
+// prose of the type field — "Currently, can be “mention”, “hashtag”, …" — and
+// reserves a field to one of them by opening its description with "For
+// “text_link” only". [Entity] is the entry point: wrap the record and call its
+// Kinds method. Decoding is driven by the two rules of this package, [ListRule]
+// and [ReservedRule], one for either form.
+//
+// Nothing here is a pass. The kinds matter to a target that builds text, and to
+// nothing else the pipeline decides, so they are read off the record at the
+// pipeline's exit rather than carried through every stage as a table.
+package formatting
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/prose/grammar"
+)
+
+// Ref is the reference the documentation addresses MessageEntity by.
+const Ref = model.Reference("messageentity")
+
+// typeKey is the field of MessageEntity whose description lists the kinds.
+const typeKey = model.Key("type")
+
+// Kind is one kind of entity: the value its type field takes, and the fields
+// the documentation reserves to it, in the order MessageEntity declares them.
+type Kind struct {
+	Value  string
+	Fields []ir.Field
+}
+
+// Entity is the record of MessageEntity ready to be read as the kinds of
+// entity it stands for.
+type Entity struct {
+	object ir.Object
+}
+
+// NewEntity constructs an Entity over the record of MessageEntity.
+func NewEntity(object ir.Object) Entity {
+	return Entity{object: object}
+}
+
+// Lookup returns the Entity among definitions, and reports whether the
+// specification declares MessageEntity as an object at all.
+func Lookup(definitions []ir.Definition) (Entity, bool) {
+	for _, definition := range definitions {
+		object, ok := definition.(ir.Object)
+		if ok && object.Ref == Ref {
+			return NewEntity(object), true
+		}
+	}
+	return Entity{}, false
+}
+
+// Name returns the name MessageEntity is declared under.
+func (e Entity) Name() model.Name {
+	return e.object.Name
+}
+
+// Kinds returns the kinds of entity in the order the type field lists them,
+// each with the fields reserved to it. It fails when the type field is missing
+// or lists nothing, and when a field is reserved to a kind the list does not
+// name: either means the page stopped reading the way this package reads it,
+// and a builder written from half of it would be wrong without saying so.
+func (e Entity) Kinds() ([]Kind, error) {
+	values, err := e.values()
+	if err != nil {
+		return nil, err
+	}
+	kinds := make([]Kind, 0, len(values))
+	index := make(map[string]int, len(values))
+	for _, value := range values {
+		if _, seen := index[value]; seen {
+			continue
+		}
+		index[value] = len(kinds)
+		kinds = append(kinds, Kind{Value: value, Fields: nil})
+	}
+	for _, field := range e.object.Fields {
+		value, ok := NewReservedRule().Match(field.Description.Inlines())
+		if !ok {
+			continue
+		}
+		at, listed := index[value]
+		if !listed {
+			return nil, fmt.Errorf("%s.%s is reserved to %q, which %s.%s does not list", e.object.Ref, field.Key, value, e.object.Ref, typeKey)
+		}
+		kinds[at].Fields = append(kinds[at].Fields, field)
+	}
+	return kinds, nil
+}
+
+// values returns the values the type field lists, in the order it lists them.
+func (e Entity) values() ([]string, error) {
+	for _, field := range e.object.Fields {
+		if field.Key != typeKey {
+			continue
+		}
+		values, ok := grammar.NewSearch[[]string](NewListRule()).Find(field.Description.Inlines())
+		if !ok {
+			return nil, fmt.Errorf("%s.%s lists no kind of entity", e.object.Ref, typeKey)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("%s has no %s field", e.object.Ref, typeKey)
+}
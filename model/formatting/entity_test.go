@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package formatting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/formatting"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/prose"
+)
+
+// plain builds the unemphasized run a field description writes its sentence in.
+func plain(content string) prose.Text {
+	return prose.NewText(content, prose.StylePlain)
+}
+
+// field builds a field of MessageEntity described by inlines.
+func field(key string, optional bool, inlines ...prose.Inline) ir.Field {
+	return ir.Field{
+		Key:         model.Key(key),
+		Optionality: model.Optionality(optional),
+		Description: prose.NewPhrase(inlines...),
+	}
+}
+
+// entity builds the record of MessageEntity owning fields.
+func entity(fields ...ir.Field) formatting.Entity {
+	return formatting.NewEntity(ir.Object{Ref: formatting.Ref, Name: "MessageEntity", Fields: fields})
+}
+
+func TestEntity_Kinds(t *testing.T) {
+	cases := []struct {
+		name   string
+		entity formatting.Entity
+		want   map[string][]model.Key
+		order  []string
+	}{
+		{
+			name: "reads every kind the type field lists, in the order it lists them",
+			entity: entity(
+				field("type", false, plain("Type of the entity. Currently, can be “mention”, “bold” or “text_link”")),
+				field("offset", false, plain("Offset in UTF-16 code units to the start of the entity")),
+			),
+			order: []string{"mention", "bold", "text_link"},
+			want:  map[string][]model.Key{"mention": nil, "bold": nil, "text_link": nil},
+		},
+		{
+			name: "reads past the glosses the list interrupts itself with",
+			entity: entity(
+				field("type", false,
+					plain("Type of the entity. Currently, can be “mention” (@username), “url” ("),
+					prose.NewLink("https://telegram.org", prose.StylePlain, "https://telegram.org"),
+					plain("), or “date_time” (for formatted date and time)."),
+				),
+			),
+			order: []string{"mention", "url", "date_time"},
+			want:  map[string][]model.Key{"mention": nil, "url": nil, "date_time": nil},
+		},
+		{
+			name: "gives a kind every field reserved to it",
+			entity: entity(
+				field("type", false, plain("Type of the entity. Currently, can be “pre” or “date_time”")),
+				field("language", true, plain("For “pre” only, the programming language of the entity text")),
+				field("unix_time", true, plain("For “date_time” only, the Unix time associated with the entity")),
+				field("date_time_format", true, plain("For “date_time” only, the string that defines the formatting")),
+			),
+			order: []string{"pre", "date_time"},
+			want: map[string][]model.Key{
+				"pre":       {"language"},
+				"date_time": {"unix_time", "date_time_format"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			kinds, err := tc.entity.Kinds()
+			require.NoError(t, err)
+
+			order := make([]string, 0, len(kinds))
+			got := make(map[string][]model.Key, len(kinds))
+			for _, kind := range kinds {
+				order = append(order, kind.Value)
+				var keys []model.Key
+				for _, f := range kind.Fields {
+					keys = append(keys, f.Key)
+				}
+				got[kind.Value] = keys
+			}
+			assert.Equal(t, tc.order, order, "Entity.Kinds must give the kinds in the order the type field lists them")
+			assert.Equal(t, tc.want, got, "Entity.Kinds must give a kind the fields reserved to it and no other")
+		})
+	}
+}
+
+func TestEntity_Kinds_fails(t *testing.T) {
+	cases := []struct {
+		name   string
+		entity formatting.Entity
+		want   string
+	}{
+		{
+			name:   "when the type field is missing",
+			entity: entity(field("offset", false, plain("Offset in UTF-16 code units"))),
+			want:   "messageentity has no type field",
+		},
+		{
+			name:   "when the type field lists nothing",
+			entity: entity(field("type", false, plain("Type of the entity"))),
+			want:   "messageentity.type lists no kind of entity",
+		},
+		{
+			name: "when a field is reserved to a kind the list does not name",
+			entity: entity(
+				field("type", false, plain("Type of the entity. Currently, can be “bold”")),
+				field("url", true, plain("For “text_link” only, URL that will be opened")),
+			),
+			want: `messageentity.url is reserved to "text_link", which messageentity.type does not list`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.entity.Kinds()
+			assert.EqualError(t, err, tc.want, "Entity.Kinds must refuse a record it cannot read whole")
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	object := ir.Object{Ref: formatting.Ref, Name: "MessageEntity"}
+	definitions := []ir.Definition{ir.Object{Ref: "user", Name: "User"}, object}
+
+	found, ok := formatting.Lookup(definitions)
+
+	require.True(t, ok, "Lookup must find MessageEntity among the definitions")
+	assert.Equal(t, model.Name("MessageEntity"), found.Name())
+
+	_, ok = formatting.Lookup(definitions[:1])
+	assert.False(t, ok, "Lookup must report a specification declaring no MessageEntity")
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package formatting
+
+import (
+	"regexp"
+
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/andreychh/tgen/model/prose/grammar"
+)
+
+var (
+	canBeSignal    = regexp.MustCompile(`(?i)\bcan be\b`)
+	quotedValue    = regexp.MustCompile(`“([^”]+)”`)
+	reservedSignal = regexp.MustCompile(`^For “([^”]+)” only\b`)
+)
+
+// ListRule is a [grammar.Rule] that recognizes "…, can be “a”, “b” or “c”", the
+// values a field may take listed in quotes after the words that announce them.
+// The list may run on through several plain runs, the documentation glossing a
+// value in parentheses now and then, and every quoted value from the words on
+// is taken; a value quoted inside a link or in emphasis is not one of them.
+type ListRule struct{}
+
+// NewListRule constructs a ListRule.
+func NewListRule() ListRule {
+	return ListRule{}
+}
+
+// Match implements [grammar.Rule]. It reports false when the run at the front
+// does not announce a list, or announces one and quotes nothing after it.
+func (ListRule) Match(inlines []prose.Inline) ([]string, bool) {
+	if len(inlines) < 1 {
+		return nil, false
+	}
+	first, ok := inlines[0].(prose.Text)
+	if !ok || first.Style() != prose.StylePlain {
+		return nil, false
+	}
+	at := canBeSignal.FindStringIndex(first.Content())
+	if at == nil {
+		return nil, false
+	}
+	values := quoted(first.Content()[at[1]:])
+	for _, inline := range inlines[1:] {
+		text, ok := inline.(prose.Text)
+		if !ok || text.Style() != prose.StylePlain {
+			continue
+		}
+		values = append(values, quoted(text.Content())...)
+	}
+	return values, len(values) > 0
+}
+
+// ReservedRule is a [grammar.Rule] that recognizes "For “value” only, …", the
+// opening a field's description gives it when only one value of a sibling field
+// ever fills it.
+type ReservedRule struct{}
+
+// NewReservedRule constructs a ReservedRule.
+func NewReservedRule() ReservedRule {
+	return ReservedRule{}
+}
+
+// Match implements [grammar.Rule].
+func (ReservedRule) Match(inlines []prose.Inline) (string, bool) {
+	if len(inlines) < 1 {
+		return "", false
+	}
+	return grammar.NewCapture(reservedSignal).Matches(inlines[0])
+}
+
+// quoted returns every value content quotes, in the order it quotes them.
+func quoted(content string) []string {
+	matches := quotedValue.FindAllStringSubmatch(content, -1)
+	out := make([]string, 0, len(matches))
+	for _, match := range matches {
+		out = append(out, match[1])
+	}
+	return out
+}
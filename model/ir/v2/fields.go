@@ -4,9 +4,7 @@
 package ir
 
 import (
-	"cmp"
 	"fmt"
-	"slices"
 
 	"github.com/andreychh/tgen/model"
 	"github.com/andreychh/tgen/model/pipeline/flattened"
@@ -21,12 +19,14 @@ import (
 // generated type meets first.
 type Fields struct {
 	db    separated.Specification
+	owned Ownership
 	owner model.Reference
 }
 
-// NewFields constructs a Fields over the fields owner holds in the database.
-func NewFields(db separated.Specification, owner model.Reference) Fields {
-	return Fields{db: db, owner: owner}
+// NewFields constructs a Fields over the fields owner holds in the database, as
+// owned groups them.
+func NewFields(db separated.Specification, owned Ownership, owner model.Reference) Fields {
+	return Fields{db: db, owned: owned, owner: owner}
 }
 
 // Value returns the fields of the owner, the required ones before the optional.
@@ -86,27 +86,5 @@ func (f Fields) field(record flattened.Field) (Field, error) {
 // records returns the raw field records of the owner, grouped by optionality
 // and ordered by position within either group.
 func (f Fields) records() []flattened.Field {
-	out := make([]flattened.Field, 0)
-	for key, record := range f.db.Fields.All() {
-		if key.Owner != f.owner {
-			continue
-		}
-		out = append(out, record)
-	}
-	slices.SortFunc(out, func(a, b flattened.Field) int {
-		return cmp.Or(
-			cmp.Compare(f.rank(a.Optionality), f.rank(b.Optionality)),
-			cmp.Compare(a.Position, b.Position),
-		)
-	})
-	return out
-}
-
-// rank returns the group an optionality sorts into, ordering a required field
-// ahead of an optional one.
-func (f Fields) rank(opt model.Optionality) int {
-	if opt {
-		return 1
-	}
-	return 0
+	return f.owned.Fields(f.owner)
 }
@@ -18,6 +18,8 @@ import (
 // Params to those reaching a file, and is empty for a method sending none.
 // Introduced reports that tgen introduced the method rather than reading it
 // from the documentation page, which leaves it no section a target can address.
+// Paged reports that the result is one page of a collection the method hands
+// out by offset and limit, and Paging how; it is the zero Paging otherwise.
 type Method struct {
 	Ref         model.Reference
 	Name        model.Name
@@ -25,6 +27,8 @@ type Method struct {
 	Params      []Field
 	Files       []FileField
 	Result      Result
+	Paging      Paging
+	Paged       bool
 	Introduced  bool
 }
 
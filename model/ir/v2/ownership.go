@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package ir
+
+import (
+	"cmp"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
+	"github.com/andreychh/tgen/model/pipeline/flattened"
+	"github.com/andreychh/tgen/model/pipeline/parsed"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+)
+
+// Ownership is the fields and variants tables grouped by the definition each
+// record belongs to, built once for the whole specification. Every definition
+// asks after what it owns, and a table keyed by field or by variant answers that
+// only by a scan; grouping once turns every such question into a lookup.
+type Ownership struct {
+	fields    pipeline.Table[model.Reference, []pipeline.Row[model.FieldKey, flattened.Field]]
+	variants  pipeline.Table[model.Reference, []pipeline.Row[model.VariantKey, parsed.Variant]]
+	admitting pipeline.Table[model.Reference, []pipeline.Row[model.VariantKey, parsed.Variant]]
+}
+
+// NewOwnership constructs the Ownership of the tables db holds. The fields of
+// one owner are grouped required before optional and by position within either
+// group, and the variants of one union by position.
+func NewOwnership(db separated.Specification) Ownership {
+	return Ownership{
+		fields: pipeline.NewGroupedTable(
+			db.Fields,
+			pipeline.NewFieldOwner[flattened.Field](),
+			fieldOrder{},
+		).Apply(),
+		variants: pipeline.NewGroupedTable(
+			db.Variants,
+			pipeline.NewVariantOwner[parsed.Variant](),
+			pipeline.NewPositionOrder(variantPosition{}),
+		).Apply(),
+		admitting: pipeline.NewIndexedTable(db.Variants, admitted{}).Apply(),
+	}
+}
+
+// Fields returns the field records owner holds, the required ones before the
+// optional, and in the order their source gave them within either group.
+func (o Ownership) Fields(owner model.Reference) []flattened.Field {
+	rows, _ := o.fields.Lookup(owner)
+	out := make([]flattened.Field, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.Record)
+	}
+	return out
+}
+
+// Variants returns the variant records union lists, ordered by position.
+func (o Ownership) Variants(union model.Reference) []parsed.Variant {
+	rows, _ := o.variants.Lookup(union)
+	out := make([]parsed.Variant, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.Record)
+	}
+	return out
+}
+
+// Unions returns every union listing ref as a variant, in no particular order.
+func (o Ownership) Unions(ref model.Reference) []model.Reference {
+	rows, _ := o.admitting.Lookup(ref)
+	out := make([]model.Reference, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.Key.Owner)
+	}
+	return out
+}
+
+// fieldOrder is the [pipeline.Order] a definition lists its fields in: required
+// before optional, since a caller fills in every required field and those are
+// what a reader of the generated type meets first, and by position within
+// either group.
+type fieldOrder struct{}
+
+// Compare implements [pipeline.Order].
+func (fieldOrder) Compare(
+	a, b pipeline.Row[model.FieldKey, flattened.Field],
+) int {
+	return cmp.Or(
+		cmp.Compare(rank(a.Record.Optionality), rank(b.Record.Optionality)),
+		cmp.Compare(a.Record.Position, b.Record.Position),
+	)
+}
+
+// rank returns the group an optionality sorts into, ordering a required field
+// ahead of an optional one.
+func rank(opt model.Optionality) int {
+	if opt {
+		return 1
+	}
+	return 0
+}
+
+// variantPosition is the [pipeline.Projection] reading the position the page
+// gave a variant, which is the order a union lists its variants in.
+type variantPosition struct{}
+
+// Apply implements [pipeline.Projection]. Every variant has a position.
+func (variantPosition) Apply(_ model.VariantKey, variant parsed.Variant) (model.Position, bool) {
+	return variant.Position, true
+}
+
+// admitted is the [pipeline.Projection] indexing a variant by the definition it
+// names, which answers which unions admit a definition.
+type admitted struct{}
+
+// Apply implements [pipeline.Projection]. Every variant names a definition.
+func (admitted) Apply(key model.VariantKey, _ parsed.Variant) (model.Reference, bool) {
+	return key.Ref, true
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package ir
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+)
+
+// Paging is the record of how a method hands a collection out a page at a
+// time: the parameters a page is asked for by, the field of the page holding
+// its items, with their type bound, and the key of the field counting every
+// item there is. Total is empty for a page that does not count them.
+type Paging struct {
+	Offset model.Key
+	Limit  model.Key
+	Items  Field
+	Total  model.Key
+}
+
+// Paged is the view of how one paged method hands its collection out, with the
+// field holding the items joined from the object its page comes back as.
+type Paged struct {
+	db    separated.Specification
+	owned Ownership
+	inner separated.Paging
+}
+
+// NewPaged constructs a Paged over the paging a method was separated with, as
+// owned groups the fields of its page.
+func NewPaged(db separated.Specification, owned Ownership, inner separated.Paging) Paged {
+	return Paged{db: db, owned: owned, inner: inner}
+}
+
+// Value returns the paging with the field holding the items joined. It fails
+// when the page holds no such field, or its type names no definition.
+func (p Paged) Value() (Paging, error) {
+	fields, err := NewFields(p.db, p.owned, p.inner.Page).Value()
+	if err != nil {
+		return Paging{}, fmt.Errorf("joining page %s: %w", p.inner.Page, err)
+	}
+	for _, field := range fields {
+		if field.Key == p.inner.Items {
+			return Paging{Offset: p.inner.Offset, Limit: p.inner.Limit, Items: field, Total: p.inner.Total}, nil
+		}
+	}
+	return Paging{}, fmt.Errorf("page %s holds no %s", p.inner.Page, p.inner.Items)
+}
@@ -16,13 +16,16 @@ import (
 // kind the definition is of.
 type Reading struct {
 	db         separated.Specification
+	owned      Ownership
 	definition corrected.Definition
 }
 
 // NewReading constructs a Reading of one definition against the database
-// holding what that definition owns.
-func NewReading(db separated.Specification, definition corrected.Definition) Reading {
-	return Reading{db: db, definition: definition}
+// holding what that definition owns, grouped by owned.
+func NewReading(
+	db separated.Specification, owned Ownership, definition corrected.Definition,
+) Reading {
+	return Reading{db: db, owned: owned, definition: definition}
 }
 
 // Value returns the definition read as the record of its kind. An object is
@@ -53,11 +56,11 @@ func (r Reading) Value() (Definition, error) {
 
 // object returns the definition joined with the fields it owns.
 func (r Reading) object() (Object, error) {
-	fields, err := NewFields(r.db, r.definition.Ref).Value()
+	fields, err := NewFields(r.db, r.owned, r.definition.Ref).Value()
 	if err != nil {
 		return Object{}, fmt.Errorf("joining object %s: %w", r.definition.Ref, err)
 	}
-	files, err := NewFields(r.db, r.definition.Ref).Files()
+	files, err := NewFields(r.db, r.owned, r.definition.Ref).Files()
 	if err != nil {
 		return Object{}, fmt.Errorf("joining object %s: %w", r.definition.Ref, err)
 	}
@@ -91,11 +94,8 @@ func (r Reading) direction() (model.Direction, error) {
 // rewrites reports whether a union reaching a file admits the definition, which
 // obliges it to rewrite itself into JSON however little it has to hand over.
 func (r Reading) rewrites() bool {
-	for key := range r.db.Variants.All() {
-		if key.Ref != r.definition.Ref {
-			continue
-		}
-		mark, reaches := r.db.Files.Lookup(key.Owner)
+	for _, union := range r.owned.Unions(r.definition.Ref) {
+		mark, reaches := r.db.Files.Lookup(union)
 		if reaches && mark.Kind == model.FileKindCarrier {
 			return true
 		}
@@ -113,7 +113,7 @@ func (r Reading) discriminatedObject() (DiscriminatedObject, error) {
 			r.definition.Ref,
 		)
 	}
-	fields, err := NewFields(r.db, r.definition.Ref).Value()
+	fields, err := NewFields(r.db, r.owned, r.definition.Ref).Value()
 	if err != nil {
 		return DiscriminatedObject{}, fmt.Errorf(
 			"joining discriminated object %s: %w",
@@ -121,7 +121,7 @@ func (r Reading) discriminatedObject() (DiscriminatedObject, error) {
 			err,
 		)
 	}
-	files, err := NewFields(r.db, r.definition.Ref).Files()
+	files, err := NewFields(r.db, r.owned, r.definition.Ref).Files()
 	if err != nil {
 		return DiscriminatedObject{}, fmt.Errorf(
 			"joining discriminated object %s: %w",
@@ -164,7 +164,7 @@ func (r Reading) union() (Definition, error) {
 	if err != nil {
 		return nil, fmt.Errorf("joining union %s: %w", r.definition.Ref, err)
 	}
-	key, discriminated, err := NewVariants(r.db, r.definition.Ref).Discriminated()
+	key, discriminated, err := NewVariants(r.db, r.owned, r.definition.Ref).Discriminated()
 	if err != nil {
 		return nil, fmt.Errorf("joining union %s: %w", r.definition.Ref, err)
 	}
@@ -180,7 +180,7 @@ func (r Reading) union() (Definition, error) {
 			Introduced:  r.definition.Introduced,
 		}, nil
 	}
-	variants, err := NewVariants(r.db, r.definition.Ref).Value()
+	variants, err := NewVariants(r.db, r.owned, r.definition.Ref).Value()
 	if err != nil {
 		return nil, fmt.Errorf("joining union %s: %w", r.definition.Ref, err)
 	}
@@ -202,11 +202,11 @@ func (r Reading) method() (Method, error) {
 	if !found {
 		return Method{}, fmt.Errorf("method %s returns nothing", r.definition.Ref)
 	}
-	params, err := NewFields(r.db, r.definition.Ref).Value()
+	params, err := NewFields(r.db, r.owned, r.definition.Ref).Value()
 	if err != nil {
 		return Method{}, fmt.Errorf("joining method %s: %w", r.definition.Ref, err)
 	}
-	files, err := NewFields(r.db, r.definition.Ref).Files()
+	files, err := NewFields(r.db, r.owned, r.definition.Ref).Files()
 	if err != nil {
 		return Method{}, fmt.Errorf("joining method %s: %w", r.definition.Ref, err)
 	}
@@ -214,6 +214,13 @@ func (r Reading) method() (Method, error) {
 	if err != nil {
 		return Method{}, fmt.Errorf("joining method %s: %w", r.definition.Ref, err)
 	}
+	var paging Paging
+	if record.Paged {
+		paging, err = NewPaged(r.db, r.owned, record.Paging).Value()
+		if err != nil {
+			return Method{}, fmt.Errorf("joining method %s: %w", r.definition.Ref, err)
+		}
+	}
 	return Method{
 		Ref:         r.definition.Ref,
 		Name:        r.definition.Name,
@@ -221,6 +228,8 @@ func (r Reading) method() (Method, error) {
 		Params:      params,
 		Files:       files,
 		Result:      res,
+		Paging:      paging,
+		Paged:       record.Paged,
 		Introduced:  r.definition.Introduced,
 	}, nil
 }
@@ -40,9 +40,10 @@ func (s Specification) Release() Release {
 // with what it owns, ordered by the position its source gave it. It fails when
 // a definition cannot be read as the record of its kind.
 func (s Specification) Definitions() ([]Definition, error) {
-	out := make([]Definition, 0)
+	owned := NewOwnership(s.db)
+	out := make([]Definition, 0, s.db.Definitions.Count())
 	for _, definition := range NewOrdered(s.db.Definitions).Value() {
-		record, err := NewReading(s.db, definition).Value()
+		record, err := NewReading(s.db, owned, definition).Value()
 		if err != nil {
 			return nil, err
 		}
@@ -4,7 +4,6 @@
 package ir
 
 import (
-	"cmp"
 	"fmt"
 	"slices"
 
@@ -17,13 +16,14 @@ import (
 // by the definition it addresses.
 type Variants struct {
 	db    separated.Specification
+	owned Ownership
 	owner model.Reference
 }
 
 // NewVariants constructs a Variants over the variants owner lists in the
-// database.
-func NewVariants(db separated.Specification, owner model.Reference) Variants {
-	return Variants{db: db, owner: owner}
+// database, as owned groups them.
+func NewVariants(db separated.Specification, owned Ownership, owner model.Reference) Variants {
+	return Variants{db: db, owned: owned, owner: owner}
 }
 
 // Value returns the variants of the union, ordered by the position their source
@@ -68,17 +68,7 @@ func (v Variants) Discriminated() (model.Key, []DiscriminatedVariant, error) {
 
 // records returns the raw variant records of the union, ordered by position.
 func (v Variants) records() []parsed.Variant {
-	out := make([]parsed.Variant, 0)
-	for key, record := range v.db.Variants.All() {
-		if key.Owner != v.owner {
-			continue
-		}
-		out = append(out, record)
-	}
-	slices.SortFunc(out, func(a, b parsed.Variant) int {
-		return cmp.Compare(a.Position, b.Position)
-	})
-	return out
+	return v.owned.Variants(v.owner)
 }
 
 // holds reports whether value already tells one of the variants apart, which no
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+// Package keyboard reads the variants of ReplyMarkup as the keyboards a bot
+// lays buttons out in, and the records of their buttons as the kinds of button
+// each can be, which is what a target writes keyboard builders from.
+//
+// A keyboard is a variant holding a field of rows of buttons — InlineKeyboard
+// of InlineKeyboardMarkup, Keyboard of ReplyKeyboardMarkup — and is found by
+// that shape rather than by name. The kinds of a button are declared nowhere
+// but in prose: the record of InlineKeyboardButton says that "exactly one of"
+// its optional fields must be used, which [ExclusionRule] reads. A button whose
+// record says no such thing, KeyboardButton among them, has no kinds and is
+// built as a literal.
+//
+// Nothing here is a pass, for the same reason as in package formatting: only a
+// target writing builders cares, so the keyboards are read off the records at
+// the pipeline's exit rather than carried through every stage as a table.
+package keyboard
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/andreychh/tgen/model/prose/grammar"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Ref is the reference of the union the keyboards are variants of.
+const Ref = model.Reference("replymarkup")
+
+// rowsDimensionality is how deep a keyboard nests its buttons: an array of
+// rows, each an array of buttons.
+const rowsDimensionality = 2
+
+// Keyboard is one variant of ReplyMarkup laying buttons out in rows: the
+// record of the markup, the field holding the rows, and the button they hold.
+type Keyboard struct {
+	Markup ir.Object
+	Rows   ir.Field
+	Button Button
+}
+
+// Button is the record of the button a keyboard lays out, ready to be read as
+// the kinds of button it stands for.
+type Button struct {
+	object ir.Object
+}
+
+// NewButton constructs a Button over the record of a button.
+func NewButton(object ir.Object) Button {
+	return Button{object: object}
+}
+
+// Lookup returns the keyboards among definitions, in the order ReplyMarkup
+// lists them, and nothing when the specification declares no ReplyMarkup. A
+// variant holding no rows of buttons, ReplyKeyboardRemove or ForceReply, is no
+// keyboard and is passed over.
+func Lookup(definitions []ir.Definition) []Keyboard {
+	objects := make(map[model.Name]ir.Object, len(definitions))
+	var union ir.Union
+	found := false
+	for _, definition := range definitions {
+		switch d := definition.(type) {
+		case ir.Object:
+			objects[d.Name] = d
+		case ir.Union:
+			if d.Ref == Ref {
+				union, found = d, true
+			}
+		}
+	}
+	if !found {
+		return nil
+	}
+	var out []Keyboard
+	for _, variant := range union.Variants {
+		markup, ok := objects[variant.Name]
+		if !ok {
+			continue
+		}
+		for _, field := range markup.Fields {
+			atom, ok := field.Type.Atom().(typebound.Object)
+			if !ok || field.Type.Dimensionality() != rowsDimensionality {
+				continue
+			}
+			button, ok := objects[atom.Name()]
+			if !ok {
+				continue
+			}
+			out = append(out, Keyboard{Markup: markup, Rows: field, Button: NewButton(button)})
+			break
+		}
+	}
+	return out
+}
+
+// Name returns the name the button is declared under.
+func (b Button) Name() model.Name {
+	return b.object.Name
+}
+
+// Required returns the fields every button must be given whatever its kind,
+// in the order the record declares them.
+func (b Button) Required() []ir.Field {
+	var out []ir.Field
+	for _, field := range b.object.Fields {
+		if !field.Optionality {
+			out = append(out, field)
+		}
+	}
+	return out
+}
+
+// Kinds returns the fields exactly one of which a button must be given, each
+// choosing a kind of button, in the order the record declares them, and
+// reports whether the record demands that at all. It fails when the record
+// excepts a field it does not declare, or leaves no field to choose from:
+// either means the page stopped reading the way this package reads it.
+func (b Button) Kinds() ([]ir.Field, bool, error) {
+	excepted, ok := b.exclusion()
+	if !ok {
+		return nil, false, nil
+	}
+	except := make(map[model.Key]bool, len(excepted))
+	for _, key := range excepted {
+		except[key] = true
+	}
+	var out []ir.Field
+	for _, field := range b.object.Fields {
+		if except[field.Key] {
+			delete(except, field.Key)
+			continue
+		}
+		if field.Optionality {
+			out = append(out, field)
+		}
+	}
+	for _, key := range excepted {
+		if except[key] {
+			return nil, false, fmt.Errorf("%s excepts %s, which it does not declare", b.object.Ref, key)
+		}
+	}
+	if len(out) == 0 {
+		return nil, false, fmt.Errorf("%s leaves no field to choose its kind from", b.object.Ref)
+	}
+	return out, true, nil
+}
+
+// exclusion returns the keys the record's "exactly one of" sentence excepts,
+// and reports whether any paragraph of its description says it.
+func (b Button) exclusion() ([]model.Key, bool) {
+	for _, block := range b.object.Description.Blocks() {
+		paragraph, ok := block.(prose.Paragraph)
+		if !ok {
+			continue
+		}
+		keys, ok := grammar.NewSearch[[]model.Key](NewExclusionRule()).Find(paragraph.Inlines())
+		if ok {
+			return keys, true
+		}
+	}
+	return nil, false
+}
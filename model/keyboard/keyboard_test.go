@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package keyboard_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/keyboard"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// plain builds an unemphasized run of a description.
+func plain(content string) prose.Text {
+	return prose.NewText(content, prose.StylePlain)
+}
+
+// italic builds the emphasized run a description sets a field name in.
+func italic(content string) prose.Text {
+	return prose.NewText(content, prose.StyleItalic)
+}
+
+// field builds a string field of a button.
+func field(key string, optional bool) ir.Field {
+	return ir.Field{
+		Key:         model.Key(key),
+		Type:        typebound.NewType(typebound.NewPrimitive(primitive.String), 0),
+		Optionality: model.Optionality(optional),
+	}
+}
+
+// button builds the record of InlineKeyboardButton described by inlines.
+func button(fields []ir.Field, inlines ...prose.Inline) keyboard.Button {
+	return keyboard.NewButton(ir.Object{
+		Ref:         "inlinekeyboardbutton",
+		Name:        "InlineKeyboardButton",
+		Description: prose.NewPassage(prose.NewParagraph(inlines...)),
+		Fields:      fields,
+	})
+}
+
+func TestButton_Kinds(t *testing.T) {
+	fields := []ir.Field{
+		field("text", false),
+		field("style", true),
+		field("url", true),
+		field("callback_data", true),
+	}
+	cases := []struct {
+		name   string
+		button keyboard.Button
+		want   []model.Key
+	}{
+		{
+			name: "takes every optional field when the record chooses among the optional ones",
+			button: button(fields, plain(
+				"This object represents one button of an inline keyboard. "+
+					"Exactly one of the optional fields must be used to specify type of the button.",
+			)),
+			want: []model.Key{"style", "url", "callback_data"},
+		},
+		{
+			name: "leaves out every field the record excepts",
+			button: button(fields,
+				plain("This object represents one button of an inline keyboard. Exactly one of the fields other than "),
+				italic("text"),
+				plain(", and "),
+				italic("style"),
+				plain(" must be used to specify the type of the button."),
+			),
+			want: []model.Key{"url", "callback_data"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			kinds, ok, err := tc.button.Kinds()
+			require.NoError(t, err)
+			require.True(t, ok, "Button.Kinds must report a record demanding exactly one of its fields")
+
+			keys := make([]model.Key, 0, len(kinds))
+			for _, kind := range kinds {
+				keys = append(keys, kind.Key)
+			}
+			assert.Equal(t, tc.want, keys, "Button.Kinds must give the fields a kind is chosen by, in the order the record declares them")
+		})
+	}
+}
+
+func TestButton_Kinds_none(t *testing.T) {
+	kinds, ok, err := button(
+		[]ir.Field{field("text", false), field("request_contact", true)},
+		plain("This object represents one button of the reply keyboard. At most one of the optional fields must be used."),
+	).Kinds()
+
+	require.NoError(t, err)
+	assert.False(t, ok, "Button.Kinds must report a record demanding no field")
+	assert.Empty(t, kinds)
+}
+
+func TestButton_Kinds_fails(t *testing.T) {
+	cases := []struct {
+		name   string
+		button keyboard.Button
+		want   string
+	}{
+		{
+			name: "when the record excepts a field it does not declare",
+			button: button([]ir.Field{field("text", false), field("url", true)},
+				plain("Exactly one of the fields other than "),
+				italic("icon"),
+				plain(" must be used."),
+			),
+			want: "inlinekeyboardbutton excepts icon, which it does not declare",
+		},
+		{
+			name: "when no field is left to choose from",
+			button: button([]ir.Field{field("text", false)},
+				plain("Exactly one of the optional fields must be used to specify type of the button."),
+			),
+			want: "inlinekeyboardbutton leaves no field to choose its kind from",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := tc.button.Kinds()
+			assert.EqualError(t, err, tc.want, "Button.Kinds must refuse a record it cannot read whole")
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	rows := func(name model.Name) typebound.Type {
+		return typebound.NewType(typebound.NewObject(name), 2)
+	}
+	definitions := []ir.Definition{
+		ir.Object{Name: "InlineKeyboardMarkup", Fields: []ir.Field{{Key: "inline_keyboard", Type: rows("InlineKeyboardButton")}}},
+		ir.Object{Name: "InlineKeyboardButton"},
+		ir.Object{Name: "ReplyKeyboardRemove", Fields: []ir.Field{field("remove_keyboard", false)}},
+		ir.Object{Name: "ReplyKeyboardMarkup", Fields: []ir.Field{{Key: "keyboard", Type: rows("KeyboardButton")}}},
+		ir.Object{Name: "KeyboardButton"},
+		ir.Union{Ref: keyboard.Ref, Name: "ReplyMarkup", Variants: []ir.Variant{
+			{Name: "InlineKeyboardMarkup"}, {Name: "ReplyKeyboardMarkup"}, {Name: "ReplyKeyboardRemove"},
+		}},
+	}
+
+	keyboards := keyboard.Lookup(definitions)
+
+	require.Len(t, keyboards, 2, "Lookup must find every variant of ReplyMarkup holding rows of buttons, and no other")
+	assert.Equal(t, model.Name("InlineKeyboardMarkup"), keyboards[0].Markup.Name)
+	assert.Equal(t, model.Key("inline_keyboard"), keyboards[0].Rows.Key)
+	assert.Equal(t, model.Name("InlineKeyboardButton"), keyboards[0].Button.Name())
+	assert.Equal(t, model.Name("KeyboardButton"), keyboards[1].Button.Name())
+
+	assert.Empty(t, keyboard.Lookup(definitions[:5]), "Lookup must find nothing in a specification declaring no ReplyMarkup")
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package keyboard
+
+import (
+	"regexp"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/andreychh/tgen/model/prose/grammar"
+)
+
+var (
+	optionalSignal  = regexp.MustCompile(`(?i)\bexactly one of the optional fields must be used\b`)
+	otherThanSignal = regexp.MustCompile(`(?i)\bexactly one of the fields other than\s*$`)
+	separator       = regexp.MustCompile(`^\s*,?\s*(?:and\s*)?$`)
+	closingSignal   = regexp.MustCompile(`^\s*must be used\b`)
+)
+
+// ExclusionRule is a [grammar.Rule] that recognizes the sentence a button's
+// description chooses its kind with, in either form the documentation has
+// written it: "Exactly one of the optional fields must be used", and "Exactly
+// one of the fields other than text, icon_custom_emoji_id, and style must be
+// used", the field names set in emphasis. It decodes the keys the sentence
+// excepts, which is none for the first form: its exception, the required
+// fields, is one no optional field falls under anyway.
+type ExclusionRule struct{}
+
+// NewExclusionRule constructs an ExclusionRule.
+func NewExclusionRule() ExclusionRule {
+	return ExclusionRule{}
+}
+
+// Match implements [grammar.Rule]. It reports false when the run at the front
+// does not open the sentence, or the sentence names no field before it closes.
+func (ExclusionRule) Match(inlines []prose.Inline) ([]model.Key, bool) {
+	if len(inlines) < 1 {
+		return nil, false
+	}
+	if grammar.NewMarker(optionalSignal).Matches(inlines[0]) {
+		return []model.Key{}, true
+	}
+	if !grammar.NewMarker(otherThanSignal).Matches(inlines[0]) {
+		return nil, false
+	}
+	var keys []model.Key
+	for _, inline := range inlines[1:] {
+		if key, ok := grammar.NewItalic().Matches(inline); ok {
+			keys = append(keys, model.Key(key))
+			continue
+		}
+		text, ok := inline.(prose.Text)
+		if !ok || text.Style() != prose.StylePlain {
+			return nil, false
+		}
+		if closingSignal.MatchString(text.Content()) {
+			return keys, len(keys) > 0
+		}
+		if !separator.MatchString(text.Content()) {
+			return nil, false
+		}
+	}
+	return nil, false
+}
@@ -15,6 +15,14 @@ type File struct {
 	Kind model.FileKind
 }
 
+// Edge is one pair a [Rule] holds: Carrier carries a file whenever Carried
+// does. It is its own key, a pair being in the relation at most once however
+// many fields state it.
+type Edge struct {
+	Carrier model.Reference
+	Carried model.Reference
+}
+
 // FileTable is the spreading operator: it starts from the type a file is sent
 // as and marks everything the rules can reach from there.
 type FileTable struct {
@@ -28,28 +36,52 @@ func NewFileTable(seed model.Reference, rules ...Rule) FileTable {
 }
 
 // Table returns every definition that can carry a file, keyed by reference. The
-// spread repeats until a round marks nothing new, so a definition holding its
-// own kind — a rich block nesting rich blocks — settles instead of circling.
+// pairs of every rule are indexed once by what they carry, and the spread walks
+// that index from the seed, visiting a definition only when it is first marked:
+// a definition holding its own kind — a rich block nesting rich blocks — is
+// marked already when the walk comes back to it, and settles instead of
+// circling.
 func (t FileTable) Table() Files {
+	carriers := pipeline.NewIndexedTable(t.edges(), carried{}).Apply()
 	out := pipeline.NewMapTable[model.Reference, File]()
 	out.Insert(t.seed, File{Ref: t.seed, Kind: model.FileKindFile})
-	for t.spread(out) {
+	pending := []model.Reference{t.seed}
+	for len(pending) > 0 {
+		ref := pending[0]
+		pending = pending[1:]
+		rows, _ := carriers.Lookup(ref)
+		for _, row := range rows {
+			carrier := row.Key.Carrier
+			if _, marked := out.Lookup(carrier); marked {
+				continue
+			}
+			out.Insert(carrier, File{Ref: carrier, Kind: model.FileKindCarrier})
+			pending = append(pending, carrier)
+		}
 	}
 	return out
 }
 
-// spread runs every rule once against out and reports whether the round marked
-// a definition out did not already hold.
-func (t FileTable) spread(out pipeline.MapTable[model.Reference, File]) bool {
-	grew := false
+// edges returns the pairs every rule holds, gathered into one table.
+func (t FileTable) edges() pipeline.Table[Edge, Edge] {
+	out := pipeline.NewMapTable[Edge, Edge]()
 	for _, rule := range t.rules {
-		for _, ref := range rule.Apply(out) {
-			if _, marked := out.Lookup(ref); marked {
+		for carrier, carried := range rule.Edges() {
+			edge := Edge{Carrier: carrier, Carried: carried}
+			if _, exists := out.Lookup(edge); exists {
 				continue
 			}
-			out.Insert(ref, File{Ref: ref, Kind: model.FileKindCarrier})
-			grew = true
+			out.Insert(edge, edge)
 		}
 	}
-	return grew
+	return out
+}
+
+// carried is the [pipeline.Projection] indexing a pair by what it carries,
+// which is the side the spread arrives from.
+type carried struct{}
+
+// Apply implements [pipeline.Projection]. Every pair carries something.
+func (carried) Apply(edge Edge, _ Edge) (model.Reference, bool) {
+	return edge.Carried, true
 }
@@ -4,20 +4,24 @@
 package attached
 
 import (
+	"iter"
+
 	"github.com/andreychh/tgen/model"
 	"github.com/andreychh/tgen/model/pipeline/flattened"
 	"github.com/andreychh/tgen/model/pipeline/parsed"
 	"github.com/andreychh/tgen/model/typeform"
 )
 
-// Rule reports which definitions one relation of the specification adds to
-// those already known to carry a file. A rule only reads the set it is given —
-// the table that owns the set decides what to do with the answer — and may name
-// a definition the set already holds.
+// Rule is one relation of the specification read as the pairs a file spreads
+// along. A pair says that its first definition carries a file whenever its
+// second one does; a rule only states the pairs — the table that owns the marks
+// decides what to do with them — and knows nothing of which definitions are
+// marked yet.
 type Rule interface {
-	// Apply returns the definitions that carry a file because a definition in
-	// files does.
-	Apply(files Files) []model.Reference
+	// Edges returns every pair the relation holds, the carrier first and what it
+	// carries second, in no particular order. A relation reaching a primitive
+	// holds no pair, a primitive being no definition a file could be found in.
+	Edges() iter.Seq2[model.Reference, model.Reference]
 }
 
 // FieldRule is the [Rule] of ownership: a definition carries a file when a
@@ -32,20 +36,19 @@ func NewFieldRule(fields flattened.Fields) FieldRule {
 	return FieldRule{fields: fields}
 }
 
-// Apply implements [Rule].
-func (r FieldRule) Apply(files Files) []model.Reference {
-	out := make([]model.Reference, 0)
-	for key, field := range r.fields.All() {
-		named, ok := field.Type.Atom().(typeform.Named)
-		if !ok {
-			continue
-		}
-		if _, carries := files.Lookup(named.Ref()); !carries {
-			continue
+// Edges implements [Rule].
+func (r FieldRule) Edges() iter.Seq2[model.Reference, model.Reference] {
+	return func(yield func(model.Reference, model.Reference) bool) {
+		for key, field := range r.fields.All() {
+			named, ok := field.Type.Atom().(typeform.Named)
+			if !ok {
+				continue
+			}
+			if !yield(key.Owner, named.Ref()) {
+				return
+			}
 		}
-		out = append(out, key.Owner)
 	}
-	return out
 }
 
 // VariantRule is the [Rule] of alternation: a union carries a file when one of
@@ -60,16 +63,15 @@ func NewVariantRule(variants parsed.Variants) VariantRule {
 	return VariantRule{variants: variants}
 }
 
-// Apply implements [Rule].
-func (r VariantRule) Apply(files Files) []model.Reference {
-	out := make([]model.Reference, 0)
-	for key := range r.variants.All() {
-		if _, carries := files.Lookup(key.Ref); !carries {
-			continue
+// Edges implements [Rule].
+func (r VariantRule) Edges() iter.Seq2[model.Reference, model.Reference] {
+	return func(yield func(model.Reference, model.Reference) bool) {
+		for key := range r.variants.All() {
+			if !yield(key.Owner, key.Ref) {
+				return
+			}
 		}
-		out = append(out, key.Owner)
 	}
-	return out
 }
 
 // AliasRule is the [Rule] of standing for: an alias carries a file when the
@@ -83,18 +85,17 @@ func NewAliasRule(aliases flattened.Aliases) AliasRule {
 	return AliasRule{aliases: aliases}
 }
 
-// Apply implements [Rule].
-func (r AliasRule) Apply(files Files) []model.Reference {
-	out := make([]model.Reference, 0)
-	for ref, alias := range r.aliases.All() {
-		named, ok := alias.Type.Atom().(typeform.Named)
-		if !ok {
-			continue
-		}
-		if _, carries := files.Lookup(named.Ref()); !carries {
-			continue
+// Edges implements [Rule].
+func (r AliasRule) Edges() iter.Seq2[model.Reference, model.Reference] {
+	return func(yield func(model.Reference, model.Reference) bool) {
+		for ref, alias := range r.aliases.All() {
+			named, ok := alias.Type.Atom().(typeform.Named)
+			if !ok {
+				continue
+			}
+			if !yield(ref, named.Ref()) {
+				return
+			}
 		}
-		out = append(out, ref)
 	}
-	return out
 }
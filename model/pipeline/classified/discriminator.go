@@ -32,18 +32,52 @@ func NewDiscriminatorTable(fields parsed.Fields) DiscriminatorTable {
 
 // Apply returns the discriminators table, one record per field at position
 // zero whose description decodes a fixed discriminator value, keyed by the
-// reference of the field's owner.
+// reference of the field's owner. Decoding a description reads that description
+// alone, so the fields are decoded at once and then collected in the order the
+// table iterates them in.
 func (t DiscriminatorTable) Apply() Discriminators {
 	out := pipeline.NewMapTable[model.Reference, Discriminator]()
-	for key, field := range t.fields.All() {
-		if field.Position != 0 {
-			continue
-		}
-		value, ok := discriminator.NewLabel(field.Description).Value()
-		if !ok {
+	first := pipeline.NewFilteredTable(t.fields, firstFilter{}).Apply()
+	labels, err := pipeline.NewParallelTable(first, labelMapping{}).Apply()
+	if err != nil {
+		return out
+	}
+	for key, label := range labels.All() {
+		if !label.found {
 			continue
 		}
-		out.Insert(key.Owner, Discriminator{Key: field.Key, Value: value})
+		out.Insert(key.Owner, label.discriminator)
 	}
 	return out
 }
+
+// firstFilter is the [pipeline.Filter] keeping the field at position zero, the
+// only one whose description may name the discriminator of its owner.
+type firstFilter struct{}
+
+// Apply implements [pipeline.Filter].
+func (firstFilter) Apply(_ model.FieldKey, field parsed.Field) bool {
+	return field.Position == 0
+}
+
+// label is what the description of one field decodes to: the discriminator it
+// names, if found says it names one.
+type label struct {
+	discriminator Discriminator
+	found         bool
+}
+
+// labelMapping is the [pipeline.Mapping] decoding the description of a field
+// for the fixed discriminator value it may carry. It holds no state, so
+// [pipeline.ParallelTable] may run it on many fields at once.
+type labelMapping struct{}
+
+// Apply implements [pipeline.Mapping]. A description naming no value is not a
+// failure, only a field that is no discriminator, so it never fails.
+func (labelMapping) Apply(field parsed.Field) (label, error) {
+	value, ok := discriminator.NewLabel(field.Description).Value()
+	if !ok {
+		return label{}, nil
+	}
+	return label{discriminator: Discriminator{Key: field.Key, Value: value}, found: true}, nil
+}
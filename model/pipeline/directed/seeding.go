@@ -5,6 +5,7 @@ package directed
 
 import (
 	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
 	"github.com/andreychh/tgen/model/pipeline/flattened"
 	"github.com/andreychh/tgen/model/typeform"
 )
@@ -42,14 +43,17 @@ func (s Seeding) Value() []Seed {
 // outbound returns one seed for every parameter of a method naming a
 // definition, since a request carries whatever the method it invokes was given.
 // A parameter typed as a primitive names no definition and seeds nothing, and a
-// field of an object is no parameter, however alike the two are held.
+// field of an object is no parameter, however alike the two are held: joining
+// the fields with the methods on their owner keeps the parameters alone.
 func (s Seeding) outbound() []Seed {
-	out := make([]Seed, 0)
-	for key, field := range s.fields.All() {
-		if _, invoked := s.methods.Lookup(key.Owner); !invoked {
-			continue
-		}
-		named, ok := field.Type.Atom().(typeform.Named)
+	parameters := pipeline.NewJoinedTable(
+		s.fields,
+		s.methods,
+		pipeline.NewFieldOwner[flattened.Field](),
+	).Apply()
+	out := make([]Seed, 0, parameters.Count())
+	for _, parameter := range parameters.All() {
+		named, ok := parameter.Left.Type.Atom().(typeform.Named)
 		if !ok {
 			continue
 		}
@@ -3,7 +3,10 @@
 
 package directed
 
-import "github.com/andreychh/tgen/model"
+import (
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
+)
 
 // Seed is one definition the traversal starts from, and how it is reached. A
 // seed stands for what no [Rule] can say: a parameter goes out because it is
@@ -15,8 +18,16 @@ type Seed struct {
 	Reach Reach
 }
 
+// Edge is one pair a [Rule] puts in the graph: whatever reaches From reaches To
+// unchanged. It is its own key, a pair being in the graph at most once however
+// many relations state it.
+type Edge struct {
+	From model.Reference
+	To   model.Reference
+}
+
 // Spread is the traversal: it starts from the seeds and follows every edge the
-// rules put in the graph until a round raises nothing.
+// rules put in the graph until nothing rises any more.
 type Spread struct {
 	seeds []Seed
 	rules []Rule
@@ -28,30 +39,54 @@ func NewSpread(seeds []Seed, rules ...Rule) Spread {
 	return Spread{seeds: seeds, rules: rules}
 }
 
-// Value returns how far every definition was reached. The rounds repeat until
-// one raises nothing, so a definition holding its own kind — a rich block
-// nesting rich blocks — settles instead of circling.
+// Value returns how far every definition was reached. The edges of every rule
+// are indexed once by their source, and a definition is visited again only when
+// what is known of it rises. A reach rises twice at most, so every edge is
+// followed a bounded number of times, and a definition holding its own kind — a
+// rich block nesting rich blocks — settles instead of circling.
 func (s Spread) Value() Reaches {
+	targets := pipeline.NewIndexedTable(s.edges(), source{}).Apply()
 	out := NewReaches()
+	pending := make([]model.Reference, 0, len(s.seeds))
 	for _, seed := range s.seeds {
-		out.Merge(seed.Ref, seed.Reach)
+		if out.Merge(seed.Ref, seed.Reach) {
+			pending = append(pending, seed.Ref)
+		}
 	}
-	for s.round(out) {
+	for len(pending) > 0 {
+		from := pending[0]
+		pending = pending[1:]
+		rows, _ := targets.Lookup(from)
+		for _, row := range rows {
+			if out.Merge(row.Key.To, out.Lookup(from)) {
+				pending = append(pending, row.Key.To)
+			}
+		}
 	}
 	return out
 }
 
-// round follows every edge once, carrying what is known of the source into the
-// target, and reports whether that raised anything. An edge leading out of a
-// definition nothing reached carries [ReachNone] and raises nothing, so no edge
-// needs a test of its own.
-func (s Spread) round(out Reaches) bool {
-	grew := false
+// edges returns the pairs every rule puts in the graph, gathered into one
+// table.
+func (s Spread) edges() pipeline.Table[Edge, Edge] {
+	out := pipeline.NewMapTable[Edge, Edge]()
 	for _, rule := range s.rules {
 		for from, to := range rule.Edges() {
-			raised := out.Merge(to, out.Lookup(from))
-			grew = grew || raised
+			edge := Edge{From: from, To: to}
+			if _, exists := out.Lookup(edge); exists {
+				continue
+			}
+			out.Insert(edge, edge)
 		}
 	}
-	return grew
+	return out
+}
+
+// source is the [pipeline.Projection] indexing an edge by the definition it
+// leads out of, which is the side a reach arrives from.
+type source struct{}
+
+// Apply implements [pipeline.Projection]. Every edge leads out of something.
+func (source) Apply(edge Edge, _ Edge) (model.Reference, bool) {
+	return edge.From, true
 }
@@ -6,21 +6,27 @@ package pipeline
 import (
 	"fmt"
 	"iter"
-	"maps"
 )
 
-// MapTable is the in-memory implementation of [Table]. Its zero value is not
-// usable; construct one with [NewMapTable] or [NewMapTableWithCapacity]. A
-// MapTable is not safe for concurrent use.
+// MapTable is the in-memory implementation of [Table]. It yields its records in
+// the order they were inserted, which is what makes every table of the pipeline
+// iterate the same way on every run: the first pass inserts in the order the
+// page lists things, and every pass after it inserts in the order it iterates
+// the tables before it. Its zero value is not usable; construct one with
+// [NewMapTable] or [NewMapTableWithCapacity]. A MapTable is not safe for
+// concurrent use.
 type MapTable[K comparable, R Record] struct {
-	inner map[K]R
+	index map[K]int
+	rows  *[]Row[K, R]
 }
 
 // NewMapTableWithCapacity constructs an empty MapTable with room preallocated
 // for cap records.
 func NewMapTableWithCapacity[K comparable, R Record](cap int) MapTable[K, R] {
+	rows := make([]Row[K, R], 0, cap)
 	return MapTable[K, R]{
-		inner: make(map[K]R, cap),
+		index: make(map[K]int, cap),
+		rows:  &rows,
 	}
 }
 
@@ -30,21 +36,32 @@ func NewMapTable[K comparable, R Record]() MapTable[K, R] {
 }
 
 func (m MapTable[K, R]) Insert(key K, record R) {
-	if _, exists := m.inner[key]; exists {
+	if _, exists := m.index[key]; exists {
 		panic(fmt.Sprintf("MapTable.Insert: key %v already present", key))
 	}
-	m.inner[key] = record
+	m.index[key] = len(*m.rows)
+	*m.rows = append(*m.rows, Row[K, R]{Key: key, Record: record})
 }
 
 func (m MapTable[K, R]) Lookup(key K) (R, bool) {
-	row, exists := m.inner[key]
-	return row, exists
+	at, exists := m.index[key]
+	if !exists {
+		var zero R
+		return zero, false
+	}
+	return (*m.rows)[at].Record, true
 }
 
 func (m MapTable[K, R]) Count() int {
-	return len(m.inner)
+	return len(*m.rows)
 }
 
 func (m MapTable[K, R]) All() iter.Seq2[K, R] {
-	return maps.All(m.inner)
+	return func(yield func(K, R) bool) {
+		for _, row := range *m.rows {
+			if !yield(row.Key, row.Record) {
+				return
+			}
+		}
+	}
 }
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// Digest identifies the input a stage ran over by the hash of its bytes, so
+// that two runs over the same page are known to be the same run without the
+// page being compared.
+type Digest [sha256.Size]byte
+
+// NewDigest returns the digest of input.
+func NewDigest(input []byte) Digest {
+	return sha256.Sum256(input)
+}
+
+// String returns the digest as hexadecimal.
+func (d Digest) String() string {
+	return hex.EncodeToString(d[:])
+}
+
+// Memo remembers the result of a stage by the digest of what it ran over. A
+// stage is a function of its input and of nothing else, and a table is never
+// mutated once built, so a result computed once can be handed to every caller
+// asking again — a process rendering several targets from one page parses and
+// types that page once.
+//
+// A failure is remembered as well as a success: the same input fails the same
+// way, and running the stage again would only say so slower. A stage that
+// panics panics in the call running it and is remembered as a failure by every
+// other. A Memo is safe for
+// concurrent use; a caller asking while the stage runs waits for that run rather
+// than starting another. Its zero value is not usable; construct one with
+// [NewMemo].
+type Memo[V any] struct {
+	mu      *sync.Mutex
+	entries map[Digest]*memoEntry[V]
+}
+
+// memoEntry is one remembered result, settled once its run is done.
+type memoEntry[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// NewMemo constructs an empty Memo.
+func NewMemo[V any]() Memo[V] {
+	return Memo[V]{mu: &sync.Mutex{}, entries: make(map[Digest]*memoEntry[V])}
+}
+
+// Value returns the result remembered under digest, running stage to compute it
+// when nothing is remembered yet. It fails when the stage failed, whichever call
+// ran it.
+func (m Memo[V]) Value(digest Digest, stage func() (V, error)) (V, error) {
+	m.mu.Lock()
+	entry, found := m.entries[digest]
+	if !found {
+		entry = &memoEntry[V]{done: make(chan struct{})}
+		m.entries[digest] = entry
+	}
+	m.mu.Unlock()
+	if found {
+		<-entry.done
+		return entry.value, entry.err
+	}
+	entry.run(stage)
+	return entry.value, entry.err
+}
+
+// run settles the entry with the result of stage. A stage that panics settles
+// it with the failure the panic stands for before the panic goes on, so that a
+// caller waiting on the entry, or asking after it, fails rather than waits for
+// a run that never ends.
+func (e *memoEntry[V]) run(stage func() (V, error)) {
+	defer close(e.done)
+	defer func() {
+		if r := recover(); r != nil {
+			e.err = fmt.Errorf("stage panicked: %v", r)
+			panic(r)
+		}
+	}()
+	e.value, e.err = stage()
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"cmp"
+
+	"github.com/andreychh/tgen/model"
+)
+
+// KeyOrder is the [Order] of a table keyed by a string or a number — a
+// definition by its reference, say — comparing keys and nothing else. Keys of
+// one table are never equal, so it tells every two rows apart, and a table
+// sorted by it iterates alike whatever order it was built in.
+type KeyOrder[K cmp.Ordered, R Record] struct{}
+
+// NewKeyOrder constructs a KeyOrder.
+func NewKeyOrder[K cmp.Ordered, R Record]() KeyOrder[K, R] {
+	return KeyOrder[K, R]{}
+}
+
+// Compare implements [Order].
+func (KeyOrder[K, R]) Compare(a, b Row[K, R]) int {
+	return cmp.Compare(a.Key, b.Key)
+}
+
+// FieldKeyOrder is the key [Order] of a table of fields: by the reference of
+// the owner first, and by the key of the field within it.
+type FieldKeyOrder[R Record] struct{}
+
+// NewFieldKeyOrder constructs a FieldKeyOrder.
+func NewFieldKeyOrder[R Record]() FieldKeyOrder[R] {
+	return FieldKeyOrder[R]{}
+}
+
+// Compare implements [Order].
+func (FieldKeyOrder[R]) Compare(a, b Row[model.FieldKey, R]) int {
+	return cmp.Or(
+		cmp.Compare(a.Key.Owner, b.Key.Owner),
+		cmp.Compare(a.Key.Key, b.Key.Key),
+	)
+}
+
+// VariantKeyOrder is the key [Order] of a table of variants: by the reference
+// of the union first, and by the reference of the variant within it.
+type VariantKeyOrder[R Record] struct{}
+
+// NewVariantKeyOrder constructs a VariantKeyOrder.
+func NewVariantKeyOrder[R Record]() VariantKeyOrder[R] {
+	return VariantKeyOrder[R]{}
+}
+
+// Compare implements [Order].
+func (VariantKeyOrder[R]) Compare(a, b Row[model.VariantKey, R]) int {
+	return cmp.Or(
+		cmp.Compare(a.Key.Owner, b.Key.Owner),
+		cmp.Compare(a.Key.Ref, b.Key.Ref),
+	)
+}
+
+// PositionOrder is the [Order] the page gives: rows by the [model.Position] a
+// projection reads from each record, which is where the page listed it. Records
+// of different kinds keep their position in different fields, so the reading is
+// left to the projection. A row it reads no position from comes after every row
+// it does, and rows sharing a position are not told apart — the fields of two
+// owners both start at zero — so a [SortedTable] keeps them in the order its
+// source iterates them in.
+type PositionOrder[K comparable, R Record] struct {
+	position Projection[K, R, model.Position]
+}
+
+// NewPositionOrder constructs the order of the positions position reads.
+func NewPositionOrder[K comparable, R Record](position Projection[K, R, model.Position]) PositionOrder[K, R] {
+	return PositionOrder[K, R]{position: position}
+}
+
+// Compare implements [Order].
+func (o PositionOrder[K, R]) Compare(a, b Row[K, R]) int {
+	first, placed := o.position.Apply(a.Key, a.Record)
+	second, known := o.position.Apply(b.Key, b.Record)
+	switch {
+	case placed && known:
+		return cmp.Compare(first, second)
+	case placed:
+		return -1
+	case known:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
+)
+
+// positioned reads the position of a field, and none from a field the page
+// never placed, which a negative position stands for here.
+type positioned struct{}
+
+func (positioned) Apply(_ model.FieldKey, record field) (model.Position, bool) {
+	return record.Position, record.Position >= 0
+}
+
+func TestMapTable_All(t *testing.T) {
+	table := pipeline.NewMapTable[model.Reference, int]()
+	want := []model.Reference{"update", "message", "chat", "user", "animation", "audio"}
+	for at, ref := range want {
+		table.Insert(ref, at)
+	}
+	for range 8 {
+		got := make([]model.Reference, 0)
+		for ref := range table.All() {
+			got = append(got, ref)
+		}
+		assert.Equal(t, want, got, "a map table must iterate in the order it was inserted in")
+	}
+}
+
+func TestKeyOrder_Compare(t *testing.T) {
+	sorted := pipeline.NewSortedTable(fields(), pipeline.NewFieldKeyOrder[field]()).Apply()
+	assert.Equal(t,
+		[]model.FieldKey{
+			{Owner: "message", Key: "chat"},
+			{Owner: "message", Key: "from"},
+			{Owner: "message", Key: "message_id"},
+			{Owner: "update", Key: "message"},
+			{Owner: "update", Key: "update_id"},
+		},
+		keys(sorted.Rows()),
+		"a key order must sort by owner and then by key",
+	)
+	refs := pipeline.NewMapTable[model.Reference, int]()
+	refs.Insert("user", 0)
+	refs.Insert("chat", 1)
+	assert.Equal(t,
+		[]model.Reference{"chat", "user"},
+		keys(pipeline.NewSortedTable(refs, pipeline.NewKeyOrder[model.Reference, int]()).Apply().Rows()),
+		"a key order must sort by key",
+	)
+}
+
+func TestPositionOrder_Compare(t *testing.T) {
+	table := pipeline.NewMapTable[model.FieldKey, field]()
+	table.Insert(model.FieldKey{Owner: "message", Key: "via_bot"}, field{Position: -1})
+	table.Insert(model.FieldKey{Owner: "message", Key: "chat"}, field{Position: 1})
+	table.Insert(model.FieldKey{Owner: "update", Key: "update_id"}, field{Position: 0})
+	table.Insert(model.FieldKey{Owner: "message", Key: "message_id"}, field{Position: 0})
+	sorted := pipeline.NewSortedTable(table, pipeline.NewPositionOrder(positioned{})).Apply()
+	assert.Equal(t,
+		[]model.FieldKey{
+			{Owner: "update", Key: "update_id"},
+			{Owner: "message", Key: "message_id"},
+			{Owner: "message", Key: "chat"},
+			{Owner: "message", Key: "via_bot"},
+		},
+		keys(sorted.Rows()),
+		"a position order must sort by position, keep ties as inserted, and put the unplaced last",
+	)
+}
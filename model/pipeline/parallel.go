@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// ParallelTable is the projection operator of [MappedTable] run across records
+// at once: a mapping that reads one record and nothing else — no sibling, no
+// table — may map every record of a table on a worker of its own. The mapping
+// must be safe for concurrent use, which a mapping holding no state is.
+//
+// Running at once changes nothing a caller can see. The records are put into the
+// table it returns in the order source iterates in, as [MappedTable] puts them,
+// and when several records fail the failure reported is the one of the record
+// earliest in that order, whichever worker got to it first.
+type ParallelTable[K comparable, A, B Record] struct {
+	source  Table[K, A]
+	mapping Mapping[A, B]
+	workers int
+}
+
+// NewParallelTableWithWorkers constructs the projection of source through
+// mapping, run on at most workers records at once. A count below one runs one
+// record at a time.
+func NewParallelTableWithWorkers[K comparable, A, B Record](
+	source Table[K, A], mapping Mapping[A, B], workers int,
+) ParallelTable[K, A, B] {
+	return ParallelTable[K, A, B]{source: source, mapping: mapping, workers: max(workers, 1)}
+}
+
+// NewParallelTable constructs the projection of source through mapping, run on
+// as many records at once as the process has processors to run them on.
+func NewParallelTable[K comparable, A, B Record](
+	source Table[K, A], mapping Mapping[A, B],
+) ParallelTable[K, A, B] {
+	return NewParallelTableWithWorkers(source, mapping, runtime.GOMAXPROCS(0))
+}
+
+// Apply returns the projected table, one record per source record under the
+// same key. It fails when the mapping fails on any record.
+func (t ParallelTable[K, A, B]) Apply() (Table[K, B], error) {
+	rows := make([]Row[K, A], 0, t.source.Count())
+	for key, record := range t.source.All() {
+		rows = append(rows, Row[K, A]{Key: key, Record: record})
+	}
+	mapped := make([]B, len(rows))
+	failures := make([]error, len(rows))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(t.workers, len(rows)) {
+		wg.Go(func() {
+			for at := range jobs {
+				mapped[at], failures[at] = t.mapping.Apply(rows[at].Record)
+			}
+		})
+	}
+	for at := range rows {
+		jobs <- at
+	}
+	close(jobs)
+	wg.Wait()
+	out := NewMapTableWithCapacity[K, B](len(rows))
+	for at, row := range rows {
+		if failures[at] != nil {
+			return out, fmt.Errorf("mapping record %v: %w", row.Key, failures[at])
+		}
+		out.Insert(row.Key, mapped[at])
+	}
+	return out, nil
+}
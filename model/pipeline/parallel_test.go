@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pipeline_test
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/model/pipeline"
+)
+
+// byNumber orders a table of numbers by key.
+type byNumber struct{}
+
+func (byNumber) Compare(a, b pipeline.Row[int, int]) int {
+	return cmp.Compare(a.Key, b.Key)
+}
+
+// squared maps a number to its square, and fails on every multiple of fail.
+type squared struct {
+	fail int
+}
+
+func (m squared) Apply(n int) (int, error) {
+	if m.fail > 0 && n%m.fail == 0 {
+		return 0, fmt.Errorf("%d is refused", n)
+	}
+	return n * n, nil
+}
+
+// numbers builds the numbers below n, keyed by themselves, in the order of
+// their keys.
+func numbers(n int) pipeline.Table[int, int] {
+	out := pipeline.NewMapTable[int, int]()
+	for i := range n {
+		out.Insert(i, i)
+	}
+	return pipeline.NewSortedTable(out, byNumber{}).Apply()
+}
+
+func TestParallelTable_Apply(t *testing.T) {
+	t.Run("maps every record under its own key", func(t *testing.T) {
+		got, err := pipeline.NewParallelTableWithWorkers(numbers(100), squared{}, 8).Apply()
+		require.NoError(t, err, "a mapping failing nowhere must not fail the table")
+		assert.Equal(t, 100, got.Count(), "a parallel table must hold every record")
+		for key, record := range got.All() {
+			assert.Equal(t, key*key, record, "a parallel table must map a record under its key")
+		}
+	})
+	t.Run("holds what a mapped table holds", func(t *testing.T) {
+		got, err := pipeline.NewParallelTable(numbers(100), squared{}).Apply()
+		require.NoError(t, err, "a mapping failing nowhere must not fail the table")
+		want, err := pipeline.NewMappedTable(numbers(100), squared{}).Apply()
+		require.NoError(t, err, "a mapping failing nowhere must not fail the table")
+		for key, record := range want.All() {
+			mapped, found := got.Lookup(key)
+			assert.True(t, found, "a parallel table must hold every key a mapped one holds")
+			assert.Equal(t, record, mapped, "a parallel table must map a record as a mapped one does")
+		}
+	})
+	t.Run("reports the failure of the earliest record", func(t *testing.T) {
+		for range 20 {
+			_, err := pipeline.NewParallelTableWithWorkers(numbers(200), squared{fail: 7}, 16).Apply()
+			require.Error(t, err, "a mapping failing somewhere must fail the table")
+			assert.Equal(t, "mapping record 0: 0 is refused", err.Error(),
+				"a parallel table must report the record earliest in its source")
+		}
+	})
+	t.Run("runs one record at a time when given no worker", func(t *testing.T) {
+		got, err := pipeline.NewParallelTableWithWorkers(numbers(10), squared{}, 0).Apply()
+		require.NoError(t, err, "a mapping failing nowhere must not fail the table")
+		assert.Equal(t, 10, got.Count(), "a parallel table must hold every record")
+	})
+}
+
+func TestMemo_Value(t *testing.T) {
+	t.Run("runs the stage once per digest", func(t *testing.T) {
+		memo := pipeline.NewMemo[int]()
+		runs := 0
+		stage := func() (int, error) {
+			runs++
+			return 42, nil
+		}
+		digest := pipeline.NewDigest([]byte("<html></html>"))
+		for range 3 {
+			got, err := memo.Value(digest, stage)
+			require.NoError(t, err, "a stage succeeding must not fail the memo")
+			assert.Equal(t, 42, got, "a memo must hand back the result of the stage")
+		}
+		assert.Equal(t, 1, runs, "a memo must not run a stage twice over one input")
+	})
+	t.Run("runs the stage again for another digest", func(t *testing.T) {
+		memo := pipeline.NewMemo[string]()
+		for _, page := range []string{"first", "second"} {
+			got, err := memo.Value(pipeline.NewDigest([]byte(page)), func() (string, error) {
+				return page, nil
+			})
+			require.NoError(t, err, "a stage succeeding must not fail the memo")
+			assert.Equal(t, page, got, "a memo must tell two inputs apart")
+		}
+	})
+	t.Run("remembers a failure", func(t *testing.T) {
+		memo := pipeline.NewMemo[int]()
+		digest := pipeline.NewDigest([]byte("broken"))
+		_, err := memo.Value(digest, func() (int, error) { return 0, errors.New("malformed") })
+		require.Error(t, err, "a stage failing must fail the memo")
+		_, err = memo.Value(digest, func() (int, error) { return 1, nil })
+		assert.EqualError(t, err, "malformed", "a memo must remember a failure")
+	})
+	t.Run("runs the stage once for callers asking at once", func(t *testing.T) {
+		memo := pipeline.NewMemo[int]()
+		digest := pipeline.NewDigest([]byte("shared"))
+		runs := make(chan struct{}, 16)
+		done := make(chan int)
+		for range 16 {
+			go func() {
+				got, _ := memo.Value(digest, func() (int, error) {
+					runs <- struct{}{}
+					return 7, nil
+				})
+				done <- got
+			}()
+		}
+		for range 16 {
+			assert.Equal(t, 7, <-done, "a memo must hand every caller the same result")
+		}
+		assert.Len(t, runs, 1, "a memo must not run a stage twice for callers asking at once")
+	})
+	t.Run("fails the callers waiting on a stage that panics", func(t *testing.T) {
+		memo := pipeline.NewMemo[int]()
+		digest := pipeline.NewDigest([]byte("panicking"))
+		entered := make(chan struct{})
+		failed := make(chan error)
+		go func() {
+			<-entered
+			_, err := memo.Value(digest, func() (int, error) { return 1, nil })
+			failed <- err
+		}()
+		assert.PanicsWithValue(t, "boom", func() {
+			_, _ = memo.Value(digest, func() (int, error) {
+				close(entered)
+				panic("boom")
+			})
+		}, "a memo must let the panic of a stage go on in the call running it")
+		assert.EqualError(t, <-failed, "stage panicked: boom",
+			"a memo must fail a caller waiting on a stage that panicked rather than block it")
+	})
+}
@@ -21,6 +21,9 @@ type Table[K comparable, R Record] interface {
 	Lookup(key K) (R, bool)
 	// Count returns the number of records in the table.
 	Count() int
-	// All returns an iterator over every key and its record, in unspecified order.
+	// All returns an iterator over every key and its record. A table decides the
+	// order and keeps to it: iterating one table twice yields the same order, and so
+	// does iterating it in two runs over the same page, so that a pass reporting the
+	// first record it rejects reports the same one every time.
 	All() iter.Seq2[K, R]
 }
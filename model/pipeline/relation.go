@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/andreychh/tgen/model"
+)
+
+// Row is one record of a table together with the key it is held under. A table
+// hands its records out as pairs; a row is how an operator keeps the pair once
+// the table that held it is no longer what the records are looked up in.
+type Row[K comparable, R Record] struct {
+	Key    K
+	Record R
+}
+
+// Projection reads from a record, held under key, the value an operator
+// indexes, groups or joins it by. It never fails, but a record may have no such
+// value — a field typed as a primitive names no definition — which the boolean
+// reports, and an operator leaves such a record out.
+type Projection[K comparable, R Record, V comparable] interface {
+	Apply(key K, record R) (V, bool)
+}
+
+// Order decides which of two rows of a table comes first: a negative number
+// when a does, a positive one when b does, and zero when the order does not tell
+// them apart.
+type Order[K comparable, R Record] interface {
+	Compare(a, b Row[K, R]) int
+}
+
+// Sequence is a [Table] that remembers an order other than the one its records
+// were inserted in: All gives them back in the order a [SortedTable] put them,
+// where a [MapTable] gives them back in the order they were inserted. Its zero
+// value is not usable; a Sequence is built by [SortedTable].
+type Sequence[K comparable, R Record] struct {
+	rows  []Row[K, R]
+	index map[K]int
+}
+
+// Lookup implements [Table].
+func (s Sequence[K, R]) Lookup(key K) (R, bool) {
+	at, exists := s.index[key]
+	if !exists {
+		var zero R
+		return zero, false
+	}
+	return s.rows[at].Record, true
+}
+
+// Count implements [Table].
+func (s Sequence[K, R]) Count() int {
+	return len(s.rows)
+}
+
+// All implements [Table]. Unlike the table it was sorted from, it yields every
+// record in order.
+func (s Sequence[K, R]) All() iter.Seq2[K, R] {
+	return func(yield func(K, R) bool) {
+		for _, row := range s.rows {
+			if !yield(row.Key, row.Record) {
+				return
+			}
+		}
+	}
+}
+
+// Rows returns every row of the sequence, in order.
+func (s Sequence[K, R]) Rows() []Row[K, R] {
+	return s.rows
+}
+
+// SortedTable is the ordering operator: it puts the records of a source table
+// in the order an [Order] gives them. Rows the order cannot tell apart keep the
+// order source iterates them in, so sorting a table by position leaves two
+// records sharing a position where the table had them.
+type SortedTable[K comparable, R Record] struct {
+	source Table[K, R]
+	order  Order[K, R]
+}
+
+// NewSortedTable constructs the ordering of source by order.
+func NewSortedTable[K comparable, R Record](source Table[K, R], order Order[K, R]) SortedTable[K, R] {
+	return SortedTable[K, R]{source: source, order: order}
+}
+
+// Apply returns the records of source as a sequence in the order the order
+// gives them.
+func (t SortedTable[K, R]) Apply() Sequence[K, R] {
+	rows := make([]Row[K, R], 0, t.source.Count())
+	for key, record := range t.source.All() {
+		rows = append(rows, Row[K, R]{Key: key, Record: record})
+	}
+	slices.SortStableFunc(rows, t.order.Compare)
+	index := make(map[K]int, len(rows))
+	for at, row := range rows {
+		index[row.Key] = at
+	}
+	return Sequence[K, R]{rows: rows, index: index}
+}
+
+// IndexedTable is the secondary-index operator: it keys every record of a
+// source table by the value a projection reads from it, so that the records
+// sharing a value are looked up at once rather than found by a scan of the whole
+// table. One value may stand for many records, so each is held with every row
+// reading it, in the order source iterates in.
+type IndexedTable[K comparable, R Record, V comparable] struct {
+	source     Table[K, R]
+	projection Projection[K, R, V]
+}
+
+// NewIndexedTable constructs the index of source by projection.
+func NewIndexedTable[K comparable, R Record, V comparable](
+	source Table[K, R], projection Projection[K, R, V],
+) IndexedTable[K, R, V] {
+	return IndexedTable[K, R, V]{source: source, projection: projection}
+}
+
+// Apply returns the index: every value projection reads, with the rows reading
+// it. A record projection reads nothing from is in no row.
+func (t IndexedTable[K, R, V]) Apply() Table[V, []Row[K, R]] {
+	groups := make(map[V][]Row[K, R])
+	order := make([]V, 0)
+	for key, record := range t.source.All() {
+		value, ok := t.projection.Apply(key, record)
+		if !ok {
+			continue
+		}
+		if _, seen := groups[value]; !seen {
+			order = append(order, value)
+		}
+		groups[value] = append(groups[value], Row[K, R]{Key: key, Record: record})
+	}
+	out := NewMapTableWithCapacity[V, []Row[K, R]](len(groups))
+	for _, value := range order {
+		out.Insert(value, groups[value])
+	}
+	return out
+}
+
+// GroupedTable is the grouping operator: it is an [IndexedTable] whose groups
+// keep the order an [Order] gives them, which is what a pass asks for when it
+// wants the fields of one owner in the order the page lists them.
+type GroupedTable[K comparable, R Record, V comparable] struct {
+	source     Table[K, R]
+	projection Projection[K, R, V]
+	order      Order[K, R]
+}
+
+// NewGroupedTable constructs the grouping of source by projection, each group
+// ordered by order.
+func NewGroupedTable[K comparable, R Record, V comparable](
+	source Table[K, R], projection Projection[K, R, V], order Order[K, R],
+) GroupedTable[K, R, V] {
+	return GroupedTable[K, R, V]{source: source, projection: projection, order: order}
+}
+
+// Apply returns every value projection reads, with the rows reading it in the
+// order the order gives them.
+func (t GroupedTable[K, R, V]) Apply() Table[V, []Row[K, R]] {
+	return NewIndexedTable[K, R, V](
+		NewSortedTable(t.source, t.order).Apply(),
+		t.projection,
+	).Apply()
+}
+
+// Joined is one record of a join: a record of the left table and the record of
+// the right table it was matched with.
+type Joined[L, R Record] struct {
+	Left  L
+	Right R
+}
+
+// JoinedTable is the equi-join operator: it matches every record of a left
+// table with the record a right table holds under the key a projection reads
+// from it — a field with the definition its type names, say. The join is
+// inner: a left record projection reads nothing from, or reads a key the right
+// table does not hold, is left out.
+type JoinedTable[K comparable, L Record, J comparable, R Record] struct {
+	left       Table[K, L]
+	right      Table[J, R]
+	projection Projection[K, L, J]
+}
+
+// NewJoinedTable constructs the join of left with right on the key projection
+// reads from every left record.
+func NewJoinedTable[K comparable, L Record, J comparable, R Record](
+	left Table[K, L], right Table[J, R], projection Projection[K, L, J],
+) JoinedTable[K, L, J, R] {
+	return JoinedTable[K, L, J, R]{left: left, right: right, projection: projection}
+}
+
+// Apply returns the matched records, each under the key of its left record.
+func (t JoinedTable[K, L, J, R]) Apply() Table[K, Joined[L, R]] {
+	out := NewMapTableWithCapacity[K, Joined[L, R]](t.left.Count())
+	for key, left := range t.left.All() {
+		on, ok := t.projection.Apply(key, left)
+		if !ok {
+			continue
+		}
+		right, found := t.right.Lookup(on)
+		if !found {
+			continue
+		}
+		out.Insert(key, Joined[L, R]{Left: left, Right: right})
+	}
+	return out
+}
+
+// FieldOwner is the [Projection] reading the owner out of the key of a field,
+// which groups the fields table by the definition owning each field.
+type FieldOwner[R Record] struct{}
+
+// NewFieldOwner constructs a FieldOwner.
+func NewFieldOwner[R Record]() FieldOwner[R] {
+	return FieldOwner[R]{}
+}
+
+// Apply implements [Projection]. Every field has an owner.
+func (FieldOwner[R]) Apply(key model.FieldKey, _ R) (model.Reference, bool) {
+	return key.Owner, true
+}
+
+// VariantOwner is the [Projection] reading the union out of the key of a
+// variant, which groups the variants table by the union listing each variant.
+type VariantOwner[R Record] struct{}
+
+// NewVariantOwner constructs a VariantOwner.
+func NewVariantOwner[R Record]() VariantOwner[R] {
+	return VariantOwner[R]{}
+}
+
+// Apply implements [Projection]. Every variant has a union listing it.
+func (VariantOwner[R]) Apply(key model.VariantKey, _ R) (model.Reference, bool) {
+	return key.Owner, true
+}
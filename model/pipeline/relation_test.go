@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pipeline_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
+)
+
+// field is the record the tests group and join: a position within its owner and
+// the definition its type names, empty for a primitive.
+type field struct {
+	Position model.Position
+	Type     model.Reference
+}
+
+// byPosition is the order a definition lists its fields in.
+type byPosition struct{}
+
+func (byPosition) Compare(a, b pipeline.Row[model.FieldKey, field]) int {
+	return cmp.Compare(a.Record.Position, b.Record.Position)
+}
+
+// typed reads the definition a field's type names, and nothing from a field
+// typed as a primitive.
+type typed struct{}
+
+func (typed) Apply(_ model.FieldKey, record field) (model.Reference, bool) {
+	return record.Type, record.Type != ""
+}
+
+// fields builds a table of the fields two owners hold, inserted out of order.
+func fields() pipeline.Table[model.FieldKey, field] {
+	out := pipeline.NewMapTable[model.FieldKey, field]()
+	out.Insert(model.FieldKey{Owner: "message", Key: "chat"}, field{Position: 2, Type: "chat"})
+	out.Insert(model.FieldKey{Owner: "message", Key: "message_id"}, field{Position: 0})
+	out.Insert(model.FieldKey{Owner: "update", Key: "message"}, field{Position: 1, Type: "message"})
+	out.Insert(model.FieldKey{Owner: "message", Key: "from"}, field{Position: 1, Type: "user"})
+	out.Insert(model.FieldKey{Owner: "update", Key: "update_id"}, field{Position: 0})
+	return out
+}
+
+func keys[K comparable, R pipeline.Record](rows []pipeline.Row[K, R]) []K {
+	out := make([]K, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.Key)
+	}
+	return out
+}
+
+func TestSortedTable_Apply(t *testing.T) {
+	t.Run("iterates the records in the order given", func(t *testing.T) {
+		sorted := pipeline.NewSortedTable(
+			pipeline.NewFilteredTable(fields(), owner("message")).Apply(),
+			byPosition{},
+		).Apply()
+		got := make([]model.Key, 0)
+		for key := range sorted.All() {
+			got = append(got, key.Key)
+		}
+		assert.Equal(t, []model.Key{"message_id", "from", "chat"}, got,
+			"a sorted table must iterate in the order given")
+	})
+	t.Run("keeps every record looked up by its key", func(t *testing.T) {
+		sorted := pipeline.NewSortedTable(fields(), byPosition{}).Apply()
+		record, found := sorted.Lookup(model.FieldKey{Owner: "update", Key: "message"})
+		assert.True(t, found, "a sorted table must hold every record of its source")
+		assert.Equal(t, field{Position: 1, Type: "message"}, record,
+			"a sorted table must hold a record under the key it was held under")
+		assert.Equal(t, 5, sorted.Count(), "a sorted table must count every record")
+	})
+}
+
+func TestGroupedTable_Apply(t *testing.T) {
+	grouped := pipeline.NewGroupedTable(
+		fields(),
+		pipeline.NewFieldOwner[field](),
+		byPosition{},
+	).Apply()
+	rows, found := grouped.Lookup("message")
+	assert.True(t, found, "a grouped table must hold a group for every owner")
+	assert.Equal(t,
+		[]model.FieldKey{
+			{Owner: "message", Key: "message_id"},
+			{Owner: "message", Key: "from"},
+			{Owner: "message", Key: "chat"},
+		},
+		keys(rows),
+		"a group must hold the fields of its owner alone, in the order given",
+	)
+	assert.Equal(t, 2, grouped.Count(), "a grouped table must hold one group per owner")
+}
+
+func TestIndexedTable_Apply(t *testing.T) {
+	indexed := pipeline.NewIndexedTable(fields(), typed{}).Apply()
+	rows, found := indexed.Lookup("message")
+	assert.True(t, found, "an index must hold every value read")
+	assert.Equal(t,
+		[]model.FieldKey{{Owner: "update", Key: "message"}},
+		keys(rows),
+		"an index must hold a record under the value read from it",
+	)
+	assert.Equal(t, 3, indexed.Count(),
+		"an index must leave out a record nothing is read from")
+}
+
+func TestJoinedTable_Apply(t *testing.T) {
+	definitions := pipeline.NewMapTable[model.Reference, model.Name]()
+	definitions.Insert("chat", "Chat")
+	definitions.Insert("message", "Message")
+	joined := pipeline.NewJoinedTable(fields(), definitions, typed{}).Apply()
+	record, found := joined.Lookup(model.FieldKey{Owner: "message", Key: "chat"})
+	assert.True(t, found, "a join must hold a record its projection matches")
+	assert.Equal(t,
+		pipeline.Joined[field, model.Name]{Left: field{Position: 2, Type: "chat"}, Right: "Chat"},
+		record,
+		"a join must pair a record with the one it matched",
+	)
+	_, found = joined.Lookup(model.FieldKey{Owner: "message", Key: "from"})
+	assert.False(t, found, "a join must leave out a record matching nothing")
+	assert.Equal(t, 2, joined.Count(),
+		"a join must leave out a record its projection reads nothing from")
+}
+
+// owner keeps the fields of one definition.
+type owner model.Reference
+
+func (o owner) Apply(key model.FieldKey, _ field) bool {
+	return key.Owner == model.Reference(o)
+}
@@ -46,13 +46,15 @@ func NewPass(spec typed.Specification) Pass {
 
 // Specification returns the resolved specification, decoding the return type of
 // every definition of method kind from its description prose into a type
-// expression. It fails when any method's return type prose cannot be decoded.
+// expression. Each return is read from its own method's prose, so every method
+// is resolved at once. It fails when any method's return type prose cannot be
+// decoded.
 func (p Pass) Specification() (Specification, error) {
 	definitions := pipeline.NewFilteredTable(
 		p.spec.Definitions,
 		parsed.NewKindFilter(model.DefinitionKindMethod),
 	).Apply()
-	methods, err := pipeline.NewMappedTable(definitions, NewMethodMapping()).Apply()
+	methods, err := pipeline.NewParallelTable(definitions, NewMethodMapping()).Apply()
 	if err != nil {
 		return Specification{}, fmt.Errorf("resolving return types: %w", err)
 	}
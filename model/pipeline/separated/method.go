@@ -12,26 +12,37 @@ import (
 )
 
 // Method is what a method has of its own, with its return split into what the
-// method signals: a confirmation of success, or the value it carries.
+// method signals: a confirmation of success, or the value it carries. Paged
+// reports that the value is one page of a collection the method hands out a
+// page at a time, and Paging how; it is the zero Paging for any other method.
 type Method struct {
 	Ref    model.Reference
 	Result result.Result
+	Paging Paging
+	Paged  bool
 }
 
 // MethodMapping maps a flattened method into a separated one by deciding
-// whether its return carries data or only reports success.
-type MethodMapping struct{}
+// whether its return carries data or only reports success, and whether that
+// data is a page.
+type MethodMapping struct {
+	paging PagingRule
+}
 
-// NewMethodMapping constructs a MethodMapping.
-func NewMethodMapping() MethodMapping {
-	return MethodMapping{}
+// NewMethodMapping constructs a MethodMapping recognizing paged methods by
+// paging.
+func NewMethodMapping(paging PagingRule) MethodMapping {
+	return MethodMapping{paging: paging}
 }
 
 // Apply implements [pipeline.Mapping]. It never fails.
 func (m MethodMapping) Apply(method flattened.Method) (Method, error) {
+	paging, paged := m.paging.Match(method)
 	return Method{
 		Ref:    method.Ref,
 		Result: m.classify(method.Type),
+		Paging: paging,
+		Paged:  paged,
 	}, nil
 }
 
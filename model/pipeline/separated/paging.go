@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package separated
+
+import (
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
+	"github.com/andreychh/tgen/model/pipeline/flattened"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/typeform"
+)
+
+const (
+	offsetKey = model.Key("offset")
+	limitKey  = model.Key("limit")
+	totalKey  = model.Key("total_count")
+)
+
+// Paging is how a method hands a collection out a page at a time: the
+// parameters it takes the position of the first item and the size of the page
+// by, the object the page comes back as, the field of that object holding the
+// items, and the one counting every item there is. Total is empty for a page
+// that does not count them, which leaves an empty page as the only sign that
+// the collection is exhausted.
+type Paging struct {
+	Offset model.Key
+	Limit  model.Key
+	Page   model.Reference
+	Items  model.Key
+	Total  model.Key
+}
+
+// PagingRule recognizes a paged method by its shape, since the documentation
+// declares paging nowhere but in the prose of each method. A method is paged
+// when it takes an optional integer offset and an optional integer limit, and
+// returns a single object holding one array and, at most, the integer count of
+// everything there is. Each condition rules out a method that looks paged and
+// is not: getUpdates returns its updates bare, and its offset confirms them
+// rather than skipping them; the gift methods take a string offset, a cursor
+// their page hands back rather than a position a caller can count; and a
+// result holding anything but the items and their count is more than a page,
+// which a walker handing out the items alone would lose.
+type PagingRule struct {
+	fields flattened.Fields
+	owned  pipeline.Table[model.Reference, []pipeline.Row[model.FieldKey, flattened.Field]]
+}
+
+// NewPagingRule constructs a PagingRule over a table of fields, which holds
+// both the parameters of a method and the fields of the object it returns. The
+// fields are grouped by owner once, here, so that reading the fields of a page
+// is a lookup rather than a scan of the whole table per method.
+func NewPagingRule(fields flattened.Fields) PagingRule {
+	return PagingRule{
+		fields: fields,
+		owned: pipeline.NewGroupedTable(
+			fields,
+			pipeline.NewFieldOwner[flattened.Field](),
+			pipeline.NewFieldKeyOrder[flattened.Field](),
+		).Apply(),
+	}
+}
+
+// Match returns how method hands its collection out, and reports whether it
+// has the shape of a paged method at all.
+func (r PagingRule) Match(method flattened.Method) (Paging, bool) {
+	if !r.counter(model.FieldKey{Owner: method.Ref, Key: offsetKey}, true) ||
+		!r.counter(model.FieldKey{Owner: method.Ref, Key: limitKey}, true) {
+		return Paging{}, false
+	}
+	named, ok := method.Type.Atom().(typeform.Named)
+	if !ok || method.Type.Dimensionality() != 0 {
+		return Paging{}, false
+	}
+	paging := Paging{Offset: offsetKey, Limit: limitKey, Page: named.Ref(), Items: "", Total: ""}
+	rows, _ := r.owned.Lookup(named.Ref())
+	for _, row := range rows {
+		switch {
+		case row.Key.Key == totalKey && r.counter(row.Key, false):
+			paging.Total = row.Key.Key
+		case row.Record.Type.Dimensionality() > 0 && paging.Items == "":
+			paging.Items = row.Key.Key
+		default:
+			return Paging{}, false
+		}
+	}
+	if paging.Items == "" {
+		return Paging{}, false
+	}
+	return paging, true
+}
+
+// counter reports whether key names an integer of the given optionality,
+// which is what both parameters of a paged method and the count of its page
+// are.
+func (r PagingRule) counter(key model.FieldKey, optional bool) bool {
+	field, found := r.fields.Lookup(key)
+	if !found {
+		return false
+	}
+	atom, ok := field.Type.Atom().(typeform.Primitive)
+	return ok && atom.Kind() == primitive.Integer &&
+		field.Type.Dimensionality() == 0 &&
+		bool(field.Optionality) == optional
+}
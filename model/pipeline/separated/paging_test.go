@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package separated_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
+	"github.com/andreychh/tgen/model/pipeline/flattened"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/typeform"
+)
+
+// field is a parameter of a method or a field of the object it returns.
+type field struct {
+	owner    model.Reference
+	key      model.Key
+	atom     typeform.Atom
+	dims     typeform.Dimensionality
+	optional model.Optionality
+}
+
+// fields builds the table of fields a PagingRule reads.
+func fields(all []field) flattened.Fields {
+	table := pipeline.NewMapTable[model.FieldKey, flattened.Field]()
+	for at, f := range all {
+		table.Insert(model.FieldKey{Owner: f.owner, Key: f.key}, flattened.Field{
+			Key:         f.key,
+			Position:    model.Position(at),
+			Type:        typeform.NewType(f.atom, f.dims),
+			Optionality: f.optional,
+		})
+	}
+	return table
+}
+
+func TestPagingRule_Match(t *testing.T) {
+	integer := typeform.NewPrimitive(primitive.Integer)
+	str := typeform.NewPrimitive(primitive.String)
+	cases := []struct {
+		name   string
+		fields []field
+		method flattened.Method
+		want   separated.Paging
+		paged  bool
+	}{
+		{
+			name: "recognizes a counted page",
+			fields: []field{
+				{owner: "getuserprofilephotos", key: "user_id", atom: integer},
+				{owner: "getuserprofilephotos", key: "offset", atom: integer, optional: true},
+				{owner: "getuserprofilephotos", key: "limit", atom: integer, optional: true},
+				{owner: "userprofilephotos", key: "total_count", atom: integer},
+				{owner: "userprofilephotos", key: "photos", atom: typeform.NewNamed("photosize"), dims: 2},
+			},
+			method: flattened.Method{
+				Ref:  "getuserprofilephotos",
+				Type: typeform.NewType(typeform.NewNamed("userprofilephotos"), 0),
+			},
+			want: separated.Paging{
+				Offset: "offset",
+				Limit:  "limit",
+				Page:   "userprofilephotos",
+				Items:  "photos",
+				Total:  "total_count",
+			},
+			paged: true,
+		},
+		{
+			name: "recognizes a page counting nothing",
+			fields: []field{
+				{owner: "getstartransactions", key: "offset", atom: integer, optional: true},
+				{owner: "getstartransactions", key: "limit", atom: integer, optional: true},
+				{owner: "startransactions", key: "transactions", atom: typeform.NewNamed("startransaction"), dims: 1},
+			},
+			method: flattened.Method{
+				Ref:  "getstartransactions",
+				Type: typeform.NewType(typeform.NewNamed("startransactions"), 0),
+			},
+			want: separated.Paging{
+				Offset: "offset",
+				Limit:  "limit",
+				Page:   "startransactions",
+				Items:  "transactions",
+				Total:  "",
+			},
+			paged: true,
+		},
+		{
+			name: "rejects getUpdates, which returns its updates bare",
+			fields: []field{
+				{owner: "getupdates", key: "offset", atom: integer, optional: true},
+				{owner: "getupdates", key: "limit", atom: integer, optional: true},
+				{owner: "getupdates", key: "timeout", atom: integer, optional: true},
+				{owner: "getupdates", key: "allowed_updates", atom: str, dims: 1, optional: true},
+			},
+			method: flattened.Method{
+				Ref:  "getupdates",
+				Type: typeform.NewType(typeform.NewNamed("update"), 1),
+			},
+		},
+		{
+			name: "rejects a gift method, whose offset is a string cursor",
+			fields: []field{
+				{owner: "getbusinessaccountgifts", key: "business_connection_id", atom: str},
+				{owner: "getbusinessaccountgifts", key: "offset", atom: str, optional: true},
+				{owner: "getbusinessaccountgifts", key: "limit", atom: integer, optional: true},
+				{owner: "ownedgifts", key: "total_count", atom: integer},
+				{owner: "ownedgifts", key: "gifts", atom: typeform.NewNamed("ownedgift"), dims: 1},
+				{owner: "ownedgifts", key: "next_offset", atom: str, optional: true},
+			},
+			method: flattened.Method{
+				Ref:  "getbusinessaccountgifts",
+				Type: typeform.NewType(typeform.NewNamed("ownedgifts"), 0),
+			},
+		},
+		{
+			name: "rejects a page holding a field besides its items and their count",
+			fields: []field{
+				{owner: "getchatboosts", key: "offset", atom: integer, optional: true},
+				{owner: "getchatboosts", key: "limit", atom: integer, optional: true},
+				{owner: "chatboosts", key: "total_count", atom: integer},
+				{owner: "chatboosts", key: "boosts", atom: typeform.NewNamed("chatboost"), dims: 1},
+				{owner: "chatboosts", key: "chat", atom: typeform.NewNamed("chat")},
+			},
+			method: flattened.Method{
+				Ref:  "getchatboosts",
+				Type: typeform.NewType(typeform.NewNamed("chatboosts"), 0),
+			},
+		},
+		{
+			name: "rejects a page holding two arrays",
+			fields: []field{
+				{owner: "getchatboosts", key: "offset", atom: integer, optional: true},
+				{owner: "getchatboosts", key: "limit", atom: integer, optional: true},
+				{owner: "chatboosts", key: "boosts", atom: typeform.NewNamed("chatboost"), dims: 1},
+				{owner: "chatboosts", key: "sources", atom: typeform.NewNamed("chatboostsource"), dims: 1},
+			},
+			method: flattened.Method{
+				Ref:  "getchatboosts",
+				Type: typeform.NewType(typeform.NewNamed("chatboosts"), 0),
+			},
+		},
+		{
+			name: "rejects a page holding no array",
+			fields: []field{
+				{owner: "getchatboosts", key: "offset", atom: integer, optional: true},
+				{owner: "getchatboosts", key: "limit", atom: integer, optional: true},
+				{owner: "chatboosts", key: "total_count", atom: integer},
+			},
+			method: flattened.Method{
+				Ref:  "getchatboosts",
+				Type: typeform.NewType(typeform.NewNamed("chatboosts"), 0),
+			},
+		},
+		{
+			name: "rejects a method taking no limit",
+			fields: []field{
+				{owner: "getstartransactions", key: "offset", atom: integer, optional: true},
+				{owner: "startransactions", key: "transactions", atom: typeform.NewNamed("startransaction"), dims: 1},
+			},
+			method: flattened.Method{
+				Ref:  "getstartransactions",
+				Type: typeform.NewType(typeform.NewNamed("startransactions"), 0),
+			},
+		},
+		{
+			name: "rejects a method requiring its offset",
+			fields: []field{
+				{owner: "getstartransactions", key: "offset", atom: integer},
+				{owner: "getstartransactions", key: "limit", atom: integer, optional: true},
+				{owner: "startransactions", key: "transactions", atom: typeform.NewNamed("startransaction"), dims: 1},
+			},
+			method: flattened.Method{
+				Ref:  "getstartransactions",
+				Type: typeform.NewType(typeform.NewNamed("startransactions"), 0),
+			},
+		},
+		{
+			name: "rejects a method returning a primitive",
+			fields: []field{
+				{owner: "getcount", key: "offset", atom: integer, optional: true},
+				{owner: "getcount", key: "limit", atom: integer, optional: true},
+			},
+			method: flattened.Method{
+				Ref:  "getcount",
+				Type: typeform.NewType(integer, 0),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, paged := separated.NewPagingRule(fields(tc.fields)).Match(tc.method)
+			assert.Equal(t, tc.paged, paged, "PagingRule.Match must report whether the method has the shape of a paged one")
+			assert.Equal(t, tc.want, got, "PagingRule.Match must name the parameters and fields the method pages by")
+		})
+	}
+}
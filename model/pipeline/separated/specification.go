@@ -2,7 +2,8 @@
 // SPDX-License-Identifier: MIT
 
 // Package separated splits each method's return into what the method signals:
-// a command that only reports success, or a value that carries data.
+// a command that only reports success, or a value that carries data — and, for
+// a method handing a collection out by offset and limit, one page of it.
 package separated
 
 import (
@@ -39,7 +40,7 @@ type Specification struct {
 
 // Pass is the separation stage: it rewrites a directed specification into a
 // separated one, deciding for every method whether its return carries data or
-// only reports success.
+// only reports success, and whether that data is a page.
 type Pass struct {
 	spec directed.Specification
 }
@@ -50,10 +51,12 @@ func NewPass(spec directed.Specification) Pass {
 }
 
 // Specification returns the separated specification, splitting every method's
-// return into a command or a value. Classifying a return cannot fail, so the
+// return into a command or a value and recognizing the paged ones by the
+// fields they take and return. Classifying a return cannot fail, so the
 // returned error is always nil.
 func (p Pass) Specification() (Specification, error) {
-	methods, err := pipeline.NewMappedTable(p.spec.Methods, NewMethodMapping()).Apply()
+	mapping := NewMethodMapping(NewPagingRule(p.spec.Fields))
+	methods, err := pipeline.NewMappedTable(p.spec.Methods, mapping).Apply()
 	if err != nil {
 		return Specification{}, fmt.Errorf("separating methods: %w", err)
 	}
@@ -41,10 +41,11 @@ func NewPass(spec unified.Specification) Pass {
 }
 
 // Specification returns the typed specification, resolving every field's type
-// prose into a type expression. It fails when any field's type prose cannot be
-// decoded.
+// prose into a type expression. The prose of one field says nothing about any
+// other, so every field is typed at once. It fails when any field's type prose
+// cannot be decoded.
 func (p Pass) Specification() (Specification, error) {
-	fields, err := pipeline.NewMappedTable(p.spec.Fields, NewFieldMapping()).Apply()
+	fields, err := pipeline.NewParallelTable(p.spec.Fields, NewFieldMapping()).Apply()
 	if err != nil {
 		return Specification{}, fmt.Errorf("typing fields: %w", err)
 	}
@@ -42,14 +42,15 @@ func NewPass(spec classified.Specification) Pass {
 }
 
 // Specification returns the unified specification, merging object fields and
-// method parameters into one table. It fails when any field or parameter cannot
+// method parameters into one table. A field is unified from its own row alone,
+// so every row is unified at once. It fails when any field or parameter cannot
 // be unified.
 func (p Pass) Specification() (Specification, error) {
-	fields, err := pipeline.NewMappedTable(p.spec.Fields, NewFieldMapping()).Apply()
+	fields, err := pipeline.NewParallelTable(p.spec.Fields, NewFieldMapping()).Apply()
 	if err != nil {
 		return Specification{}, fmt.Errorf("unifying fields: %w", err)
 	}
-	params, err := pipeline.NewMappedTable(p.spec.Params, NewParamMapping()).Apply()
+	params, err := pipeline.NewParallelTable(p.spec.Params, NewParamMapping()).Apply()
 	if err != nil {
 		return Specification{}, fmt.Errorf("unifying parameters: %w", err)
 	}
@@ -5,8 +5,10 @@ package output
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 )
 
 // Fileset represents a set of [Artifacts] that can be written to the file
@@ -21,14 +23,17 @@ func NewFileset(a Artifacts) Fileset {
 }
 
 // Emit writes all artifacts to path, creating it and any missing parents if
-// they do not exist. Returns an error if the directory cannot be created or any
-// artifact fails to render.
+// they do not exist. Artifacts are written in the order of their file names, so
+// that when several fail to render the one reported is the same on every run.
+// Returns an error if the directory cannot be created or any artifact fails to
+// render.
 func (f Fileset) Emit(path string) error {
 	err := os.MkdirAll(path, 0o750)
 	if err != nil {
 		return fmt.Errorf("creating output directory: %w", err)
 	}
-	for filename, view := range f.artifacts {
+	for _, filename := range slices.Sorted(maps.Keys(f.artifacts)) {
+		view := f.artifacts[filename]
 		err := f.renderFile(filepath.Join(path, filename), view)
 		if err != nil {
 			return fmt.Errorf("rendering file %q: %w", filename, err)
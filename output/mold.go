@@ -7,6 +7,7 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"text/template"
 )
@@ -14,13 +15,26 @@ import (
 // Mold represents a set of embedded templates configured with a function map,
 // ready to produce a [template.Template].
 type Mold struct {
-	fs    embed.FS
-	funcs template.FuncMap
+	fs       embed.FS
+	funcs    template.FuncMap
+	patterns []string
 }
 
-// NewMold creates a Mold from the given embedded filesystem and function map.
+// NewMold creates a Mold from the given embedded filesystem and function map,
+// parsing the templates directly under its templates directory.
 func NewMold(fs embed.FS, funcs template.FuncMap) Mold {
-	return Mold{fs: fs, funcs: funcs}
+	return Mold{fs: fs, funcs: funcs, patterns: []string{"templates/*.tmpl"}}
+}
+
+// Layered returns a copy of the Mold that also parses the templates directly
+// under dir, a directory of the templates directory. A target rendering the
+// same files in more than one way keeps what every way shares at the top and
+// what one way spells differently in a directory of its own, each defining the
+// same names: the shared templates invoke a name and the layer parsed decides
+// what it writes. A name two layers both define is not caught here, which is why
+// a target parses one layer at a time.
+func (m Mold) Layered(dir string) Mold {
+	return Mold{fs: m.fs, funcs: m.funcs, patterns: append(slices.Clone(m.patterns), "templates/"+dir+"/*.tmpl")}
 }
 
 // Template parses all templates in the embedded filesystem and returns a
@@ -65,5 +79,5 @@ func (m Mold) Template() (*template.Template, error) {
 			}
 			return name
 		},
-	}).ParseFS(m.fs, "templates/*.tmpl")
+	}).ParseFS(m.fs, m.patterns...)
 }
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package parity
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/andreychh/tgen/model"
+	legacy "github.com/andreychh/tgen/model/ir"
+)
+
+// Legacy is the facts the legacy chain states about a page. The chain declares
+// objects, discriminated objects and unions, and methods; every other union it
+// knows only by the name a field's type gives it, and a target declares it by
+// hand, so no definition fact is stated for one.
+type Legacy struct {
+	spec legacy.Specification
+}
+
+// NewLegacy creates a Legacy over the specification the legacy chain narrowed.
+func NewLegacy(spec legacy.Specification) Legacy {
+	return Legacy{spec: spec}
+}
+
+// Facts returns the facts. It fails when the chain fails to answer for any
+// record it holds.
+func (l Legacy) Facts() (Facts, error) {
+	out := make(Facts)
+	for o := range l.spec.Objects() {
+		ref, err := o.Reference()
+		if err != nil {
+			return nil, fmt.Errorf("reading object: %w", err)
+		}
+		out[Claim{AspectDefinition, string(ref)}] = kindObject
+		err = l.fields(out, ref, o.Fields())
+		if err != nil {
+			return nil, err
+		}
+	}
+	for d := range l.spec.DiscriminatedObjects() {
+		ref, err := d.Reference()
+		if err != nil {
+			return nil, fmt.Errorf("reading discriminated object: %w", err)
+		}
+		out[Claim{AspectDefinition, string(ref)}] = kindDiscriminatedObject
+		value, err := l.discriminator(d)
+		if err != nil {
+			return nil, fmt.Errorf("reading discriminator of %q: %w", ref, err)
+		}
+		out[Claim{AspectDiscriminator, string(ref)}] = value
+		err = l.fields(out, ref, d.Fields().Free())
+		if err != nil {
+			return nil, err
+		}
+	}
+	for u := range l.spec.DiscriminatedUnions() {
+		err := l.union(out, u)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for m := range l.spec.Methods() {
+		err := l.method(out, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// union states the facts of a discriminated union and of every variant it
+// admits.
+func (l Legacy) union(out Facts, u legacy.DiscriminatedUnion) error {
+	ref, err := u.Reference()
+	if err != nil {
+		return fmt.Errorf("reading discriminated union: %w", err)
+	}
+	key, err := u.DiscriminatorKey()
+	if err != nil {
+		return fmt.Errorf("reading key of %q: %w", ref, err)
+	}
+	out[Claim{AspectDefinition, string(ref)}] = kindDiscriminatedUnion
+	out[Claim{AspectDiscriminator, string(ref)}] = string(key)
+	for v := range u.Variants() {
+		name, err := v.Name()
+		if err != nil {
+			return fmt.Errorf("reading variant of %q: %w", ref, err)
+		}
+		value, err := v.Fields().Discriminator().Value()
+		if err != nil {
+			return fmt.Errorf("reading variant %q of %q: %w", name, ref, err)
+		}
+		out[Claim{AspectVariant, member(ref, string(name))}] = string(value)
+	}
+	return nil
+}
+
+// method states the facts of a method, its parameters and its result.
+func (l Legacy) method(out Facts, m legacy.Method) error {
+	ref, err := m.Reference()
+	if err != nil {
+		return fmt.Errorf("reading method: %w", err)
+	}
+	out[Claim{AspectDefinition, string(ref)}] = kindMethod
+	err = l.fields(out, ref, m.Fields())
+	if err != nil {
+		return err
+	}
+	result, err := m.Result()
+	if err != nil {
+		return fmt.Errorf("reading result of %q: %w", ref, err)
+	}
+	switch result := result.(type) {
+	case legacy.Command:
+		out[Claim{AspectResult, string(ref)}] = confirmation
+	case legacy.Value:
+		typ, err := l.typ(result.Type())
+		if err != nil {
+			return fmt.Errorf("reading result of %q: %w", ref, err)
+		}
+		out[Claim{AspectResult, string(ref)}] = typ
+	default:
+		return fmt.Errorf("reading result of %q: unknown result %T", ref, result)
+	}
+	return nil
+}
+
+// fields states the type and the optionality of every field owner holds.
+func (l Legacy) fields(out Facts, owner model.Reference, fields iter.Seq[legacy.Field]) error {
+	for f := range fields {
+		key, err := f.Key()
+		if err != nil {
+			return fmt.Errorf("reading field of %q: %w", owner, err)
+		}
+		typ, err := f.Type()
+		if err != nil {
+			return fmt.Errorf("reading type of %q: %w", member(owner, string(key)), err)
+		}
+		spelling, err := l.typ(typ)
+		if err != nil {
+			return fmt.Errorf("reading type of %q: %w", member(owner, string(key)), err)
+		}
+		opt, err := f.Optionality()
+		if err != nil {
+			return fmt.Errorf("reading optionality of %q: %w", member(owner, string(key)), err)
+		}
+		out[Claim{AspectType, member(owner, string(key))}] = spelling
+		out[Claim{AspectOptionality, member(owner, string(key))}] = optionality(opt)
+	}
+	return nil
+}
+
+// discriminator returns how a fact spells the key and value d is told apart
+// by.
+func (l Legacy) discriminator(d legacy.DiscriminatedObject) (string, error) {
+	key, err := d.Fields().Discriminator().Key()
+	if err != nil {
+		return "", err
+	}
+	value, err := d.Fields().Discriminator().Value()
+	if err != nil {
+		return "", err
+	}
+	return discriminated(key, value), nil
+}
+
+// typ returns how a fact spells typ.
+func (l Legacy) typ(typ legacy.Type) (string, error) {
+	name, err := typ.Name()
+	if err != nil {
+		return "", err
+	}
+	dim, err := typ.Dimensionality()
+	if err != nil {
+		return "", err
+	}
+	return spelled(name, dim), nil
+}
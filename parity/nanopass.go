@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package parity
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// admitted is how a fact spells a variant of a union no key tells apart, which
+// the union admits without any value to tell it by.
+const admitted = "admitted"
+
+// Nanopass is the facts the nanopass pipeline states about a page, read off the
+// records it ends in. It declares every union and alias it introduces, which
+// the legacy chain leaves to a target, so those show up as facts only this
+// side states.
+type Nanopass struct {
+	spec ir.Specification
+}
+
+// NewNanopass creates a Nanopass over the records of the nanopass pipeline.
+func NewNanopass(spec ir.Specification) Nanopass {
+	return Nanopass{spec: spec}
+}
+
+// Facts returns the facts. It fails when the records cannot be read.
+func (n Nanopass) Facts() (Facts, error) {
+	definitions, err := n.spec.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading records: %w", err)
+	}
+	out := make(Facts)
+	for _, definition := range definitions {
+		switch d := definition.(type) {
+		case ir.Object:
+			out[Claim{AspectDefinition, string(d.Ref)}] = kindObject
+			n.fields(out, d.Ref, d.Fields)
+		case ir.DiscriminatedObject:
+			out[Claim{AspectDefinition, string(d.Ref)}] = kindDiscriminatedObject
+			out[Claim{AspectDiscriminator, string(d.Ref)}] = discriminated(d.Discriminator.Key, d.Discriminator.Value)
+			n.fields(out, d.Ref, d.Fields)
+		case ir.Union:
+			out[Claim{AspectDefinition, string(d.Ref)}] = kindUnion
+			for _, v := range d.Variants {
+				out[Claim{AspectVariant, member(d.Ref, string(v.Name))}] = admitted
+			}
+		case ir.DiscriminatedUnion:
+			out[Claim{AspectDefinition, string(d.Ref)}] = kindDiscriminatedUnion
+			out[Claim{AspectDiscriminator, string(d.Ref)}] = string(d.Key)
+			for _, v := range d.Variants {
+				out[Claim{AspectVariant, member(d.Ref, string(v.Name))}] = string(v.Value)
+			}
+		case ir.Alias:
+			out[Claim{AspectDefinition, string(d.Ref)}] = kindAlias
+			out[Claim{AspectType, string(d.Ref)}] = n.typ(d.Type)
+		case ir.Method:
+			out[Claim{AspectDefinition, string(d.Ref)}] = kindMethod
+			n.fields(out, d.Ref, d.Params)
+			err = n.result(out, d.Ref, d.Result)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown record %T", d)
+		}
+	}
+	return out, nil
+}
+
+// fields states the type and the optionality of every field owner holds.
+func (n Nanopass) fields(out Facts, owner model.Reference, fields []ir.Field) {
+	for _, f := range fields {
+		out[Claim{AspectType, member(owner, string(f.Key))}] = n.typ(f.Type)
+		out[Claim{AspectOptionality, member(owner, string(f.Key))}] = optionality(f.Optionality)
+	}
+}
+
+// result states what the method owner returns.
+func (n Nanopass) result(out Facts, owner model.Reference, result ir.Result) error {
+	switch r := result.(type) {
+	case ir.Confirmation:
+		out[Claim{AspectResult, string(owner)}] = confirmation
+	case ir.Value:
+		out[Claim{AspectResult, string(owner)}] = n.typ(r.Type())
+	default:
+		return fmt.Errorf("reading result of %q: unknown result %T", owner, r)
+	}
+	return nil
+}
+
+// typ returns how a fact spells typ. An alias is spelled by its own name rather
+// than by what it stands for, since that name is what a target declares and
+// what the legacy chain has to declare too.
+func (n Nanopass) typ(typ typebound.Type) string {
+	var name string
+	switch atom := typ.Atom().(type) {
+	case typebound.Primitive:
+		name = string(atom.Kind())
+	case typebound.Object:
+		name = string(atom.Name())
+	case typebound.Union:
+		name = string(atom.Name())
+	case typebound.Alias:
+		name = string(atom.Name())
+	}
+	return spelled(name, int(typ.Dimensionality()))
+}
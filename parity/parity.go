@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+// Package parity compares what the legacy chain — model/spec through its
+// overlays into model/ir — and the nanopass pipeline into model/ir/v2 make of
+// one page. The python target still renders from the first and pythonv2 from
+// the second, and the second may only replace the first once it declares
+// everything the first does, the same way. Neither chain's records can be held
+// against the other's directly: they spell types, results and variants in
+// types of their own. So each is reduced to facts, one claim about one thing
+// the page names and the value the chain gives it, and the facts are compared
+// claim by claim.
+package parity
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+)
+
+// Aspect is what a claim is about: which of the things a target renders from a
+// record the claim pins down.
+type Aspect string
+
+const (
+	// AspectDefinition claims the kind of definition a reference names.
+	AspectDefinition Aspect = "definition"
+	// AspectType claims the type of a field or a parameter.
+	AspectType Aspect = "type"
+	// AspectOptionality claims whether a field or a parameter may be left out.
+	AspectOptionality Aspect = "optionality"
+	// AspectVariant claims that a union admits a variant, and the value a
+	// discriminated union tells it apart by.
+	AspectVariant Aspect = "variant"
+	// AspectDiscriminator claims the key a discriminated union tells its
+	// variants apart by, or the key and value a discriminated object is told
+	// apart by.
+	AspectDiscriminator Aspect = "discriminator"
+	// AspectResult claims what a method returns.
+	AspectResult Aspect = "result"
+)
+
+// Claim is one thing a chain can say something about: an aspect of a subject.
+// A subject is the reference of a definition, the reference of the owner and
+// the key of a field joined by a dot, or the reference of a union and the name
+// of a variant joined the same way.
+type Claim struct {
+	Aspect  Aspect
+	Subject string
+}
+
+// Facts is what one chain says about a page: the value it gives every claim it
+// makes. A claim a chain does not make is absent, which is how a definition,
+// field or variant one chain never declares shows up.
+type Facts map[Claim]string
+
+// Kinds of definition, as both chains' facts spell them.
+const (
+	kindObject              = "object"
+	kindDiscriminatedObject = "discriminated object"
+	kindUnion               = "union"
+	kindDiscriminatedUnion  = "discriminated union"
+	kindAlias               = "alias"
+	kindMethod              = "method"
+)
+
+// confirmation is how both chains' facts spell the result of a method that
+// answers with nothing but success.
+const confirmation = "confirmation"
+
+// member returns the subject of a field or a variant of owner.
+func member(owner model.Reference, name string) string {
+	return string(owner) + "." + name
+}
+
+// optionality returns how a fact spells whether a field may be left out.
+func optionality(opt model.Optionality) string {
+	if opt {
+		return "optional"
+	}
+	return "required"
+}
+
+// spelled returns how a fact spells a type: the name of what it ultimately
+// holds, followed by one pair of brackets per dimension it repeats over.
+func spelled(name string, dim int) string {
+	return name + strings.Repeat("[]", dim)
+}
+
+// discriminated returns how a fact spells the key and value a discriminated
+// object is told apart by.
+func discriminated(key model.Key, value model.DiscriminatorValue) string {
+	return string(key) + "=" + string(value)
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package parity
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+)
+
+// absent is how a report writes the value of a claim one chain does not make.
+const absent = "-"
+
+// aspects is the order a report lists disagreements in: what a page declares
+// before what those declarations hold, so a definition one chain lacks is read
+// before the fields that lack it too.
+//
+//nolint:gochecknoglobals // A fixed order, read and never written.
+var aspects = []Aspect{
+	AspectDefinition,
+	AspectDiscriminator,
+	AspectVariant,
+	AspectType,
+	AspectOptionality,
+	AspectResult,
+}
+
+// Disagreement is one claim the two chains answer differently. Legacy and
+// Nanopass hold what each chain says, and are empty for a chain that says
+// nothing about the claim.
+type Disagreement struct {
+	Claim    Claim
+	Legacy   string
+	Nanopass string
+}
+
+// Report is the [output.View] listing where the facts of the legacy chain and
+// of the nanopass pipeline disagree, one disagreement per line, ordered by
+// aspect and then by subject so two runs over one page write the same report.
+// A claim both chains answer alike is left out: what is listed is exactly the
+// work left before pythonv2 can take over from python.
+type Report struct {
+	legacy   Facts
+	nanopass Facts
+}
+
+// NewReport creates a Report comparing the facts of the legacy chain with those
+// of the nanopass pipeline.
+func NewReport(legacy, nanopass Facts) Report {
+	return Report{legacy: legacy, nanopass: nanopass}
+}
+
+// Disagreements returns every claim the two chains answer differently, in the
+// order the report lists them.
+func (r Report) Disagreements() []Disagreement {
+	out := make([]Disagreement, 0)
+	for claim, value := range r.legacy {
+		if other, ok := r.nanopass[claim]; !ok || other != value {
+			out = append(out, Disagreement{Claim: claim, Legacy: value, Nanopass: other})
+		}
+	}
+	for claim, value := range r.nanopass {
+		if _, ok := r.legacy[claim]; !ok {
+			out = append(out, Disagreement{Claim: claim, Nanopass: value})
+		}
+	}
+	slices.SortFunc(out, func(a, b Disagreement) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(aspects, a.Claim.Aspect), slices.Index(aspects, b.Claim.Aspect)),
+			cmp.Compare(a.Claim.Subject, b.Claim.Subject),
+		)
+	})
+	return out
+}
+
+// Render implements [output.View], writing a table with a column for the
+// aspect, the subject, and what each chain says, and a closing line counting
+// the disagreements.
+func (r Report) Render(w io.Writer) error {
+	disagreements := r.Disagreements()
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, err := fmt.Fprintln(table, "ASPECT\tSUBJECT\tPYTHON\tPYTHONV2")
+	if err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	for _, d := range disagreements {
+		_, err = fmt.Fprintf(
+			table,
+			"%s\t%s\t%s\t%s\n",
+			d.Claim.Aspect, d.Claim.Subject, cmp.Or(d.Legacy, absent), cmp.Or(d.Nanopass, absent),
+		)
+		if err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+	}
+	err = table.Flush()
+	if err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "%d disagreements\n", len(disagreements))
+	if err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
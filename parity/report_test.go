@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package parity_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/parity"
+)
+
+func TestReport_Disagreements(t *testing.T) {
+	cases := []struct {
+		name     string
+		legacy   parity.Facts
+		nanopass parity.Facts
+		want     []parity.Disagreement
+	}{
+		{
+			name: "lists nothing when both chains say the same",
+			legacy: parity.Facts{
+				{Aspect: parity.AspectType, Subject: "chat.id"}: "Integer",
+			},
+			nanopass: parity.Facts{
+				{Aspect: parity.AspectType, Subject: "chat.id"}: "Integer",
+			},
+			want: []parity.Disagreement{},
+		},
+		{
+			name: "lists a claim the chains answer differently with both answers",
+			legacy: parity.Facts{
+				{Aspect: parity.AspectType, Subject: "sendmessage.chat_id"}: "ChatID",
+			},
+			nanopass: parity.Facts{
+				{Aspect: parity.AspectType, Subject: "sendmessage.chat_id"}: "ChatId",
+			},
+			want: []parity.Disagreement{
+				{
+					Claim:    parity.Claim{Aspect: parity.AspectType, Subject: "sendmessage.chat_id"},
+					Legacy:   "ChatID",
+					Nanopass: "ChatId",
+				},
+			},
+		},
+		{
+			name: "lists a claim only one chain makes with the other answer empty",
+			legacy: parity.Facts{
+				{Aspect: parity.AspectOptionality, Subject: "chat.title"}: "optional",
+			},
+			nanopass: parity.Facts{
+				{Aspect: parity.AspectDefinition, Subject: "chatid"}: "union",
+			},
+			want: []parity.Disagreement{
+				{
+					Claim:    parity.Claim{Aspect: parity.AspectDefinition, Subject: "chatid"},
+					Nanopass: "union",
+				},
+				{
+					Claim:  parity.Claim{Aspect: parity.AspectOptionality, Subject: "chat.title"},
+					Legacy: "optional",
+				},
+			},
+		},
+		{
+			name: "orders by aspect before subject",
+			nanopass: parity.Facts{
+				{Aspect: parity.AspectResult, Subject: "getme"}:        "User",
+				{Aspect: parity.AspectVariant, Subject: "chatid.Id"}:   "admitted",
+				{Aspect: parity.AspectDefinition, Subject: "username"}: "alias",
+				{Aspect: parity.AspectDefinition, Subject: "chatid"}:   "union",
+			},
+			want: []parity.Disagreement{
+				{Claim: parity.Claim{Aspect: parity.AspectDefinition, Subject: "chatid"}, Nanopass: "union"},
+				{Claim: parity.Claim{Aspect: parity.AspectDefinition, Subject: "username"}, Nanopass: "alias"},
+				{Claim: parity.Claim{Aspect: parity.AspectVariant, Subject: "chatid.Id"}, Nanopass: "admitted"},
+				{Claim: parity.Claim{Aspect: parity.AspectResult, Subject: "getme"}, Nanopass: "User"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parity.NewReport(tc.legacy, tc.nanopass).Disagreements())
+		})
+	}
+}
+
+func TestReport_Render(t *testing.T) {
+	report := parity.NewReport(
+		parity.Facts{
+			{Aspect: parity.AspectType, Subject: "sendmessage.chat_id"}: "ChatID",
+		},
+		parity.Facts{
+			{Aspect: parity.AspectType, Subject: "sendmessage.chat_id"}: "ChatId",
+			{Aspect: parity.AspectDefinition, Subject: "chatid"}:        "union",
+		},
+	)
+	var b bytes.Buffer
+	require.NoError(t, report.Render(&b))
+	assert.Equal(t, ""+
+		"ASPECT      SUBJECT              PYTHON  PYTHONV2\n"+
+		"definition  chatid               -       union\n"+
+		"type        sendmessage.chat_id  ChatID  ChatId\n"+
+		"2 disagreements\n",
+		b.String(),
+	)
+}
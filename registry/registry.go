@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+// Package registry keeps the numbers a wire format knows fields and variants
+// by, across every release a target is run over. A number is what a reader of
+// such a format decodes a value by, so once handed out it means one field for
+// good: the registry is read before a run numbers anything and written back
+// after, and it refuses a release that would make one number mean two things.
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/andreychh/tgen/model"
+)
+
+// firstReserved and lastReserved bound the numbers the protobuf implementation
+// keeps to itself, which no schema may declare. Every other format numbered
+// this way holds far fewer fields than that, so stepping over them costs it
+// nothing.
+const (
+	firstReserved = 19000
+	lastReserved  = 19999
+)
+
+// largest is the highest number a protobuf field may hold, and so the highest
+// the registry hands out.
+const largest = 1<<29 - 1
+
+// Entry is what the registry holds for one field or variant: the number it is
+// known by, and the release that removed it, if one did. A removed entry keeps
+// its number, which is reserved from then on: nothing the owner declares later
+// may take it, and the entry itself may not come back under it.
+type Entry struct {
+	Number  int                  `json:"number"`
+	Removed model.ReleaseVersion `json:"removed,omitempty"`
+}
+
+// Reserved reports whether a release removed the entry.
+func (e Entry) Reserved() bool {
+	return e.Removed != ""
+}
+
+// Slot is one number an owner holds and the key holding it, spelled the way
+// the owner knows the key.
+type Slot struct {
+	Name   string
+	Number int
+}
+
+// Registry represents the numbers handed out to the fields of every object and
+// the variants of every union, each owned by the definition declaring it.
+// Fields and variants are kept apart, since a field and a variant share no key,
+// but they are numbered from one counter per owner, so a format declaring both
+// in one scope never sees them collide.
+type Registry struct {
+	fields   table[model.Key]
+	variants table[model.Reference]
+}
+
+// New creates a Registry that has handed out no number yet, which is where the
+// first run of a target starts.
+func New() Registry {
+	return Registry{
+		fields:   make(table[model.Key]),
+		variants: make(table[model.Reference]),
+	}
+}
+
+// Parse creates a Registry from the file an earlier run wrote. It fails when
+// the document is not one, or when one number is held twice under the same
+// owner, which no run writes and a hand editing the file can.
+func Parse(data []byte) (Registry, error) {
+	var document struct {
+		Fields   table[model.Key]       `json:"fields"`
+		Variants table[model.Reference] `json:"variants"`
+	}
+	err := json.Unmarshal(data, &document)
+	if err != nil {
+		return Registry{}, fmt.Errorf("decoding registry: %w", err)
+	}
+	out := New()
+	for owner, entries := range document.Fields {
+		if entries != nil {
+			out.fields[owner] = entries
+		}
+	}
+	for owner, entries := range document.Variants {
+		if entries != nil {
+			out.variants[owner] = entries
+		}
+	}
+	err = out.distinct()
+	if err != nil {
+		return Registry{}, err
+	}
+	return out, nil
+}
+
+// Updated returns a copy of the registry brought up to the release named
+// release, which declares the given fields and variants, listed in the order
+// the page gives them. A key the registry has not seen takes the number after
+// the highest its owner has ever held, so two runs over one page agree on
+// every number. A key the registry holds and the release no longer declares
+// is reserved under the release that dropped it.
+//
+// It fails when the release brings back a key an earlier one removed: the key
+// holds its number for good and the number is reserved, so either answer would
+// reuse a number some reader knows as something else. Whoever runs tgen
+// decides, by editing the file, whether the key is the field it was. It also
+// fails when an owner runs out of numbers. Every failure is reported, not just
+// the first, and the receiver is left as it was.
+func (r Registry) Updated(fields []model.FieldKey, variants []model.VariantKey, release model.ReleaseVersion) (Registry, error) {
+	out := Registry{fields: r.fields.clone(), variants: r.variants.clone()}
+	errs := make([]error, 0)
+	for _, key := range fields {
+		err := assign(out, out.fields, key.Owner, key.Key)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %q of %q: %w", key.Key, key.Owner, err))
+		}
+	}
+	for _, key := range variants {
+		err := assign(out, out.variants, key.Owner, key.Ref)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("variant %q of %q: %w", key.Ref, key.Owner, err))
+		}
+	}
+	err := errors.Join(errs...)
+	if err != nil {
+		return Registry{}, fmt.Errorf("updating registry to %s: %w", release, err)
+	}
+	present := make(map[model.FieldKey]bool, len(fields))
+	for _, key := range fields {
+		present[key] = true
+	}
+	out.fields.remove(func(owner model.Reference, key model.Key) bool {
+		return present[model.FieldKey{Owner: owner, Key: key}]
+	}, release)
+	offered := make(map[model.VariantKey]bool, len(variants))
+	for _, key := range variants {
+		offered[key] = true
+	}
+	out.variants.remove(func(owner model.Reference, ref model.Reference) bool {
+		return offered[model.VariantKey{Owner: owner, Ref: ref}]
+	}, release)
+	return out, nil
+}
+
+// Field returns the entry of a field, and whether the registry holds one.
+func (r Registry) Field(key model.FieldKey) (Entry, bool) {
+	entry, ok := r.fields[key.Owner][key.Key]
+	return entry, ok
+}
+
+// Variant returns the entry of a variant, and whether the registry holds one.
+func (r Registry) Variant(key model.VariantKey) (Entry, bool) {
+	entry, ok := r.variants[key.Owner][key.Ref]
+	return entry, ok
+}
+
+// RetiredFields returns the fields of owner a release removed, ordered by
+// number.
+func (r Registry) RetiredFields(owner model.Reference) []Slot {
+	return byNumber(listed(r.fields[owner], Entry.Reserved))
+}
+
+// RetiredVariants returns the variants of owner a release removed, ordered by
+// number.
+func (r Registry) RetiredVariants(owner model.Reference) []Slot {
+	return byNumber(listed(r.variants[owner], Entry.Reserved))
+}
+
+// Render implements [output.View], writing the registry as the file the next
+// run reads it back from. The keys of a JSON object are written sorted, so a
+// run changing nothing rewrites the file byte for byte and a diff of it shows
+// only what a release added and removed.
+func (r Registry) Render(w io.Writer) error {
+	document := struct {
+		Fields   table[model.Key]       `json:"fields"`
+		Variants table[model.Reference] `json:"variants"`
+	}{Fields: r.fields, Variants: r.variants}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(document)
+	if err != nil {
+		return fmt.Errorf("encoding registry: %w", err)
+	}
+	return nil
+}
+
+// assign hands key the number after the highest its owner holds, fields and
+// variants counted together, unless the registry holds one for it already. It
+// fails when the entry it holds is reserved, or when the owner has no number
+// left to hand out.
+func assign[K ~string](r Registry, t table[K], owner model.Reference, key K) error {
+	entries, ok := t[owner]
+	if !ok {
+		entries = make(map[K]Entry)
+		t[owner] = entries
+	}
+	if entry, ok := entries[key]; ok {
+		if entry.Reserved() {
+			return fmt.Errorf(
+				"removed in %s and declared again, which would reuse reserved number %d",
+				entry.Removed, entry.Number,
+			)
+		}
+		return nil
+	}
+	next := r.highest(owner) + 1
+	if next >= firstReserved && next <= lastReserved {
+		next = lastReserved + 1
+	}
+	if next > largest {
+		return fmt.Errorf("no number is left after %d", largest)
+	}
+	entries[key] = Entry{Number: next}
+	return nil
+}
+
+// highest returns the highest number owner holds, fields and variants,
+// reserved and not, counted together, or zero when it holds none.
+func (r Registry) highest(owner model.Reference) int {
+	out := 0
+	for _, entry := range r.fields[owner] {
+		out = max(out, entry.Number)
+	}
+	for _, entry := range r.variants[owner] {
+		out = max(out, entry.Number)
+	}
+	return out
+}
+
+// distinct fails when two entries of one owner hold the same number. Owners
+// and keys are visited sorted, so the pair reported is the same on every run.
+func (r Registry) distinct() error {
+	owners := make(map[model.Reference]bool)
+	for owner := range r.fields {
+		owners[owner] = true
+	}
+	for owner := range r.variants {
+		owners[owner] = true
+	}
+	for _, owner := range slices.Sorted(maps.Keys(owners)) {
+		seen := make(map[int]string)
+		held := append(listed(r.fields[owner], Entry.any), listed(r.variants[owner], Entry.any)...)
+		for _, entry := range held {
+			if other, ok := seen[entry.Number]; ok {
+				return fmt.Errorf("%s: %q and %q both hold number %d", owner, other, entry.Name, entry.Number)
+			}
+			seen[entry.Number] = entry.Name
+		}
+	}
+	return nil
+}
+
+// any reports true whatever the entry, for a listing keeping every entry.
+func (Entry) any() bool {
+	return true
+}
+
+// table is the entries of every owner, each keyed by what its owner knows it
+// by.
+type table[K ~string] map[model.Reference]map[K]Entry
+
+// clone returns a copy of the table sharing no map with it.
+func (t table[K]) clone() table[K] {
+	out := make(table[K], len(t))
+	for owner, entries := range t {
+		out[owner] = maps.Clone(entries)
+	}
+	return out
+}
+
+// remove reserves, under release, every entry of the table the release does
+// not declare and no earlier one removed.
+func (t table[K]) remove(declared func(model.Reference, K) bool, release model.ReleaseVersion) {
+	for owner, entries := range t {
+		for key, entry := range entries {
+			if !entry.Reserved() && !declared(owner, key) {
+				entry.Removed = release
+				entries[key] = entry
+			}
+		}
+	}
+}
+
+// listed returns the slots of the entries keep admits, ordered by key.
+func listed[K ~string](entries map[K]Entry, keep func(Entry) bool) []Slot {
+	out := make([]Slot, 0)
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		if keep(entries[key]) {
+			out = append(out, Slot{Name: string(key), Number: entries[key].Number})
+		}
+	}
+	return out
+}
+
+// byNumber returns the slots ordered by number.
+func byNumber(slots []Slot) []Slot {
+	slices.SortFunc(slots, func(a, b Slot) int {
+		return a.Number - b.Number
+	})
+	return slots
+}
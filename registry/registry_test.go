@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package registry_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/registry"
+)
+
+func fields(owner model.Reference, keys ...model.Key) []model.FieldKey {
+	out := make([]model.FieldKey, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, model.FieldKey{Owner: owner, Key: key})
+	}
+	return out
+}
+
+func parsed(t *testing.T, file string) registry.Registry {
+	t.Helper()
+	if file == "" {
+		return registry.New()
+	}
+	r, err := registry.Parse([]byte(file))
+	require.NoError(t, err)
+	return r
+}
+
+func TestRegistry_Field(t *testing.T) {
+	cases := []struct {
+		name   string
+		file   string
+		fields []model.FieldKey
+		key    model.FieldKey
+		want   registry.Entry
+	}{
+		{
+			name:   "numbers the fields of a first run in the order the page lists them",
+			fields: fields("chat", "id", "type", "title"),
+			key:    model.FieldKey{Owner: "chat", Key: "title"},
+			want:   registry.Entry{Number: 3},
+		},
+		{
+			name:   "keeps the number an earlier run handed out when the page moves the field",
+			file:   `{"fields": {"chat": {"id": {"number": 1}, "type": {"number": 2}, "title": {"number": 3}}}}`,
+			fields: fields("chat", "title", "id", "type"),
+			key:    model.FieldKey{Owner: "chat", Key: "title"},
+			want:   registry.Entry{Number: 3},
+		},
+		{
+			name:   "gives a new field the number after the highest its owner ever held",
+			file:   `{"fields": {"chat": {"id": {"number": 1}, "title": {"number": 3, "removed": "10.1"}}}}`,
+			fields: fields("chat", "id", "username"),
+			key:    model.FieldKey{Owner: "chat", Key: "username"},
+			want:   registry.Entry{Number: 4},
+		},
+		{
+			name:   "reserves the number of a field the release dropped under that release",
+			file:   `{"fields": {"chat": {"id": {"number": 1}, "title": {"number": 2}}}}`,
+			fields: fields("chat", "id"),
+			key:    model.FieldKey{Owner: "chat", Key: "title"},
+			want:   registry.Entry{Number: 2, Removed: "10.2"},
+		},
+		{
+			name:   "keeps the release that first dropped a field",
+			file:   `{"fields": {"chat": {"id": {"number": 1}, "title": {"number": 2, "removed": "9.0"}}}}`,
+			fields: fields("chat", "id"),
+			key:    model.FieldKey{Owner: "chat", Key: "title"},
+			want:   registry.Entry{Number: 2, Removed: "9.0"},
+		},
+		{
+			name:   "numbers the fields of each owner on their own",
+			file:   `{"fields": {"chat": {"id": {"number": 1}, "type": {"number": 2}}}}`,
+			fields: append(fields("chat", "id", "type"), fields("user", "id")...),
+			key:    model.FieldKey{Owner: "user", Key: "id"},
+			want:   registry.Entry{Number: 1},
+		},
+		{
+			name:   "steps over the numbers the protobuf implementation keeps to itself",
+			file:   `{"fields": {"chat": {"id": {"number": 18999}}}}`,
+			fields: fields("chat", "id", "type"),
+			key:    model.FieldKey{Owner: "chat", Key: "type"},
+			want:   registry.Entry{Number: 20000},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated, err := parsed(t, tc.file).Updated(tc.fields, nil, "10.2")
+			require.NoError(t, err)
+			got, ok := updated.Field(tc.key)
+			require.True(t, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRegistry_Variant(t *testing.T) {
+	cases := []struct {
+		name string
+		file string
+		key  model.VariantKey
+		want registry.Entry
+	}{
+		{
+			name: "numbers the variants of a first run in the order the union lists them",
+			key:  model.VariantKey{Owner: "chatid", Ref: "username"},
+			want: registry.Entry{Number: 2},
+		},
+		{
+			name: "keeps the number an earlier run handed out to a variant",
+			file: `{"variants": {"chatid": {"username": {"number": 1}, "id": {"number": 2}}}}`,
+			key:  model.VariantKey{Owner: "chatid", Ref: "username"},
+			want: registry.Entry{Number: 1},
+		},
+	}
+	variants := []model.VariantKey{
+		{Owner: "chatid", Ref: "id"},
+		{Owner: "chatid", Ref: "username"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated, err := parsed(t, tc.file).Updated(nil, variants, "10.2")
+			require.NoError(t, err)
+			got, ok := updated.Variant(tc.key)
+			require.True(t, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRegistry_Updated(t *testing.T) {
+	cases := []struct {
+		name string
+		file string
+		want string
+	}{
+		{
+			name: "fails when a release brings back a field an earlier one removed",
+			file: `{"fields": {"chat": {"id": {"number": 1}, "title": {"number": 2, "removed": "10.1"}}}}`,
+			want: `field "title" of "chat": removed in 10.1 and declared again, which would reuse reserved number 2`,
+		},
+		{
+			name: "fails when an owner has no number left",
+			file: `{"fields": {"chat": {"id": {"number": 536870911}}}}`,
+			want: `field "title" of "chat": no number is left after 536870911`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parsed(t, tc.file).Updated(fields("chat", "id", "title"), nil, "10.2")
+			assert.ErrorContains(t, err, tc.want)
+		})
+	}
+}
+
+func TestRegistry_RetiredFields(t *testing.T) {
+	r := parsed(t, `{"fields": {"chat": {
+		"id": {"number": 1},
+		"title": {"number": 4, "removed": "9.0"},
+		"bio": {"number": 2}
+	}}}`)
+	updated, err := r.Updated(fields("chat", "id"), nil, "10.2")
+	require.NoError(t, err)
+	assert.Equal(t, []registry.Slot{
+		{Name: "bio", Number: 2},
+		{Name: "title", Number: 4},
+	}, updated.RetiredFields("chat"))
+}
+
+func TestRegistry_RetiredVariants(t *testing.T) {
+	r := parsed(t, `{"variants": {"chatid": {
+		"id": {"number": 1},
+		"username": {"number": 2}
+	}}}`)
+	updated, err := r.Updated(nil, []model.VariantKey{{Owner: "chatid", Ref: "id"}}, "10.2")
+	require.NoError(t, err)
+	assert.Equal(t, []registry.Slot{
+		{Name: "username", Number: 2},
+	}, updated.RetiredVariants("chatid"))
+}
+
+func TestRegistry_Render(t *testing.T) {
+	updated, err := registry.New().Updated(fields("chat", "id", "type"), nil, "10.2")
+	require.NoError(t, err)
+	var b bytes.Buffer
+	require.NoError(t, updated.Render(&b))
+	again, err := registry.Parse(b.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, updated, again, "a registry must read back as the registry written")
+}
+
+func TestParse(t *testing.T) {
+	_, err := registry.Parse([]byte(`{
+		"fields": {"chat": {"id": {"number": 1}}},
+		"variants": {"chat": {"old": {"number": 1, "removed": "9.0"}}}
+	}`))
+	assert.ErrorContains(t, err, `chat: "id" and "old" both hold number 1`)
+}
@@ -142,6 +142,36 @@ type GetUpdatesMethod struct {
 	AllowedUpdates []string `json:"allowed_updates,omitempty"`
 }
 
+// NewGetUpdatesMethod creates the request of getUpdates from the parameters it
+// requires, leaving every optional one unset.
+func NewGetUpdatesMethod() GetUpdatesMethod {
+	return GetUpdatesMethod{}
+}
+
+// WithOffset returns a copy of m with Offset set to offset.
+func (m GetUpdatesMethod) WithOffset(offset int64) GetUpdatesMethod {
+	m.Offset = &offset
+	return m
+}
+
+// WithLimit returns a copy of m with Limit set to limit.
+func (m GetUpdatesMethod) WithLimit(limit int64) GetUpdatesMethod {
+	m.Limit = &limit
+	return m
+}
+
+// WithTimeout returns a copy of m with Timeout set to timeout.
+func (m GetUpdatesMethod) WithTimeout(timeout int64) GetUpdatesMethod {
+	m.Timeout = &timeout
+	return m
+}
+
+// WithAllowedUpdates returns a copy of m with AllowedUpdates set to allowedUpdates.
+func (m GetUpdatesMethod) WithAllowedUpdates(allowedUpdates []string) GetUpdatesMethod {
+	m.AllowedUpdates = allowedUpdates
+	return m
+}
+
 func (m GetUpdatesMethod) Call(ctx context.Context, conn Connection) ([]Update, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -216,6 +246,48 @@ type SetWebhookMethod struct {
 	SecretToken *string `json:"secret_token,omitempty"`
 }
 
+// NewSetWebhookMethod creates the request of setWebhook from the parameters it
+// requires, leaving every optional one unset.
+func NewSetWebhookMethod(url string) SetWebhookMethod {
+	return SetWebhookMethod{URL: url}
+}
+
+// WithCertificate returns a copy of m with Certificate set to certificate.
+func (m SetWebhookMethod) WithCertificate(certificate InputFile) SetWebhookMethod {
+	m.Certificate = certificate
+	return m
+}
+
+// WithIPAddress returns a copy of m with IPAddress set to ipAddress.
+func (m SetWebhookMethod) WithIPAddress(ipAddress string) SetWebhookMethod {
+	m.IPAddress = &ipAddress
+	return m
+}
+
+// WithMaxConnections returns a copy of m with MaxConnections set to maxConnections.
+func (m SetWebhookMethod) WithMaxConnections(maxConnections int64) SetWebhookMethod {
+	m.MaxConnections = &maxConnections
+	return m
+}
+
+// WithAllowedUpdates returns a copy of m with AllowedUpdates set to allowedUpdates.
+func (m SetWebhookMethod) WithAllowedUpdates(allowedUpdates []string) SetWebhookMethod {
+	m.AllowedUpdates = allowedUpdates
+	return m
+}
+
+// WithDropPendingUpdates returns a copy of m with DropPendingUpdates set to dropPendingUpdates.
+func (m SetWebhookMethod) WithDropPendingUpdates(dropPendingUpdates bool) SetWebhookMethod {
+	m.DropPendingUpdates = &dropPendingUpdates
+	return m
+}
+
+// WithSecretToken returns a copy of m with SecretToken set to secretToken.
+func (m SetWebhookMethod) WithSecretToken(secretToken string) SetWebhookMethod {
+	m.SecretToken = &secretToken
+	return m
+}
+
 func (m SetWebhookMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -249,6 +321,18 @@ type DeleteWebhookMethod struct {
 	DropPendingUpdates *bool `json:"drop_pending_updates,omitempty"`
 }
 
+// NewDeleteWebhookMethod creates the request of deleteWebhook from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteWebhookMethod() DeleteWebhookMethod {
+	return DeleteWebhookMethod{}
+}
+
+// WithDropPendingUpdates returns a copy of m with DropPendingUpdates set to dropPendingUpdates.
+func (m DeleteWebhookMethod) WithDropPendingUpdates(dropPendingUpdates bool) DeleteWebhookMethod {
+	m.DropPendingUpdates = &dropPendingUpdates
+	return m
+}
+
 func (m DeleteWebhookMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -269,6 +353,12 @@ func (m DeleteWebhookMethod) payload() (jsonPayload, error) {
 type GetWebhookInfoMethod struct {
 }
 
+// NewGetWebhookInfoMethod creates the request of getWebhookInfo from the parameters it
+// requires, leaving every optional one unset.
+func NewGetWebhookInfoMethod() GetWebhookInfoMethod {
+	return GetWebhookInfoMethod{}
+}
+
 func (m GetWebhookInfoMethod) Call(ctx context.Context, conn Connection) (WebhookInfo, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -543,7 +633,7 @@ func (o *ChatFullInfo) UnmarshalJSON(data []byte) error {
 		AvailableReactions []json.RawMessage `json:"available_reactions"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = ChatFullInfo(aux.alias)
@@ -884,7 +974,7 @@ func (o *Message) UnmarshalJSON(data []byte) error {
 		PinnedMessage json.RawMessage `json:"pinned_message"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = Message(aux.alias)
@@ -944,18 +1034,18 @@ func unmarshalMaybeInaccessibleMessage(data []byte) (MaybeInaccessibleMessage, e
 	var mark struct {
 		Date int64 `json:"date"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	if mark.Date == 0 {
 		var inaccessible InaccessibleMessage
-		if err := json.Unmarshal(data, &inaccessible); err != nil {
+		if err := codec.Unmarshal(data, &inaccessible); err != nil {
 			return nil, err
 		}
 		return inaccessible, nil
 	}
 	var message Message
-	if err := json.Unmarshal(data, &message); err != nil {
+	if err := codec.Unmarshal(data, &message); err != nil {
 		return nil, err
 	}
 	return message, nil
@@ -1086,7 +1176,7 @@ func (o *ExternalReplyInfo) UnmarshalJSON(data []byte) error {
 		Origin json.RawMessage `json:"origin"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = ExternalReplyInfo(aux.alias)
@@ -1158,31 +1248,31 @@ func unmarshalMessageOrigin(data []byte) (MessageOrigin, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "user":
 		var variant MessageOriginUser
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "hidden_user":
 		var variant MessageOriginHiddenUser
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "chat":
 		var variant MessageOriginChat
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "channel":
 		var variant MessageOriginChannel
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -1486,7 +1576,7 @@ func (o *PaidMediaInfo) UnmarshalJSON(data []byte) error {
 		PaidMedia []json.RawMessage `json:"paid_media"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = PaidMediaInfo(aux.alias)
@@ -1519,31 +1609,31 @@ func unmarshalPaidMedia(data []byte) (PaidMedia, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "live_photo":
 		var variant PaidMediaLivePhoto
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "photo":
 		var variant PaidMediaPhoto
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "preview":
 		var variant PaidMediaPreview
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "video":
 		var variant PaidMediaVideo
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -1747,7 +1837,7 @@ func (o InputPollOption) resolve(sink *fileSink) (json.RawMessage, error) {
 		media = data
 	}
 	type alias InputPollOption
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Media json.RawMessage `json:"media,omitempty"`
 		alias
 	}{
@@ -2042,7 +2132,7 @@ func (o *PollOptionAdded) UnmarshalJSON(data []byte) error {
 		PollMessage json.RawMessage `json:"poll_message"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = PollOptionAdded(aux.alias)
@@ -2078,7 +2168,7 @@ func (o *PollOptionDeleted) UnmarshalJSON(data []byte) error {
 		PollMessage json.RawMessage `json:"poll_message"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = PollOptionDeleted(aux.alias)
@@ -2115,25 +2205,25 @@ func unmarshalBackgroundFill(data []byte) (BackgroundFill, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "solid":
 		var variant BackgroundFillSolid
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "gradient":
 		var variant BackgroundFillGradient
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "freeform_gradient":
 		var variant BackgroundFillFreeformGradient
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -2187,31 +2277,31 @@ func unmarshalBackgroundType(data []byte) (BackgroundType, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "fill":
 		var variant BackgroundTypeFill
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "wallpaper":
 		var variant BackgroundTypeWallpaper
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "pattern":
 		var variant BackgroundTypePattern
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "chat_theme":
 		var variant BackgroundTypeChatTheme
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -2236,7 +2326,7 @@ func (o *BackgroundTypeFill) UnmarshalJSON(data []byte) error {
 		Fill json.RawMessage `json:"fill"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = BackgroundTypeFill(aux.alias)
@@ -2290,7 +2380,7 @@ func (o *BackgroundTypePattern) UnmarshalJSON(data []byte) error {
 		Fill json.RawMessage `json:"fill"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = BackgroundTypePattern(aux.alias)
@@ -2326,7 +2416,7 @@ func (o *ChatBackground) UnmarshalJSON(data []byte) error {
 		Type json.RawMessage `json:"type"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = ChatBackground(aux.alias)
@@ -3239,7 +3329,7 @@ func (o *CallbackQuery) UnmarshalJSON(data []byte) error {
 		Message json.RawMessage `json:"message"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = CallbackQuery(aux.alias)
@@ -3443,7 +3533,7 @@ func (o *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
 		NewChatMember json.RawMessage `json:"new_chat_member"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = ChatMemberUpdated(aux.alias)
@@ -3482,43 +3572,43 @@ func unmarshalChatMember(data []byte) (ChatMember, error) {
 	var mark struct {
 		Key string `json:"status"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "creator":
 		var variant ChatMemberOwner
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "administrator":
 		var variant ChatMemberAdministrator
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "member":
 		var variant ChatMemberMember
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "restricted":
 		var variant ChatMemberRestricted
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "left":
 		var variant ChatMemberLeft
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "kicked":
 		var variant ChatMemberBanned
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -3893,7 +3983,7 @@ type StoryAreaTypeLocation struct {
 
 func (o StoryAreaTypeLocation) MarshalJSON() ([]byte, error) {
 	type alias StoryAreaTypeLocation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -3917,7 +4007,7 @@ type StoryAreaTypeSuggestedReaction struct {
 
 func (o StoryAreaTypeSuggestedReaction) MarshalJSON() ([]byte, error) {
 	type alias StoryAreaTypeSuggestedReaction
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -3937,7 +4027,7 @@ type StoryAreaTypeLink struct {
 
 func (o StoryAreaTypeLink) MarshalJSON() ([]byte, error) {
 	type alias StoryAreaTypeLink
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -3961,7 +4051,7 @@ type StoryAreaTypeWeather struct {
 
 func (o StoryAreaTypeWeather) MarshalJSON() ([]byte, error) {
 	type alias StoryAreaTypeWeather
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -3981,7 +4071,7 @@ type StoryAreaTypeUniqueGift struct {
 
 func (o StoryAreaTypeUniqueGift) MarshalJSON() ([]byte, error) {
 	type alias StoryAreaTypeUniqueGift
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4024,25 +4114,25 @@ func unmarshalReactionType(data []byte) (ReactionType, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "emoji":
 		var variant ReactionTypeEmoji
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "custom_emoji":
 		var variant ReactionTypeCustomEmoji
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "paid":
 		var variant ReactionTypePaid
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -4066,7 +4156,7 @@ type ReactionTypeEmoji struct {
 
 func (o ReactionTypeEmoji) MarshalJSON() ([]byte, error) {
 	type alias ReactionTypeEmoji
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4085,7 +4175,7 @@ type ReactionTypeCustomEmoji struct {
 
 func (o ReactionTypeCustomEmoji) MarshalJSON() ([]byte, error) {
 	type alias ReactionTypeCustomEmoji
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4102,7 +4192,7 @@ type ReactionTypePaid struct {
 
 func (o ReactionTypePaid) MarshalJSON() ([]byte, error) {
 	type alias ReactionTypePaid
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4128,7 +4218,7 @@ func (o *ReactionCount) UnmarshalJSON(data []byte) error {
 		Type json.RawMessage `json:"type"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = ReactionCount(aux.alias)
@@ -4171,7 +4261,7 @@ func (o *MessageReactionUpdated) UnmarshalJSON(data []byte) error {
 		NewReaction []json.RawMessage `json:"new_reaction"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = MessageReactionUpdated(aux.alias)
@@ -4478,19 +4568,19 @@ func unmarshalOwnedGift(data []byte) (OwnedGift, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "regular":
 		var variant OwnedGiftRegular
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "unique":
 		var variant OwnedGiftUnique
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -4586,7 +4676,7 @@ func (o *OwnedGifts) UnmarshalJSON(data []byte) error {
 		Gifts []json.RawMessage `json:"gifts"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = OwnedGifts(aux.alias)
@@ -4683,7 +4773,7 @@ type BotCommandScopeDefault struct {
 
 func (o BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
 	type alias BotCommandScopeDefault
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4700,7 +4790,7 @@ type BotCommandScopeAllPrivateChats struct {
 
 func (o BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
 	type alias BotCommandScopeAllPrivateChats
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4718,7 +4808,7 @@ type BotCommandScopeAllGroupChats struct {
 
 func (o BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
 	type alias BotCommandScopeAllGroupChats
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4736,7 +4826,7 @@ type BotCommandScopeAllChatAdministrators struct {
 
 func (o BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
 	type alias BotCommandScopeAllChatAdministrators
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4757,7 +4847,7 @@ type BotCommandScopeChat struct {
 
 func (o BotCommandScopeChat) MarshalJSON() ([]byte, error) {
 	type alias BotCommandScopeChat
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4779,7 +4869,7 @@ type BotCommandScopeChatAdministrators struct {
 
 func (o BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
 	type alias BotCommandScopeChatAdministrators
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4803,7 +4893,7 @@ type BotCommandScopeChatMember struct {
 
 func (o BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
 	type alias BotCommandScopeChatMember
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4855,25 +4945,25 @@ func unmarshalMenuButton(data []byte) (MenuButton, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "commands":
 		var variant MenuButtonCommands
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "web_app":
 		var variant MenuButtonWebApp
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "default":
 		var variant MenuButtonDefault
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -4890,7 +4980,7 @@ type MenuButtonCommands struct {
 
 func (o MenuButtonCommands) MarshalJSON() ([]byte, error) {
 	type alias MenuButtonCommands
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4916,7 +5006,7 @@ type MenuButtonWebApp struct {
 
 func (o MenuButtonWebApp) MarshalJSON() ([]byte, error) {
 	type alias MenuButtonWebApp
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4933,7 +5023,7 @@ type MenuButtonDefault struct {
 
 func (o MenuButtonDefault) MarshalJSON() ([]byte, error) {
 	type alias MenuButtonDefault
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -4956,25 +5046,25 @@ func unmarshalChatBoostSource(data []byte) (ChatBoostSource, error) {
 	var mark struct {
 		Key string `json:"source"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "premium":
 		var variant ChatBoostSourcePremium
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "gift_code":
 		var variant ChatBoostSourceGiftCode
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "giveaway":
 		var variant ChatBoostSourceGiveaway
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -5043,7 +5133,7 @@ func (o *ChatBoost) UnmarshalJSON(data []byte) error {
 		Source json.RawMessage `json:"source"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = ChatBoost(aux.alias)
@@ -5087,7 +5177,7 @@ func (o *ChatBoostRemoved) UnmarshalJSON(data []byte) error {
 		Source json.RawMessage `json:"source"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = ChatBoostRemoved(aux.alias)
@@ -5313,7 +5403,7 @@ type InputMediaAnimation struct {
 
 func (o InputMediaAnimation) MarshalJSON() ([]byte, error) {
 	type alias InputMediaAnimation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5330,7 +5420,7 @@ func (o InputMediaAnimation) resolve(sink *fileSink) (json.RawMessage, error) {
 		thumbnail = &ref
 	}
 	type alias InputMediaAnimation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		Thumbnail *string `json:"thumbnail,omitempty"`
@@ -5380,7 +5470,7 @@ type InputMediaAudio struct {
 
 func (o InputMediaAudio) MarshalJSON() ([]byte, error) {
 	type alias InputMediaAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5397,7 +5487,7 @@ func (o InputMediaAudio) resolve(sink *fileSink) (json.RawMessage, error) {
 		thumbnail = &ref
 	}
 	type alias InputMediaAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		Thumbnail *string `json:"thumbnail,omitempty"`
@@ -5445,7 +5535,7 @@ type InputMediaDocument struct {
 
 func (o InputMediaDocument) MarshalJSON() ([]byte, error) {
 	type alias InputMediaDocument
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5462,7 +5552,7 @@ func (o InputMediaDocument) resolve(sink *fileSink) (json.RawMessage, error) {
 		thumbnail = &ref
 	}
 	type alias InputMediaDocument
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		Thumbnail *string `json:"thumbnail,omitempty"`
@@ -5485,7 +5575,7 @@ type InputMediaLink struct {
 
 func (o InputMediaLink) MarshalJSON() ([]byte, error) {
 	type alias InputMediaLink
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5495,7 +5585,7 @@ func (o InputMediaLink) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputMediaLink) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents a live photo to be sent.
@@ -5531,7 +5621,7 @@ type InputMediaLivePhoto struct {
 
 func (o InputMediaLivePhoto) MarshalJSON() ([]byte, error) {
 	type alias InputMediaLivePhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5544,7 +5634,7 @@ func (o InputMediaLivePhoto) resolve(sink *fileSink) (json.RawMessage, error) {
 	media := o.Media.attach(sink)
 	photo := o.Photo.attach(sink)
 	type alias InputMediaLivePhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		Photo string `json:"photo"`
@@ -5571,7 +5661,7 @@ type InputMediaLocation struct {
 
 func (o InputMediaLocation) MarshalJSON() ([]byte, error) {
 	type alias InputMediaLocation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5581,7 +5671,7 @@ func (o InputMediaLocation) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputMediaLocation) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents a photo to be sent.
@@ -5610,7 +5700,7 @@ type InputMediaPhoto struct {
 
 func (o InputMediaPhoto) MarshalJSON() ([]byte, error) {
 	type alias InputMediaPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5622,7 +5712,7 @@ func (o InputMediaPhoto) MarshalJSON() ([]byte, error) {
 func (o InputMediaPhoto) resolve(sink *fileSink) (json.RawMessage, error) {
 	media := o.Media.attach(sink)
 	type alias InputMediaPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		alias
@@ -5649,7 +5739,7 @@ type InputMediaSticker struct {
 
 func (o InputMediaSticker) MarshalJSON() ([]byte, error) {
 	type alias InputMediaSticker
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5661,7 +5751,7 @@ func (o InputMediaSticker) MarshalJSON() ([]byte, error) {
 func (o InputMediaSticker) resolve(sink *fileSink) (json.RawMessage, error) {
 	media := o.Media.attach(sink)
 	type alias InputMediaSticker
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		alias
@@ -5698,7 +5788,7 @@ type InputMediaVenue struct {
 
 func (o InputMediaVenue) MarshalJSON() ([]byte, error) {
 	type alias InputMediaVenue
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5708,7 +5798,7 @@ func (o InputMediaVenue) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputMediaVenue) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents a video to be sent.
@@ -5762,7 +5852,7 @@ type InputMediaVideo struct {
 
 func (o InputMediaVideo) MarshalJSON() ([]byte, error) {
 	type alias InputMediaVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5784,7 +5874,7 @@ func (o InputMediaVideo) resolve(sink *fileSink) (json.RawMessage, error) {
 		cover = &ref
 	}
 	type alias InputMediaVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		Thumbnail *string `json:"thumbnail,omitempty"`
@@ -5824,7 +5914,7 @@ type InputMediaVoiceNote struct {
 
 func (o InputMediaVoiceNote) MarshalJSON() ([]byte, error) {
 	type alias InputMediaVoiceNote
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5836,7 +5926,7 @@ func (o InputMediaVoiceNote) MarshalJSON() ([]byte, error) {
 func (o InputMediaVoiceNote) resolve(sink *fileSink) (json.RawMessage, error) {
 	media := o.Media.attach(sink)
 	type alias InputMediaVoiceNote
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		alias
@@ -5880,7 +5970,7 @@ type InputPaidMediaLivePhoto struct {
 
 func (o InputPaidMediaLivePhoto) MarshalJSON() ([]byte, error) {
 	type alias InputPaidMediaLivePhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5893,7 +5983,7 @@ func (o InputPaidMediaLivePhoto) resolve(sink *fileSink) (json.RawMessage, error
 	media := o.Media.attach(sink)
 	photo := o.Photo.attach(sink)
 	type alias InputPaidMediaLivePhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		Photo string `json:"photo"`
@@ -5920,7 +6010,7 @@ type InputPaidMediaPhoto struct {
 
 func (o InputPaidMediaPhoto) MarshalJSON() ([]byte, error) {
 	type alias InputPaidMediaPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -5932,7 +6022,7 @@ func (o InputPaidMediaPhoto) MarshalJSON() ([]byte, error) {
 func (o InputPaidMediaPhoto) resolve(sink *fileSink) (json.RawMessage, error) {
 	media := o.Media.attach(sink)
 	type alias InputPaidMediaPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		alias
@@ -5982,7 +6072,7 @@ type InputPaidMediaVideo struct {
 
 func (o InputPaidMediaVideo) MarshalJSON() ([]byte, error) {
 	type alias InputPaidMediaVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -6004,7 +6094,7 @@ func (o InputPaidMediaVideo) resolve(sink *fileSink) (json.RawMessage, error) {
 		cover = &ref
 	}
 	type alias InputPaidMediaVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Media string `json:"media"`
 		Thumbnail *string `json:"thumbnail,omitempty"`
@@ -6044,7 +6134,7 @@ type InputProfilePhotoStatic struct {
 
 func (o InputProfilePhotoStatic) MarshalJSON() ([]byte, error) {
 	type alias InputProfilePhotoStatic
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -6056,7 +6146,7 @@ func (o InputProfilePhotoStatic) MarshalJSON() ([]byte, error) {
 func (o InputProfilePhotoStatic) resolve(sink *fileSink) (json.RawMessage, error) {
 	photo := o.Photo.attach(sink)
 	type alias InputProfilePhotoStatic
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Photo string `json:"photo"`
 		alias
@@ -6083,7 +6173,7 @@ type InputProfilePhotoAnimated struct {
 
 func (o InputProfilePhotoAnimated) MarshalJSON() ([]byte, error) {
 	type alias InputProfilePhotoAnimated
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -6095,7 +6185,7 @@ func (o InputProfilePhotoAnimated) MarshalJSON() ([]byte, error) {
 func (o InputProfilePhotoAnimated) resolve(sink *fileSink) (json.RawMessage, error) {
 	animation := o.Animation.attach(sink)
 	type alias InputProfilePhotoAnimated
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Animation string `json:"animation"`
 		alias
@@ -6133,7 +6223,7 @@ type InputStoryContentPhoto struct {
 
 func (o InputStoryContentPhoto) MarshalJSON() ([]byte, error) {
 	type alias InputStoryContentPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -6145,7 +6235,7 @@ func (o InputStoryContentPhoto) MarshalJSON() ([]byte, error) {
 func (o InputStoryContentPhoto) resolve(sink *fileSink) (json.RawMessage, error) {
 	photo := o.Photo.attach(sink)
 	type alias InputStoryContentPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Photo string `json:"photo"`
 		alias
@@ -6179,7 +6269,7 @@ type InputStoryContentVideo struct {
 
 func (o InputStoryContentVideo) MarshalJSON() ([]byte, error) {
 	type alias InputStoryContentVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -6191,7 +6281,7 @@ func (o InputStoryContentVideo) MarshalJSON() ([]byte, error) {
 func (o InputStoryContentVideo) resolve(sink *fileSink) (json.RawMessage, error) {
 	video := o.Video.attach(sink)
 	type alias InputStoryContentVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Video string `json:"video"`
 		alias
@@ -6209,6 +6299,12 @@ func (o InputStoryContentVideo) resolve(sink *fileSink) (json.RawMessage, error)
 type GetMeMethod struct {
 }
 
+// NewGetMeMethod creates the request of getMe from the parameters it
+// requires, leaving every optional one unset.
+func NewGetMeMethod() GetMeMethod {
+	return GetMeMethod{}
+}
+
 func (m GetMeMethod) Call(ctx context.Context, conn Connection) (User, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6236,6 +6332,12 @@ func (m GetMeMethod) payload() (emptyPayload, error) {
 type LogOutMethod struct {
 }
 
+// NewLogOutMethod creates the request of logOut from the parameters it
+// requires, leaving every optional one unset.
+func NewLogOutMethod() LogOutMethod {
+	return LogOutMethod{}
+}
+
 func (m LogOutMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -6258,6 +6360,12 @@ func (m LogOutMethod) payload() (emptyPayload, error) {
 type CloseMethod struct {
 }
 
+// NewCloseMethod creates the request of close from the parameters it
+// requires, leaving every optional one unset.
+func NewCloseMethod() CloseMethod {
+	return CloseMethod{}
+}
+
 func (m CloseMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -6328,6 +6436,102 @@ type SendMessageMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendMessageMethod creates the request of sendMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewSendMessageMethod(chatID ChatID, text string) SendMessageMethod {
+	return SendMessageMethod{ChatID: chatID, Text: text}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendMessageMethod) WithBusinessConnectionID(businessConnectionID string) SendMessageMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendMessageMethod) WithMessageThreadID(messageThreadID int64) SendMessageMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendMessageMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendMessageMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendMessageMethod) WithReceiverUserID(receiverUserID int64) SendMessageMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendMessageMethod) WithCallbackQueryID(callbackQueryID string) SendMessageMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendMessageMethod) WithParseMode(parseMode string) SendMessageMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithEntities returns a copy of m with Entities set to entities.
+func (m SendMessageMethod) WithEntities(entities []MessageEntity) SendMessageMethod {
+	m.Entities = entities
+	return m
+}
+
+// WithLinkPreviewOptions returns a copy of m with LinkPreviewOptions set to linkPreviewOptions.
+func (m SendMessageMethod) WithLinkPreviewOptions(linkPreviewOptions LinkPreviewOptions) SendMessageMethod {
+	m.LinkPreviewOptions = &linkPreviewOptions
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendMessageMethod) WithDisableNotification(disableNotification bool) SendMessageMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendMessageMethod) WithProtectContent(protectContent bool) SendMessageMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendMessageMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendMessageMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendMessageMethod) WithMessageEffectID(messageEffectID string) SendMessageMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendMessageMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendMessageMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendMessageMethod) WithReplyParameters(replyParameters ReplyParameters) SendMessageMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendMessageMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendMessageMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendMessageMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6378,6 +6582,54 @@ type ForwardMessageMethod struct {
 	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
 }
 
+// NewForwardMessageMethod creates the request of forwardMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewForwardMessageMethod(chatID ChatID, fromChatID ChatID, messageID int64) ForwardMessageMethod {
+	return ForwardMessageMethod{ChatID: chatID, FromChatID: fromChatID, MessageID: messageID}
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m ForwardMessageMethod) WithMessageThreadID(messageThreadID int64) ForwardMessageMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m ForwardMessageMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) ForwardMessageMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithVideoStartTimestamp returns a copy of m with VideoStartTimestamp set to videoStartTimestamp.
+func (m ForwardMessageMethod) WithVideoStartTimestamp(videoStartTimestamp int64) ForwardMessageMethod {
+	m.VideoStartTimestamp = &videoStartTimestamp
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m ForwardMessageMethod) WithDisableNotification(disableNotification bool) ForwardMessageMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m ForwardMessageMethod) WithProtectContent(protectContent bool) ForwardMessageMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m ForwardMessageMethod) WithMessageEffectID(messageEffectID string) ForwardMessageMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m ForwardMessageMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) ForwardMessageMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
 func (m ForwardMessageMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6424,6 +6676,36 @@ type ForwardMessagesMethod struct {
 	ProtectContent *bool `json:"protect_content,omitempty"`
 }
 
+// NewForwardMessagesMethod creates the request of forwardMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewForwardMessagesMethod(chatID ChatID, fromChatID ChatID, messageIDs []int64) ForwardMessagesMethod {
+	return ForwardMessagesMethod{ChatID: chatID, FromChatID: fromChatID, MessageIDs: messageIDs}
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m ForwardMessagesMethod) WithMessageThreadID(messageThreadID int64) ForwardMessagesMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m ForwardMessagesMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) ForwardMessagesMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m ForwardMessagesMethod) WithDisableNotification(disableNotification bool) ForwardMessagesMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m ForwardMessagesMethod) WithProtectContent(protectContent bool) ForwardMessagesMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
 func (m ForwardMessagesMethod) Call(ctx context.Context, conn Connection) ([]MessageID, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6500,6 +6782,96 @@ type CopyMessageMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewCopyMessageMethod creates the request of copyMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewCopyMessageMethod(chatID ChatID, fromChatID ChatID, messageID int64) CopyMessageMethod {
+	return CopyMessageMethod{ChatID: chatID, FromChatID: fromChatID, MessageID: messageID}
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m CopyMessageMethod) WithMessageThreadID(messageThreadID int64) CopyMessageMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m CopyMessageMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) CopyMessageMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithVideoStartTimestamp returns a copy of m with VideoStartTimestamp set to videoStartTimestamp.
+func (m CopyMessageMethod) WithVideoStartTimestamp(videoStartTimestamp int64) CopyMessageMethod {
+	m.VideoStartTimestamp = &videoStartTimestamp
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m CopyMessageMethod) WithCaption(caption string) CopyMessageMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m CopyMessageMethod) WithParseMode(parseMode string) CopyMessageMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m CopyMessageMethod) WithCaptionEntities(captionEntities []MessageEntity) CopyMessageMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithShowCaptionAboveMedia returns a copy of m with ShowCaptionAboveMedia set to showCaptionAboveMedia.
+func (m CopyMessageMethod) WithShowCaptionAboveMedia(showCaptionAboveMedia bool) CopyMessageMethod {
+	m.ShowCaptionAboveMedia = &showCaptionAboveMedia
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m CopyMessageMethod) WithDisableNotification(disableNotification bool) CopyMessageMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m CopyMessageMethod) WithProtectContent(protectContent bool) CopyMessageMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m CopyMessageMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) CopyMessageMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m CopyMessageMethod) WithMessageEffectID(messageEffectID string) CopyMessageMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m CopyMessageMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) CopyMessageMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m CopyMessageMethod) WithReplyParameters(replyParameters ReplyParameters) CopyMessageMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m CopyMessageMethod) WithReplyMarkup(replyMarkup ReplyMarkup) CopyMessageMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m CopyMessageMethod) Call(ctx context.Context, conn Connection) (MessageID, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6551,6 +6923,42 @@ type CopyMessagesMethod struct {
 	RemoveCaption *bool `json:"remove_caption,omitempty"`
 }
 
+// NewCopyMessagesMethod creates the request of copyMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewCopyMessagesMethod(chatID ChatID, fromChatID ChatID, messageIDs []int64) CopyMessagesMethod {
+	return CopyMessagesMethod{ChatID: chatID, FromChatID: fromChatID, MessageIDs: messageIDs}
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m CopyMessagesMethod) WithMessageThreadID(messageThreadID int64) CopyMessagesMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m CopyMessagesMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) CopyMessagesMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m CopyMessagesMethod) WithDisableNotification(disableNotification bool) CopyMessagesMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m CopyMessagesMethod) WithProtectContent(protectContent bool) CopyMessagesMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithRemoveCaption returns a copy of m with RemoveCaption set to removeCaption.
+func (m CopyMessagesMethod) WithRemoveCaption(removeCaption bool) CopyMessagesMethod {
+	m.RemoveCaption = &removeCaption
+	return m
+}
+
 func (m CopyMessagesMethod) Call(ctx context.Context, conn Connection) ([]MessageID, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6634,6 +7042,114 @@ type SendPhotoMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendPhotoMethod creates the request of sendPhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewSendPhotoMethod(chatID ChatID, photo InputFile) SendPhotoMethod {
+	return SendPhotoMethod{ChatID: chatID, Photo: photo}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendPhotoMethod) WithBusinessConnectionID(businessConnectionID string) SendPhotoMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendPhotoMethod) WithMessageThreadID(messageThreadID int64) SendPhotoMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendPhotoMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendPhotoMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendPhotoMethod) WithReceiverUserID(receiverUserID int64) SendPhotoMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendPhotoMethod) WithCallbackQueryID(callbackQueryID string) SendPhotoMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendPhotoMethod) WithCaption(caption string) SendPhotoMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendPhotoMethod) WithParseMode(parseMode string) SendPhotoMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendPhotoMethod) WithCaptionEntities(captionEntities []MessageEntity) SendPhotoMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithShowCaptionAboveMedia returns a copy of m with ShowCaptionAboveMedia set to showCaptionAboveMedia.
+func (m SendPhotoMethod) WithShowCaptionAboveMedia(showCaptionAboveMedia bool) SendPhotoMethod {
+	m.ShowCaptionAboveMedia = &showCaptionAboveMedia
+	return m
+}
+
+// WithHasSpoiler returns a copy of m with HasSpoiler set to hasSpoiler.
+func (m SendPhotoMethod) WithHasSpoiler(hasSpoiler bool) SendPhotoMethod {
+	m.HasSpoiler = &hasSpoiler
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendPhotoMethod) WithDisableNotification(disableNotification bool) SendPhotoMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendPhotoMethod) WithProtectContent(protectContent bool) SendPhotoMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendPhotoMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendPhotoMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendPhotoMethod) WithMessageEffectID(messageEffectID string) SendPhotoMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendPhotoMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendPhotoMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendPhotoMethod) WithReplyParameters(replyParameters ReplyParameters) SendPhotoMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendPhotoMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendPhotoMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendPhotoMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6731,6 +7247,114 @@ type SendLivePhotoMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendLivePhotoMethod creates the request of sendLivePhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewSendLivePhotoMethod(chatID ChatID, livePhoto InputFile, photo InputFile) SendLivePhotoMethod {
+	return SendLivePhotoMethod{ChatID: chatID, LivePhoto: livePhoto, Photo: photo}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendLivePhotoMethod) WithBusinessConnectionID(businessConnectionID string) SendLivePhotoMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendLivePhotoMethod) WithMessageThreadID(messageThreadID int64) SendLivePhotoMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendLivePhotoMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendLivePhotoMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendLivePhotoMethod) WithReceiverUserID(receiverUserID int64) SendLivePhotoMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendLivePhotoMethod) WithCallbackQueryID(callbackQueryID string) SendLivePhotoMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendLivePhotoMethod) WithCaption(caption string) SendLivePhotoMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendLivePhotoMethod) WithParseMode(parseMode string) SendLivePhotoMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendLivePhotoMethod) WithCaptionEntities(captionEntities []MessageEntity) SendLivePhotoMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithShowCaptionAboveMedia returns a copy of m with ShowCaptionAboveMedia set to showCaptionAboveMedia.
+func (m SendLivePhotoMethod) WithShowCaptionAboveMedia(showCaptionAboveMedia bool) SendLivePhotoMethod {
+	m.ShowCaptionAboveMedia = &showCaptionAboveMedia
+	return m
+}
+
+// WithHasSpoiler returns a copy of m with HasSpoiler set to hasSpoiler.
+func (m SendLivePhotoMethod) WithHasSpoiler(hasSpoiler bool) SendLivePhotoMethod {
+	m.HasSpoiler = &hasSpoiler
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendLivePhotoMethod) WithDisableNotification(disableNotification bool) SendLivePhotoMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendLivePhotoMethod) WithProtectContent(protectContent bool) SendLivePhotoMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendLivePhotoMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendLivePhotoMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendLivePhotoMethod) WithMessageEffectID(messageEffectID string) SendLivePhotoMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendLivePhotoMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendLivePhotoMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendLivePhotoMethod) WithReplyParameters(replyParameters ReplyParameters) SendLivePhotoMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendLivePhotoMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendLivePhotoMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendLivePhotoMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6839,6 +7463,126 @@ type SendAudioMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendAudioMethod creates the request of sendAudio from the parameters it
+// requires, leaving every optional one unset.
+func NewSendAudioMethod(chatID ChatID, audio InputFile) SendAudioMethod {
+	return SendAudioMethod{ChatID: chatID, Audio: audio}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendAudioMethod) WithBusinessConnectionID(businessConnectionID string) SendAudioMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendAudioMethod) WithMessageThreadID(messageThreadID int64) SendAudioMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendAudioMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendAudioMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendAudioMethod) WithReceiverUserID(receiverUserID int64) SendAudioMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendAudioMethod) WithCallbackQueryID(callbackQueryID string) SendAudioMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendAudioMethod) WithCaption(caption string) SendAudioMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendAudioMethod) WithParseMode(parseMode string) SendAudioMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendAudioMethod) WithCaptionEntities(captionEntities []MessageEntity) SendAudioMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithDuration returns a copy of m with Duration set to duration.
+func (m SendAudioMethod) WithDuration(duration int64) SendAudioMethod {
+	m.Duration = &duration
+	return m
+}
+
+// WithPerformer returns a copy of m with Performer set to performer.
+func (m SendAudioMethod) WithPerformer(performer string) SendAudioMethod {
+	m.Performer = &performer
+	return m
+}
+
+// WithTitle returns a copy of m with Title set to title.
+func (m SendAudioMethod) WithTitle(title string) SendAudioMethod {
+	m.Title = &title
+	return m
+}
+
+// WithThumbnail returns a copy of m with Thumbnail set to thumbnail.
+func (m SendAudioMethod) WithThumbnail(thumbnail InputFile) SendAudioMethod {
+	m.Thumbnail = thumbnail
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendAudioMethod) WithDisableNotification(disableNotification bool) SendAudioMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendAudioMethod) WithProtectContent(protectContent bool) SendAudioMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendAudioMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendAudioMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendAudioMethod) WithMessageEffectID(messageEffectID string) SendAudioMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendAudioMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendAudioMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendAudioMethod) WithReplyParameters(replyParameters ReplyParameters) SendAudioMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendAudioMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendAudioMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendAudioMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -6945,14 +7689,122 @@ type SendDocumentMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
-func (m SendDocumentMethod) Call(ctx context.Context, conn Connection) (Message, error) {
-	payload, err := m.payload()
-	if err != nil {
-		return Message{}, err
-	}
-	var resp Message
-	if err := conn.Do(ctx, "sendDocument", payload, &resp); err != nil {
-		return Message{}, err
+// NewSendDocumentMethod creates the request of sendDocument from the parameters it
+// requires, leaving every optional one unset.
+func NewSendDocumentMethod(chatID ChatID, document InputFile) SendDocumentMethod {
+	return SendDocumentMethod{ChatID: chatID, Document: document}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendDocumentMethod) WithBusinessConnectionID(businessConnectionID string) SendDocumentMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendDocumentMethod) WithMessageThreadID(messageThreadID int64) SendDocumentMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendDocumentMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendDocumentMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendDocumentMethod) WithReceiverUserID(receiverUserID int64) SendDocumentMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendDocumentMethod) WithCallbackQueryID(callbackQueryID string) SendDocumentMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithThumbnail returns a copy of m with Thumbnail set to thumbnail.
+func (m SendDocumentMethod) WithThumbnail(thumbnail InputFile) SendDocumentMethod {
+	m.Thumbnail = thumbnail
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendDocumentMethod) WithCaption(caption string) SendDocumentMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendDocumentMethod) WithParseMode(parseMode string) SendDocumentMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendDocumentMethod) WithCaptionEntities(captionEntities []MessageEntity) SendDocumentMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithDisableContentTypeDetection returns a copy of m with DisableContentTypeDetection set to disableContentTypeDetection.
+func (m SendDocumentMethod) WithDisableContentTypeDetection(disableContentTypeDetection bool) SendDocumentMethod {
+	m.DisableContentTypeDetection = &disableContentTypeDetection
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendDocumentMethod) WithDisableNotification(disableNotification bool) SendDocumentMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendDocumentMethod) WithProtectContent(protectContent bool) SendDocumentMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendDocumentMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendDocumentMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendDocumentMethod) WithMessageEffectID(messageEffectID string) SendDocumentMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendDocumentMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendDocumentMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendDocumentMethod) WithReplyParameters(replyParameters ReplyParameters) SendDocumentMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendDocumentMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendDocumentMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
+func (m SendDocumentMethod) Call(ctx context.Context, conn Connection) (Message, error) {
+	payload, err := m.payload()
+	if err != nil {
+		return Message{}, err
+	}
+	var resp Message
+	if err := conn.Do(ctx, "sendDocument", payload, &resp); err != nil {
+		return Message{}, err
 	}
 	return resp, nil
 }
@@ -7069,6 +7921,156 @@ type SendVideoMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendVideoMethod creates the request of sendVideo from the parameters it
+// requires, leaving every optional one unset.
+func NewSendVideoMethod(chatID ChatID, video InputFile) SendVideoMethod {
+	return SendVideoMethod{ChatID: chatID, Video: video}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendVideoMethod) WithBusinessConnectionID(businessConnectionID string) SendVideoMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendVideoMethod) WithMessageThreadID(messageThreadID int64) SendVideoMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendVideoMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendVideoMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendVideoMethod) WithReceiverUserID(receiverUserID int64) SendVideoMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendVideoMethod) WithCallbackQueryID(callbackQueryID string) SendVideoMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithDuration returns a copy of m with Duration set to duration.
+func (m SendVideoMethod) WithDuration(duration int64) SendVideoMethod {
+	m.Duration = &duration
+	return m
+}
+
+// WithWidth returns a copy of m with Width set to width.
+func (m SendVideoMethod) WithWidth(width int64) SendVideoMethod {
+	m.Width = &width
+	return m
+}
+
+// WithHeight returns a copy of m with Height set to height.
+func (m SendVideoMethod) WithHeight(height int64) SendVideoMethod {
+	m.Height = &height
+	return m
+}
+
+// WithThumbnail returns a copy of m with Thumbnail set to thumbnail.
+func (m SendVideoMethod) WithThumbnail(thumbnail InputFile) SendVideoMethod {
+	m.Thumbnail = thumbnail
+	return m
+}
+
+// WithCover returns a copy of m with Cover set to cover.
+func (m SendVideoMethod) WithCover(cover InputFile) SendVideoMethod {
+	m.Cover = cover
+	return m
+}
+
+// WithStartTimestamp returns a copy of m with StartTimestamp set to startTimestamp.
+func (m SendVideoMethod) WithStartTimestamp(startTimestamp int64) SendVideoMethod {
+	m.StartTimestamp = &startTimestamp
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendVideoMethod) WithCaption(caption string) SendVideoMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendVideoMethod) WithParseMode(parseMode string) SendVideoMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendVideoMethod) WithCaptionEntities(captionEntities []MessageEntity) SendVideoMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithShowCaptionAboveMedia returns a copy of m with ShowCaptionAboveMedia set to showCaptionAboveMedia.
+func (m SendVideoMethod) WithShowCaptionAboveMedia(showCaptionAboveMedia bool) SendVideoMethod {
+	m.ShowCaptionAboveMedia = &showCaptionAboveMedia
+	return m
+}
+
+// WithHasSpoiler returns a copy of m with HasSpoiler set to hasSpoiler.
+func (m SendVideoMethod) WithHasSpoiler(hasSpoiler bool) SendVideoMethod {
+	m.HasSpoiler = &hasSpoiler
+	return m
+}
+
+// WithSupportsStreaming returns a copy of m with SupportsStreaming set to supportsStreaming.
+func (m SendVideoMethod) WithSupportsStreaming(supportsStreaming bool) SendVideoMethod {
+	m.SupportsStreaming = &supportsStreaming
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendVideoMethod) WithDisableNotification(disableNotification bool) SendVideoMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendVideoMethod) WithProtectContent(protectContent bool) SendVideoMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendVideoMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendVideoMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendVideoMethod) WithMessageEffectID(messageEffectID string) SendVideoMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendVideoMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendVideoMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendVideoMethod) WithReplyParameters(replyParameters ReplyParameters) SendVideoMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendVideoMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendVideoMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendVideoMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7189,6 +8191,138 @@ type SendAnimationMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendAnimationMethod creates the request of sendAnimation from the parameters it
+// requires, leaving every optional one unset.
+func NewSendAnimationMethod(chatID ChatID, animation InputFile) SendAnimationMethod {
+	return SendAnimationMethod{ChatID: chatID, Animation: animation}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendAnimationMethod) WithBusinessConnectionID(businessConnectionID string) SendAnimationMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendAnimationMethod) WithMessageThreadID(messageThreadID int64) SendAnimationMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendAnimationMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendAnimationMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendAnimationMethod) WithReceiverUserID(receiverUserID int64) SendAnimationMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendAnimationMethod) WithCallbackQueryID(callbackQueryID string) SendAnimationMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithDuration returns a copy of m with Duration set to duration.
+func (m SendAnimationMethod) WithDuration(duration int64) SendAnimationMethod {
+	m.Duration = &duration
+	return m
+}
+
+// WithWidth returns a copy of m with Width set to width.
+func (m SendAnimationMethod) WithWidth(width int64) SendAnimationMethod {
+	m.Width = &width
+	return m
+}
+
+// WithHeight returns a copy of m with Height set to height.
+func (m SendAnimationMethod) WithHeight(height int64) SendAnimationMethod {
+	m.Height = &height
+	return m
+}
+
+// WithThumbnail returns a copy of m with Thumbnail set to thumbnail.
+func (m SendAnimationMethod) WithThumbnail(thumbnail InputFile) SendAnimationMethod {
+	m.Thumbnail = thumbnail
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendAnimationMethod) WithCaption(caption string) SendAnimationMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendAnimationMethod) WithParseMode(parseMode string) SendAnimationMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendAnimationMethod) WithCaptionEntities(captionEntities []MessageEntity) SendAnimationMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithShowCaptionAboveMedia returns a copy of m with ShowCaptionAboveMedia set to showCaptionAboveMedia.
+func (m SendAnimationMethod) WithShowCaptionAboveMedia(showCaptionAboveMedia bool) SendAnimationMethod {
+	m.ShowCaptionAboveMedia = &showCaptionAboveMedia
+	return m
+}
+
+// WithHasSpoiler returns a copy of m with HasSpoiler set to hasSpoiler.
+func (m SendAnimationMethod) WithHasSpoiler(hasSpoiler bool) SendAnimationMethod {
+	m.HasSpoiler = &hasSpoiler
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendAnimationMethod) WithDisableNotification(disableNotification bool) SendAnimationMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendAnimationMethod) WithProtectContent(protectContent bool) SendAnimationMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendAnimationMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendAnimationMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendAnimationMethod) WithMessageEffectID(messageEffectID string) SendAnimationMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendAnimationMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendAnimationMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendAnimationMethod) WithReplyParameters(replyParameters ReplyParameters) SendAnimationMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendAnimationMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendAnimationMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendAnimationMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7287,6 +8421,108 @@ type SendVoiceMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendVoiceMethod creates the request of sendVoice from the parameters it
+// requires, leaving every optional one unset.
+func NewSendVoiceMethod(chatID ChatID, voice InputFile) SendVoiceMethod {
+	return SendVoiceMethod{ChatID: chatID, Voice: voice}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendVoiceMethod) WithBusinessConnectionID(businessConnectionID string) SendVoiceMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendVoiceMethod) WithMessageThreadID(messageThreadID int64) SendVoiceMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendVoiceMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendVoiceMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendVoiceMethod) WithReceiverUserID(receiverUserID int64) SendVoiceMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendVoiceMethod) WithCallbackQueryID(callbackQueryID string) SendVoiceMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendVoiceMethod) WithCaption(caption string) SendVoiceMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendVoiceMethod) WithParseMode(parseMode string) SendVoiceMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendVoiceMethod) WithCaptionEntities(captionEntities []MessageEntity) SendVoiceMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithDuration returns a copy of m with Duration set to duration.
+func (m SendVoiceMethod) WithDuration(duration int64) SendVoiceMethod {
+	m.Duration = &duration
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendVoiceMethod) WithDisableNotification(disableNotification bool) SendVoiceMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendVoiceMethod) WithProtectContent(protectContent bool) SendVoiceMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendVoiceMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendVoiceMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendVoiceMethod) WithMessageEffectID(messageEffectID string) SendVoiceMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendVoiceMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendVoiceMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendVoiceMethod) WithReplyParameters(replyParameters ReplyParameters) SendVoiceMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendVoiceMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendVoiceMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendVoiceMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7379,6 +8615,102 @@ type SendVideoNoteMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendVideoNoteMethod creates the request of sendVideoNote from the parameters it
+// requires, leaving every optional one unset.
+func NewSendVideoNoteMethod(chatID ChatID, videoNote InputFile) SendVideoNoteMethod {
+	return SendVideoNoteMethod{ChatID: chatID, VideoNote: videoNote}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendVideoNoteMethod) WithBusinessConnectionID(businessConnectionID string) SendVideoNoteMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendVideoNoteMethod) WithMessageThreadID(messageThreadID int64) SendVideoNoteMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendVideoNoteMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendVideoNoteMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendVideoNoteMethod) WithReceiverUserID(receiverUserID int64) SendVideoNoteMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendVideoNoteMethod) WithCallbackQueryID(callbackQueryID string) SendVideoNoteMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithDuration returns a copy of m with Duration set to duration.
+func (m SendVideoNoteMethod) WithDuration(duration int64) SendVideoNoteMethod {
+	m.Duration = &duration
+	return m
+}
+
+// WithLength returns a copy of m with Length set to length.
+func (m SendVideoNoteMethod) WithLength(length int64) SendVideoNoteMethod {
+	m.Length = &length
+	return m
+}
+
+// WithThumbnail returns a copy of m with Thumbnail set to thumbnail.
+func (m SendVideoNoteMethod) WithThumbnail(thumbnail InputFile) SendVideoNoteMethod {
+	m.Thumbnail = thumbnail
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendVideoNoteMethod) WithDisableNotification(disableNotification bool) SendVideoNoteMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendVideoNoteMethod) WithProtectContent(protectContent bool) SendVideoNoteMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendVideoNoteMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendVideoNoteMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendVideoNoteMethod) WithMessageEffectID(messageEffectID string) SendVideoNoteMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendVideoNoteMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendVideoNoteMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendVideoNoteMethod) WithReplyParameters(replyParameters ReplyParameters) SendVideoNoteMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendVideoNoteMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendVideoNoteMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendVideoNoteMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7466,6 +8798,96 @@ type SendPaidMediaMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendPaidMediaMethod creates the request of sendPaidMedia from the parameters it
+// requires, leaving every optional one unset.
+func NewSendPaidMediaMethod(chatID ChatID, starCount int64, media []InputPaidMedia) SendPaidMediaMethod {
+	return SendPaidMediaMethod{ChatID: chatID, StarCount: starCount, Media: media}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendPaidMediaMethod) WithBusinessConnectionID(businessConnectionID string) SendPaidMediaMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendPaidMediaMethod) WithMessageThreadID(messageThreadID int64) SendPaidMediaMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendPaidMediaMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendPaidMediaMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithPayload returns a copy of m with Payload set to payload.
+func (m SendPaidMediaMethod) WithPayload(payload string) SendPaidMediaMethod {
+	m.Payload = &payload
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m SendPaidMediaMethod) WithCaption(caption string) SendPaidMediaMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendPaidMediaMethod) WithParseMode(parseMode string) SendPaidMediaMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m SendPaidMediaMethod) WithCaptionEntities(captionEntities []MessageEntity) SendPaidMediaMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithShowCaptionAboveMedia returns a copy of m with ShowCaptionAboveMedia set to showCaptionAboveMedia.
+func (m SendPaidMediaMethod) WithShowCaptionAboveMedia(showCaptionAboveMedia bool) SendPaidMediaMethod {
+	m.ShowCaptionAboveMedia = &showCaptionAboveMedia
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendPaidMediaMethod) WithDisableNotification(disableNotification bool) SendPaidMediaMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendPaidMediaMethod) WithProtectContent(protectContent bool) SendPaidMediaMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendPaidMediaMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendPaidMediaMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendPaidMediaMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendPaidMediaMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendPaidMediaMethod) WithReplyParameters(replyParameters ReplyParameters) SendPaidMediaMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendPaidMediaMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendPaidMediaMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendPaidMediaMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7535,6 +8957,60 @@ type SendMediaGroupMethod struct {
 	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
 }
 
+// NewSendMediaGroupMethod creates the request of sendMediaGroup from the parameters it
+// requires, leaving every optional one unset.
+func NewSendMediaGroupMethod(chatID ChatID, media []InputMediaGroup) SendMediaGroupMethod {
+	return SendMediaGroupMethod{ChatID: chatID, Media: media}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendMediaGroupMethod) WithBusinessConnectionID(businessConnectionID string) SendMediaGroupMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendMediaGroupMethod) WithMessageThreadID(messageThreadID int64) SendMediaGroupMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendMediaGroupMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendMediaGroupMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendMediaGroupMethod) WithDisableNotification(disableNotification bool) SendMediaGroupMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendMediaGroupMethod) WithProtectContent(protectContent bool) SendMediaGroupMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendMediaGroupMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendMediaGroupMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendMediaGroupMethod) WithMessageEffectID(messageEffectID string) SendMediaGroupMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendMediaGroupMethod) WithReplyParameters(replyParameters ReplyParameters) SendMediaGroupMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
 func (m SendMediaGroupMethod) Call(ctx context.Context, conn Connection) ([]Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7631,6 +9107,108 @@ type SendLocationMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendLocationMethod creates the request of sendLocation from the parameters it
+// requires, leaving every optional one unset.
+func NewSendLocationMethod(chatID ChatID, latitude float64, longitude float64) SendLocationMethod {
+	return SendLocationMethod{ChatID: chatID, Latitude: latitude, Longitude: longitude}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendLocationMethod) WithBusinessConnectionID(businessConnectionID string) SendLocationMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendLocationMethod) WithMessageThreadID(messageThreadID int64) SendLocationMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendLocationMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendLocationMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendLocationMethod) WithReceiverUserID(receiverUserID int64) SendLocationMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendLocationMethod) WithCallbackQueryID(callbackQueryID string) SendLocationMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithHorizontalAccuracy returns a copy of m with HorizontalAccuracy set to horizontalAccuracy.
+func (m SendLocationMethod) WithHorizontalAccuracy(horizontalAccuracy float64) SendLocationMethod {
+	m.HorizontalAccuracy = &horizontalAccuracy
+	return m
+}
+
+// WithLivePeriod returns a copy of m with LivePeriod set to livePeriod.
+func (m SendLocationMethod) WithLivePeriod(livePeriod int64) SendLocationMethod {
+	m.LivePeriod = &livePeriod
+	return m
+}
+
+// WithHeading returns a copy of m with Heading set to heading.
+func (m SendLocationMethod) WithHeading(heading int64) SendLocationMethod {
+	m.Heading = &heading
+	return m
+}
+
+// WithProximityAlertRadius returns a copy of m with ProximityAlertRadius set to proximityAlertRadius.
+func (m SendLocationMethod) WithProximityAlertRadius(proximityAlertRadius int64) SendLocationMethod {
+	m.ProximityAlertRadius = &proximityAlertRadius
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendLocationMethod) WithDisableNotification(disableNotification bool) SendLocationMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendLocationMethod) WithProtectContent(protectContent bool) SendLocationMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendLocationMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendLocationMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendLocationMethod) WithMessageEffectID(messageEffectID string) SendLocationMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendLocationMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendLocationMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendLocationMethod) WithReplyParameters(replyParameters ReplyParameters) SendLocationMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendLocationMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendLocationMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendLocationMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7713,6 +9291,108 @@ type SendVenueMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendVenueMethod creates the request of sendVenue from the parameters it
+// requires, leaving every optional one unset.
+func NewSendVenueMethod(chatID ChatID, latitude float64, longitude float64, title string, address string) SendVenueMethod {
+	return SendVenueMethod{ChatID: chatID, Latitude: latitude, Longitude: longitude, Title: title, Address: address}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendVenueMethod) WithBusinessConnectionID(businessConnectionID string) SendVenueMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendVenueMethod) WithMessageThreadID(messageThreadID int64) SendVenueMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendVenueMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendVenueMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendVenueMethod) WithReceiverUserID(receiverUserID int64) SendVenueMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendVenueMethod) WithCallbackQueryID(callbackQueryID string) SendVenueMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithFoursquareID returns a copy of m with FoursquareID set to foursquareID.
+func (m SendVenueMethod) WithFoursquareID(foursquareID string) SendVenueMethod {
+	m.FoursquareID = &foursquareID
+	return m
+}
+
+// WithFoursquareType returns a copy of m with FoursquareType set to foursquareType.
+func (m SendVenueMethod) WithFoursquareType(foursquareType string) SendVenueMethod {
+	m.FoursquareType = &foursquareType
+	return m
+}
+
+// WithGooglePlaceID returns a copy of m with GooglePlaceID set to googlePlaceID.
+func (m SendVenueMethod) WithGooglePlaceID(googlePlaceID string) SendVenueMethod {
+	m.GooglePlaceID = &googlePlaceID
+	return m
+}
+
+// WithGooglePlaceType returns a copy of m with GooglePlaceType set to googlePlaceType.
+func (m SendVenueMethod) WithGooglePlaceType(googlePlaceType string) SendVenueMethod {
+	m.GooglePlaceType = &googlePlaceType
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendVenueMethod) WithDisableNotification(disableNotification bool) SendVenueMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendVenueMethod) WithProtectContent(protectContent bool) SendVenueMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendVenueMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendVenueMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendVenueMethod) WithMessageEffectID(messageEffectID string) SendVenueMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendVenueMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendVenueMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendVenueMethod) WithReplyParameters(replyParameters ReplyParameters) SendVenueMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendVenueMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendVenueMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendVenueMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7785,6 +9465,96 @@ type SendContactMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendContactMethod creates the request of sendContact from the parameters it
+// requires, leaving every optional one unset.
+func NewSendContactMethod(chatID ChatID, phoneNumber string, firstName string) SendContactMethod {
+	return SendContactMethod{ChatID: chatID, PhoneNumber: phoneNumber, FirstName: firstName}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendContactMethod) WithBusinessConnectionID(businessConnectionID string) SendContactMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendContactMethod) WithMessageThreadID(messageThreadID int64) SendContactMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendContactMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendContactMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendContactMethod) WithReceiverUserID(receiverUserID int64) SendContactMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendContactMethod) WithCallbackQueryID(callbackQueryID string) SendContactMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithLastName returns a copy of m with LastName set to lastName.
+func (m SendContactMethod) WithLastName(lastName string) SendContactMethod {
+	m.LastName = &lastName
+	return m
+}
+
+// WithVcard returns a copy of m with Vcard set to vcard.
+func (m SendContactMethod) WithVcard(vcard string) SendContactMethod {
+	m.Vcard = &vcard
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendContactMethod) WithDisableNotification(disableNotification bool) SendContactMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendContactMethod) WithProtectContent(protectContent bool) SendContactMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendContactMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendContactMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendContactMethod) WithMessageEffectID(messageEffectID string) SendContactMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendContactMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendContactMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendContactMethod) WithReplyParameters(replyParameters ReplyParameters) SendContactMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendContactMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendContactMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendContactMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7905,6 +9675,198 @@ type SendPollMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendPollMethod creates the request of sendPoll from the parameters it
+// requires, leaving every optional one unset.
+func NewSendPollMethod(chatID ChatID, question string, options []InputPollOption) SendPollMethod {
+	return SendPollMethod{ChatID: chatID, Question: question, Options: options}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendPollMethod) WithBusinessConnectionID(businessConnectionID string) SendPollMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendPollMethod) WithMessageThreadID(messageThreadID int64) SendPollMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithQuestionParseMode returns a copy of m with QuestionParseMode set to questionParseMode.
+func (m SendPollMethod) WithQuestionParseMode(questionParseMode string) SendPollMethod {
+	m.QuestionParseMode = &questionParseMode
+	return m
+}
+
+// WithQuestionEntities returns a copy of m with QuestionEntities set to questionEntities.
+func (m SendPollMethod) WithQuestionEntities(questionEntities []MessageEntity) SendPollMethod {
+	m.QuestionEntities = questionEntities
+	return m
+}
+
+// WithIsAnonymous returns a copy of m with IsAnonymous set to isAnonymous.
+func (m SendPollMethod) WithIsAnonymous(isAnonymous bool) SendPollMethod {
+	m.IsAnonymous = &isAnonymous
+	return m
+}
+
+// WithType returns a copy of m with Type set to type_.
+func (m SendPollMethod) WithType(type_ string) SendPollMethod {
+	m.Type = &type_
+	return m
+}
+
+// WithAllowsMultipleAnswers returns a copy of m with AllowsMultipleAnswers set to allowsMultipleAnswers.
+func (m SendPollMethod) WithAllowsMultipleAnswers(allowsMultipleAnswers bool) SendPollMethod {
+	m.AllowsMultipleAnswers = &allowsMultipleAnswers
+	return m
+}
+
+// WithAllowsRevoting returns a copy of m with AllowsRevoting set to allowsRevoting.
+func (m SendPollMethod) WithAllowsRevoting(allowsRevoting bool) SendPollMethod {
+	m.AllowsRevoting = &allowsRevoting
+	return m
+}
+
+// WithShuffleOptions returns a copy of m with ShuffleOptions set to shuffleOptions.
+func (m SendPollMethod) WithShuffleOptions(shuffleOptions bool) SendPollMethod {
+	m.ShuffleOptions = &shuffleOptions
+	return m
+}
+
+// WithAllowAddingOptions returns a copy of m with AllowAddingOptions set to allowAddingOptions.
+func (m SendPollMethod) WithAllowAddingOptions(allowAddingOptions bool) SendPollMethod {
+	m.AllowAddingOptions = &allowAddingOptions
+	return m
+}
+
+// WithHideResultsUntilCloses returns a copy of m with HideResultsUntilCloses set to hideResultsUntilCloses.
+func (m SendPollMethod) WithHideResultsUntilCloses(hideResultsUntilCloses bool) SendPollMethod {
+	m.HideResultsUntilCloses = &hideResultsUntilCloses
+	return m
+}
+
+// WithMembersOnly returns a copy of m with MembersOnly set to membersOnly.
+func (m SendPollMethod) WithMembersOnly(membersOnly bool) SendPollMethod {
+	m.MembersOnly = &membersOnly
+	return m
+}
+
+// WithCountryCodes returns a copy of m with CountryCodes set to countryCodes.
+func (m SendPollMethod) WithCountryCodes(countryCodes []string) SendPollMethod {
+	m.CountryCodes = countryCodes
+	return m
+}
+
+// WithCorrectOptionIDs returns a copy of m with CorrectOptionIDs set to correctOptionIDs.
+func (m SendPollMethod) WithCorrectOptionIDs(correctOptionIDs []int64) SendPollMethod {
+	m.CorrectOptionIDs = correctOptionIDs
+	return m
+}
+
+// WithExplanation returns a copy of m with Explanation set to explanation.
+func (m SendPollMethod) WithExplanation(explanation string) SendPollMethod {
+	m.Explanation = &explanation
+	return m
+}
+
+// WithExplanationParseMode returns a copy of m with ExplanationParseMode set to explanationParseMode.
+func (m SendPollMethod) WithExplanationParseMode(explanationParseMode string) SendPollMethod {
+	m.ExplanationParseMode = &explanationParseMode
+	return m
+}
+
+// WithExplanationEntities returns a copy of m with ExplanationEntities set to explanationEntities.
+func (m SendPollMethod) WithExplanationEntities(explanationEntities []MessageEntity) SendPollMethod {
+	m.ExplanationEntities = explanationEntities
+	return m
+}
+
+// WithExplanationMedia returns a copy of m with ExplanationMedia set to explanationMedia.
+func (m SendPollMethod) WithExplanationMedia(explanationMedia InputPollMedia) SendPollMethod {
+	m.ExplanationMedia = explanationMedia
+	return m
+}
+
+// WithOpenPeriod returns a copy of m with OpenPeriod set to openPeriod.
+func (m SendPollMethod) WithOpenPeriod(openPeriod int64) SendPollMethod {
+	m.OpenPeriod = &openPeriod
+	return m
+}
+
+// WithCloseDate returns a copy of m with CloseDate set to closeDate.
+func (m SendPollMethod) WithCloseDate(closeDate int64) SendPollMethod {
+	m.CloseDate = &closeDate
+	return m
+}
+
+// WithIsClosed returns a copy of m with IsClosed set to isClosed.
+func (m SendPollMethod) WithIsClosed(isClosed bool) SendPollMethod {
+	m.IsClosed = &isClosed
+	return m
+}
+
+// WithDescription returns a copy of m with Description set to description.
+func (m SendPollMethod) WithDescription(description string) SendPollMethod {
+	m.Description = &description
+	return m
+}
+
+// WithDescriptionParseMode returns a copy of m with DescriptionParseMode set to descriptionParseMode.
+func (m SendPollMethod) WithDescriptionParseMode(descriptionParseMode string) SendPollMethod {
+	m.DescriptionParseMode = &descriptionParseMode
+	return m
+}
+
+// WithDescriptionEntities returns a copy of m with DescriptionEntities set to descriptionEntities.
+func (m SendPollMethod) WithDescriptionEntities(descriptionEntities []MessageEntity) SendPollMethod {
+	m.DescriptionEntities = descriptionEntities
+	return m
+}
+
+// WithMedia returns a copy of m with Media set to media.
+func (m SendPollMethod) WithMedia(media InputPollMedia) SendPollMethod {
+	m.Media = media
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendPollMethod) WithDisableNotification(disableNotification bool) SendPollMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendPollMethod) WithProtectContent(protectContent bool) SendPollMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendPollMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendPollMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendPollMethod) WithMessageEffectID(messageEffectID string) SendPollMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendPollMethod) WithReplyParameters(replyParameters ReplyParameters) SendPollMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendPollMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendPollMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendPollMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -7982,6 +9944,42 @@ type SendChecklistMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendChecklistMethod creates the request of sendChecklist from the parameters it
+// requires, leaving every optional one unset.
+func NewSendChecklistMethod(businessConnectionID string, chatID ChatID, checklist InputChecklist) SendChecklistMethod {
+	return SendChecklistMethod{BusinessConnectionID: businessConnectionID, ChatID: chatID, Checklist: checklist}
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendChecklistMethod) WithDisableNotification(disableNotification bool) SendChecklistMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendChecklistMethod) WithProtectContent(protectContent bool) SendChecklistMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendChecklistMethod) WithMessageEffectID(messageEffectID string) SendChecklistMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendChecklistMethod) WithReplyParameters(replyParameters ReplyParameters) SendChecklistMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendChecklistMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) SendChecklistMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m SendChecklistMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8042,6 +10040,78 @@ type SendDiceMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendDiceMethod creates the request of sendDice from the parameters it
+// requires, leaving every optional one unset.
+func NewSendDiceMethod(chatID ChatID) SendDiceMethod {
+	return SendDiceMethod{ChatID: chatID}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendDiceMethod) WithBusinessConnectionID(businessConnectionID string) SendDiceMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendDiceMethod) WithMessageThreadID(messageThreadID int64) SendDiceMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendDiceMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendDiceMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithEmoji returns a copy of m with Emoji set to emoji.
+func (m SendDiceMethod) WithEmoji(emoji string) SendDiceMethod {
+	m.Emoji = &emoji
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendDiceMethod) WithDisableNotification(disableNotification bool) SendDiceMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendDiceMethod) WithProtectContent(protectContent bool) SendDiceMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendDiceMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendDiceMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendDiceMethod) WithMessageEffectID(messageEffectID string) SendDiceMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendDiceMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendDiceMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendDiceMethod) WithReplyParameters(replyParameters ReplyParameters) SendDiceMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendDiceMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendDiceMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendDiceMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8084,6 +10154,36 @@ type SendMessageDraftMethod struct {
 	Entities []MessageEntity `json:"entities,omitempty"`
 }
 
+// NewSendMessageDraftMethod creates the request of sendMessageDraft from the parameters it
+// requires, leaving every optional one unset.
+func NewSendMessageDraftMethod(chatID int64, draftID int64) SendMessageDraftMethod {
+	return SendMessageDraftMethod{ChatID: chatID, DraftID: draftID}
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendMessageDraftMethod) WithMessageThreadID(messageThreadID int64) SendMessageDraftMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithText returns a copy of m with Text set to text.
+func (m SendMessageDraftMethod) WithText(text string) SendMessageDraftMethod {
+	m.Text = &text
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m SendMessageDraftMethod) WithParseMode(parseMode string) SendMessageDraftMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithEntities returns a copy of m with Entities set to entities.
+func (m SendMessageDraftMethod) WithEntities(entities []MessageEntity) SendMessageDraftMethod {
+	m.Entities = entities
+	return m
+}
+
 func (m SendMessageDraftMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8129,6 +10229,24 @@ type SendChatActionMethod struct {
 	MessageThreadID *int64 `json:"message_thread_id,omitempty"`
 }
 
+// NewSendChatActionMethod creates the request of sendChatAction from the parameters it
+// requires, leaving every optional one unset.
+func NewSendChatActionMethod(chatID ChatID, action string) SendChatActionMethod {
+	return SendChatActionMethod{ChatID: chatID, Action: action}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendChatActionMethod) WithBusinessConnectionID(businessConnectionID string) SendChatActionMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendChatActionMethod) WithMessageThreadID(messageThreadID int64) SendChatActionMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
 func (m SendChatActionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8164,6 +10282,24 @@ type SetMessageReactionMethod struct {
 	IsBig *bool `json:"is_big,omitempty"`
 }
 
+// NewSetMessageReactionMethod creates the request of setMessageReaction from the parameters it
+// requires, leaving every optional one unset.
+func NewSetMessageReactionMethod(chatID ChatID, messageID int64) SetMessageReactionMethod {
+	return SetMessageReactionMethod{ChatID: chatID, MessageID: messageID}
+}
+
+// WithReaction returns a copy of m with Reaction set to reaction.
+func (m SetMessageReactionMethod) WithReaction(reaction []ReactionType) SetMessageReactionMethod {
+	m.Reaction = reaction
+	return m
+}
+
+// WithIsBig returns a copy of m with IsBig set to isBig.
+func (m SetMessageReactionMethod) WithIsBig(isBig bool) SetMessageReactionMethod {
+	m.IsBig = &isBig
+	return m
+}
+
 func (m SetMessageReactionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8191,6 +10327,24 @@ type GetUserProfilePhotosMethod struct {
 	Limit *int64 `json:"limit,omitempty"`
 }
 
+// NewGetUserProfilePhotosMethod creates the request of getUserProfilePhotos from the parameters it
+// requires, leaving every optional one unset.
+func NewGetUserProfilePhotosMethod(userID int64) GetUserProfilePhotosMethod {
+	return GetUserProfilePhotosMethod{UserID: userID}
+}
+
+// WithOffset returns a copy of m with Offset set to offset.
+func (m GetUserProfilePhotosMethod) WithOffset(offset int64) GetUserProfilePhotosMethod {
+	m.Offset = &offset
+	return m
+}
+
+// WithLimit returns a copy of m with Limit set to limit.
+func (m GetUserProfilePhotosMethod) WithLimit(limit int64) GetUserProfilePhotosMethod {
+	m.Limit = &limit
+	return m
+}
+
 func (m GetUserProfilePhotosMethod) Call(ctx context.Context, conn Connection) (UserProfilePhotos, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8222,6 +10376,24 @@ type GetUserProfileAudiosMethod struct {
 	Limit *int64 `json:"limit,omitempty"`
 }
 
+// NewGetUserProfileAudiosMethod creates the request of getUserProfileAudios from the parameters it
+// requires, leaving every optional one unset.
+func NewGetUserProfileAudiosMethod(userID int64) GetUserProfileAudiosMethod {
+	return GetUserProfileAudiosMethod{UserID: userID}
+}
+
+// WithOffset returns a copy of m with Offset set to offset.
+func (m GetUserProfileAudiosMethod) WithOffset(offset int64) GetUserProfileAudiosMethod {
+	m.Offset = &offset
+	return m
+}
+
+// WithLimit returns a copy of m with Limit set to limit.
+func (m GetUserProfileAudiosMethod) WithLimit(limit int64) GetUserProfileAudiosMethod {
+	m.Limit = &limit
+	return m
+}
+
 func (m GetUserProfileAudiosMethod) Call(ctx context.Context, conn Connection) (UserProfileAudios, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8253,6 +10425,24 @@ type SetUserEmojiStatusMethod struct {
 	EmojiStatusExpirationDate *int64 `json:"emoji_status_expiration_date,omitempty"`
 }
 
+// NewSetUserEmojiStatusMethod creates the request of setUserEmojiStatus from the parameters it
+// requires, leaving every optional one unset.
+func NewSetUserEmojiStatusMethod(userID int64) SetUserEmojiStatusMethod {
+	return SetUserEmojiStatusMethod{UserID: userID}
+}
+
+// WithEmojiStatusCustomEmojiID returns a copy of m with EmojiStatusCustomEmojiID set to emojiStatusCustomEmojiID.
+func (m SetUserEmojiStatusMethod) WithEmojiStatusCustomEmojiID(emojiStatusCustomEmojiID string) SetUserEmojiStatusMethod {
+	m.EmojiStatusCustomEmojiID = &emojiStatusCustomEmojiID
+	return m
+}
+
+// WithEmojiStatusExpirationDate returns a copy of m with EmojiStatusExpirationDate set to emojiStatusExpirationDate.
+func (m SetUserEmojiStatusMethod) WithEmojiStatusExpirationDate(emojiStatusExpirationDate int64) SetUserEmojiStatusMethod {
+	m.EmojiStatusExpirationDate = &emojiStatusExpirationDate
+	return m
+}
+
 func (m SetUserEmojiStatusMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8283,6 +10473,12 @@ type GetFileMethod struct {
 	FileID string `json:"file_id"`
 }
 
+// NewGetFileMethod creates the request of getFile from the parameters it
+// requires, leaving every optional one unset.
+func NewGetFileMethod(fileID string) GetFileMethod {
+	return GetFileMethod{FileID: fileID}
+}
+
 func (m GetFileMethod) Call(ctx context.Context, conn Connection) (File, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8299,6 +10495,25 @@ func (m GetFileMethod) payload() (jsonPayload, error) {
 	return newJSONPayload(m), nil
 }
 
+// Download looks the file fileID names up with getFile and streams its content
+// into w, returning what the lookup said about it. A file the Bot API will not
+// serve — one over 20 MB — is refused unless the connection points at a server
+// in local mode, which hands out a path on its own disk instead.
+func (c HTTPConnection) Download(ctx context.Context, fileID string, w io.Writer) (File, error) {
+	file, err := GetFileMethod{FileID: fileID}.Call(ctx, c)
+	if err != nil {
+		return File{}, err
+	}
+	if file.FilePath == nil {
+		return file, fmt.Errorf("file %q has no path to download from", fileID)
+	}
+	err = c.fetch(ctx, *file.FilePath, file.FileSize, w)
+	if err != nil {
+		return file, fmt.Errorf("downloading file %q: %w", fileID, err)
+	}
+	return file, nil
+}
+
 // Use this method to ban a user in a group, a supergroup or a channel. In the
 // case of supergroups and channels, the user will not be able to return to the
 // chat on their own using invite links, etc., unless unbanned first. The bot
@@ -8323,6 +10538,24 @@ type BanChatMemberMethod struct {
 	RevokeMessages *bool `json:"revoke_messages,omitempty"`
 }
 
+// NewBanChatMemberMethod creates the request of banChatMember from the parameters it
+// requires, leaving every optional one unset.
+func NewBanChatMemberMethod(chatID ChatID, userID int64) BanChatMemberMethod {
+	return BanChatMemberMethod{ChatID: chatID, UserID: userID}
+}
+
+// WithUntilDate returns a copy of m with UntilDate set to untilDate.
+func (m BanChatMemberMethod) WithUntilDate(untilDate int64) BanChatMemberMethod {
+	m.UntilDate = &untilDate
+	return m
+}
+
+// WithRevokeMessages returns a copy of m with RevokeMessages set to revokeMessages.
+func (m BanChatMemberMethod) WithRevokeMessages(revokeMessages bool) BanChatMemberMethod {
+	m.RevokeMessages = &revokeMessages
+	return m
+}
+
 func (m BanChatMemberMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8354,6 +10587,18 @@ type UnbanChatMemberMethod struct {
 	OnlyIfBanned *bool `json:"only_if_banned,omitempty"`
 }
 
+// NewUnbanChatMemberMethod creates the request of unbanChatMember from the parameters it
+// requires, leaving every optional one unset.
+func NewUnbanChatMemberMethod(chatID ChatID, userID int64) UnbanChatMemberMethod {
+	return UnbanChatMemberMethod{ChatID: chatID, UserID: userID}
+}
+
+// WithOnlyIfBanned returns a copy of m with OnlyIfBanned set to onlyIfBanned.
+func (m UnbanChatMemberMethod) WithOnlyIfBanned(onlyIfBanned bool) UnbanChatMemberMethod {
+	m.OnlyIfBanned = &onlyIfBanned
+	return m
+}
+
 func (m UnbanChatMemberMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8392,6 +10637,24 @@ type RestrictChatMemberMethod struct {
 	UntilDate *int64 `json:"until_date,omitempty"`
 }
 
+// NewRestrictChatMemberMethod creates the request of restrictChatMember from the parameters it
+// requires, leaving every optional one unset.
+func NewRestrictChatMemberMethod(chatID ChatID, userID int64, permissions ChatPermissions) RestrictChatMemberMethod {
+	return RestrictChatMemberMethod{ChatID: chatID, UserID: userID, Permissions: permissions}
+}
+
+// WithUseIndependentChatPermissions returns a copy of m with UseIndependentChatPermissions set to useIndependentChatPermissions.
+func (m RestrictChatMemberMethod) WithUseIndependentChatPermissions(useIndependentChatPermissions bool) RestrictChatMemberMethod {
+	m.UseIndependentChatPermissions = &useIndependentChatPermissions
+	return m
+}
+
+// WithUntilDate returns a copy of m with UntilDate set to untilDate.
+func (m RestrictChatMemberMethod) WithUntilDate(untilDate int64) RestrictChatMemberMethod {
+	m.UntilDate = &untilDate
+	return m
+}
+
 func (m RestrictChatMemberMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8468,6 +10731,114 @@ type PromoteChatMemberMethod struct {
 	CanManageTags *bool `json:"can_manage_tags,omitempty"`
 }
 
+// NewPromoteChatMemberMethod creates the request of promoteChatMember from the parameters it
+// requires, leaving every optional one unset.
+func NewPromoteChatMemberMethod(chatID ChatID, userID int64) PromoteChatMemberMethod {
+	return PromoteChatMemberMethod{ChatID: chatID, UserID: userID}
+}
+
+// WithIsAnonymous returns a copy of m with IsAnonymous set to isAnonymous.
+func (m PromoteChatMemberMethod) WithIsAnonymous(isAnonymous bool) PromoteChatMemberMethod {
+	m.IsAnonymous = &isAnonymous
+	return m
+}
+
+// WithCanManageChat returns a copy of m with CanManageChat set to canManageChat.
+func (m PromoteChatMemberMethod) WithCanManageChat(canManageChat bool) PromoteChatMemberMethod {
+	m.CanManageChat = &canManageChat
+	return m
+}
+
+// WithCanDeleteMessages returns a copy of m with CanDeleteMessages set to canDeleteMessages.
+func (m PromoteChatMemberMethod) WithCanDeleteMessages(canDeleteMessages bool) PromoteChatMemberMethod {
+	m.CanDeleteMessages = &canDeleteMessages
+	return m
+}
+
+// WithCanManageVideoChats returns a copy of m with CanManageVideoChats set to canManageVideoChats.
+func (m PromoteChatMemberMethod) WithCanManageVideoChats(canManageVideoChats bool) PromoteChatMemberMethod {
+	m.CanManageVideoChats = &canManageVideoChats
+	return m
+}
+
+// WithCanRestrictMembers returns a copy of m with CanRestrictMembers set to canRestrictMembers.
+func (m PromoteChatMemberMethod) WithCanRestrictMembers(canRestrictMembers bool) PromoteChatMemberMethod {
+	m.CanRestrictMembers = &canRestrictMembers
+	return m
+}
+
+// WithCanPromoteMembers returns a copy of m with CanPromoteMembers set to canPromoteMembers.
+func (m PromoteChatMemberMethod) WithCanPromoteMembers(canPromoteMembers bool) PromoteChatMemberMethod {
+	m.CanPromoteMembers = &canPromoteMembers
+	return m
+}
+
+// WithCanChangeInfo returns a copy of m with CanChangeInfo set to canChangeInfo.
+func (m PromoteChatMemberMethod) WithCanChangeInfo(canChangeInfo bool) PromoteChatMemberMethod {
+	m.CanChangeInfo = &canChangeInfo
+	return m
+}
+
+// WithCanInviteUsers returns a copy of m with CanInviteUsers set to canInviteUsers.
+func (m PromoteChatMemberMethod) WithCanInviteUsers(canInviteUsers bool) PromoteChatMemberMethod {
+	m.CanInviteUsers = &canInviteUsers
+	return m
+}
+
+// WithCanPostStories returns a copy of m with CanPostStories set to canPostStories.
+func (m PromoteChatMemberMethod) WithCanPostStories(canPostStories bool) PromoteChatMemberMethod {
+	m.CanPostStories = &canPostStories
+	return m
+}
+
+// WithCanEditStories returns a copy of m with CanEditStories set to canEditStories.
+func (m PromoteChatMemberMethod) WithCanEditStories(canEditStories bool) PromoteChatMemberMethod {
+	m.CanEditStories = &canEditStories
+	return m
+}
+
+// WithCanDeleteStories returns a copy of m with CanDeleteStories set to canDeleteStories.
+func (m PromoteChatMemberMethod) WithCanDeleteStories(canDeleteStories bool) PromoteChatMemberMethod {
+	m.CanDeleteStories = &canDeleteStories
+	return m
+}
+
+// WithCanPostMessages returns a copy of m with CanPostMessages set to canPostMessages.
+func (m PromoteChatMemberMethod) WithCanPostMessages(canPostMessages bool) PromoteChatMemberMethod {
+	m.CanPostMessages = &canPostMessages
+	return m
+}
+
+// WithCanEditMessages returns a copy of m with CanEditMessages set to canEditMessages.
+func (m PromoteChatMemberMethod) WithCanEditMessages(canEditMessages bool) PromoteChatMemberMethod {
+	m.CanEditMessages = &canEditMessages
+	return m
+}
+
+// WithCanPinMessages returns a copy of m with CanPinMessages set to canPinMessages.
+func (m PromoteChatMemberMethod) WithCanPinMessages(canPinMessages bool) PromoteChatMemberMethod {
+	m.CanPinMessages = &canPinMessages
+	return m
+}
+
+// WithCanManageTopics returns a copy of m with CanManageTopics set to canManageTopics.
+func (m PromoteChatMemberMethod) WithCanManageTopics(canManageTopics bool) PromoteChatMemberMethod {
+	m.CanManageTopics = &canManageTopics
+	return m
+}
+
+// WithCanManageDirectMessages returns a copy of m with CanManageDirectMessages set to canManageDirectMessages.
+func (m PromoteChatMemberMethod) WithCanManageDirectMessages(canManageDirectMessages bool) PromoteChatMemberMethod {
+	m.CanManageDirectMessages = &canManageDirectMessages
+	return m
+}
+
+// WithCanManageTags returns a copy of m with CanManageTags set to canManageTags.
+func (m PromoteChatMemberMethod) WithCanManageTags(canManageTags bool) PromoteChatMemberMethod {
+	m.CanManageTags = &canManageTags
+	return m
+}
+
 func (m PromoteChatMemberMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8495,6 +10866,12 @@ type SetChatAdministratorCustomTitleMethod struct {
 	CustomTitle string `json:"custom_title"`
 }
 
+// NewSetChatAdministratorCustomTitleMethod creates the request of setChatAdministratorCustomTitle from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatAdministratorCustomTitleMethod(chatID ChatID, userID int64, customTitle string) SetChatAdministratorCustomTitleMethod {
+	return SetChatAdministratorCustomTitleMethod{ChatID: chatID, UserID: userID, CustomTitle: customTitle}
+}
+
 func (m SetChatAdministratorCustomTitleMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8522,6 +10899,18 @@ type SetChatMemberTagMethod struct {
 	Tag *string `json:"tag,omitempty"`
 }
 
+// NewSetChatMemberTagMethod creates the request of setChatMemberTag from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatMemberTagMethod(chatID ChatID, userID int64) SetChatMemberTagMethod {
+	return SetChatMemberTagMethod{ChatID: chatID, UserID: userID}
+}
+
+// WithTag returns a copy of m with Tag set to tag.
+func (m SetChatMemberTagMethod) WithTag(tag string) SetChatMemberTagMethod {
+	m.Tag = &tag
+	return m
+}
+
 func (m SetChatMemberTagMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8549,6 +10938,12 @@ type BanChatSenderChatMethod struct {
 	SenderChatID int64 `json:"sender_chat_id"`
 }
 
+// NewBanChatSenderChatMethod creates the request of banChatSenderChat from the parameters it
+// requires, leaving every optional one unset.
+func NewBanChatSenderChatMethod(chatID ChatID, senderChatID int64) BanChatSenderChatMethod {
+	return BanChatSenderChatMethod{ChatID: chatID, SenderChatID: senderChatID}
+}
+
 func (m BanChatSenderChatMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8574,6 +10969,12 @@ type UnbanChatSenderChatMethod struct {
 	SenderChatID int64 `json:"sender_chat_id"`
 }
 
+// NewUnbanChatSenderChatMethod creates the request of unbanChatSenderChat from the parameters it
+// requires, leaving every optional one unset.
+func NewUnbanChatSenderChatMethod(chatID ChatID, senderChatID int64) UnbanChatSenderChatMethod {
+	return UnbanChatSenderChatMethod{ChatID: chatID, SenderChatID: senderChatID}
+}
+
 func (m UnbanChatSenderChatMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8605,6 +11006,18 @@ type SetChatPermissionsMethod struct {
 	UseIndependentChatPermissions *bool `json:"use_independent_chat_permissions,omitempty"`
 }
 
+// NewSetChatPermissionsMethod creates the request of setChatPermissions from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatPermissionsMethod(chatID ChatID, permissions ChatPermissions) SetChatPermissionsMethod {
+	return SetChatPermissionsMethod{ChatID: chatID, Permissions: permissions}
+}
+
+// WithUseIndependentChatPermissions returns a copy of m with UseIndependentChatPermissions set to useIndependentChatPermissions.
+func (m SetChatPermissionsMethod) WithUseIndependentChatPermissions(useIndependentChatPermissions bool) SetChatPermissionsMethod {
+	m.UseIndependentChatPermissions = &useIndependentChatPermissions
+	return m
+}
+
 func (m SetChatPermissionsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8636,6 +11049,12 @@ type ExportChatInviteLinkMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewExportChatInviteLinkMethod creates the request of exportChatInviteLink from the parameters it
+// requires, leaving every optional one unset.
+func NewExportChatInviteLinkMethod(chatID ChatID) ExportChatInviteLinkMethod {
+	return ExportChatInviteLinkMethod{ChatID: chatID}
+}
+
 func (m ExportChatInviteLinkMethod) Call(ctx context.Context, conn Connection) (string, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8674,6 +11093,36 @@ type CreateChatInviteLinkMethod struct {
 	CreatesJoinRequest *bool `json:"creates_join_request,omitempty"`
 }
 
+// NewCreateChatInviteLinkMethod creates the request of createChatInviteLink from the parameters it
+// requires, leaving every optional one unset.
+func NewCreateChatInviteLinkMethod(chatID ChatID) CreateChatInviteLinkMethod {
+	return CreateChatInviteLinkMethod{ChatID: chatID}
+}
+
+// WithName returns a copy of m with Name set to name.
+func (m CreateChatInviteLinkMethod) WithName(name string) CreateChatInviteLinkMethod {
+	m.Name = &name
+	return m
+}
+
+// WithExpireDate returns a copy of m with ExpireDate set to expireDate.
+func (m CreateChatInviteLinkMethod) WithExpireDate(expireDate int64) CreateChatInviteLinkMethod {
+	m.ExpireDate = &expireDate
+	return m
+}
+
+// WithMemberLimit returns a copy of m with MemberLimit set to memberLimit.
+func (m CreateChatInviteLinkMethod) WithMemberLimit(memberLimit int64) CreateChatInviteLinkMethod {
+	m.MemberLimit = &memberLimit
+	return m
+}
+
+// WithCreatesJoinRequest returns a copy of m with CreatesJoinRequest set to createsJoinRequest.
+func (m CreateChatInviteLinkMethod) WithCreatesJoinRequest(createsJoinRequest bool) CreateChatInviteLinkMethod {
+	m.CreatesJoinRequest = &createsJoinRequest
+	return m
+}
+
 func (m CreateChatInviteLinkMethod) Call(ctx context.Context, conn Connection) (ChatInviteLink, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8714,6 +11163,36 @@ type EditChatInviteLinkMethod struct {
 	CreatesJoinRequest *bool `json:"creates_join_request,omitempty"`
 }
 
+// NewEditChatInviteLinkMethod creates the request of editChatInviteLink from the parameters it
+// requires, leaving every optional one unset.
+func NewEditChatInviteLinkMethod(chatID ChatID, inviteLink string) EditChatInviteLinkMethod {
+	return EditChatInviteLinkMethod{ChatID: chatID, InviteLink: inviteLink}
+}
+
+// WithName returns a copy of m with Name set to name.
+func (m EditChatInviteLinkMethod) WithName(name string) EditChatInviteLinkMethod {
+	m.Name = &name
+	return m
+}
+
+// WithExpireDate returns a copy of m with ExpireDate set to expireDate.
+func (m EditChatInviteLinkMethod) WithExpireDate(expireDate int64) EditChatInviteLinkMethod {
+	m.ExpireDate = &expireDate
+	return m
+}
+
+// WithMemberLimit returns a copy of m with MemberLimit set to memberLimit.
+func (m EditChatInviteLinkMethod) WithMemberLimit(memberLimit int64) EditChatInviteLinkMethod {
+	m.MemberLimit = &memberLimit
+	return m
+}
+
+// WithCreatesJoinRequest returns a copy of m with CreatesJoinRequest set to createsJoinRequest.
+func (m EditChatInviteLinkMethod) WithCreatesJoinRequest(createsJoinRequest bool) EditChatInviteLinkMethod {
+	m.CreatesJoinRequest = &createsJoinRequest
+	return m
+}
+
 func (m EditChatInviteLinkMethod) Call(ctx context.Context, conn Connection) (ChatInviteLink, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8751,6 +11230,18 @@ type CreateChatSubscriptionInviteLinkMethod struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// NewCreateChatSubscriptionInviteLinkMethod creates the request of createChatSubscriptionInviteLink from the parameters it
+// requires, leaving every optional one unset.
+func NewCreateChatSubscriptionInviteLinkMethod(chatID ChatID, subscriptionPeriod int64, subscriptionPrice int64) CreateChatSubscriptionInviteLinkMethod {
+	return CreateChatSubscriptionInviteLinkMethod{ChatID: chatID, SubscriptionPeriod: subscriptionPeriod, SubscriptionPrice: subscriptionPrice}
+}
+
+// WithName returns a copy of m with Name set to name.
+func (m CreateChatSubscriptionInviteLinkMethod) WithName(name string) CreateChatSubscriptionInviteLinkMethod {
+	m.Name = &name
+	return m
+}
+
 func (m CreateChatSubscriptionInviteLinkMethod) Call(ctx context.Context, conn Connection) (ChatInviteLink, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8782,6 +11273,18 @@ type EditChatSubscriptionInviteLinkMethod struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// NewEditChatSubscriptionInviteLinkMethod creates the request of editChatSubscriptionInviteLink from the parameters it
+// requires, leaving every optional one unset.
+func NewEditChatSubscriptionInviteLinkMethod(chatID ChatID, inviteLink string) EditChatSubscriptionInviteLinkMethod {
+	return EditChatSubscriptionInviteLinkMethod{ChatID: chatID, InviteLink: inviteLink}
+}
+
+// WithName returns a copy of m with Name set to name.
+func (m EditChatSubscriptionInviteLinkMethod) WithName(name string) EditChatSubscriptionInviteLinkMethod {
+	m.Name = &name
+	return m
+}
+
 func (m EditChatSubscriptionInviteLinkMethod) Call(ctx context.Context, conn Connection) (ChatInviteLink, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8813,6 +11316,12 @@ type RevokeChatInviteLinkMethod struct {
 	InviteLink string `json:"invite_link"`
 }
 
+// NewRevokeChatInviteLinkMethod creates the request of revokeChatInviteLink from the parameters it
+// requires, leaving every optional one unset.
+func NewRevokeChatInviteLinkMethod(chatID ChatID, inviteLink string) RevokeChatInviteLinkMethod {
+	return RevokeChatInviteLinkMethod{ChatID: chatID, InviteLink: inviteLink}
+}
+
 func (m RevokeChatInviteLinkMethod) Call(ctx context.Context, conn Connection) (ChatInviteLink, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -8842,6 +11351,12 @@ type ApproveChatJoinRequestMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewApproveChatJoinRequestMethod creates the request of approveChatJoinRequest from the parameters it
+// requires, leaving every optional one unset.
+func NewApproveChatJoinRequestMethod(chatID ChatID, userID int64) ApproveChatJoinRequestMethod {
+	return ApproveChatJoinRequestMethod{ChatID: chatID, UserID: userID}
+}
+
 func (m ApproveChatJoinRequestMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8867,6 +11382,12 @@ type DeclineChatJoinRequestMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewDeclineChatJoinRequestMethod creates the request of declineChatJoinRequest from the parameters it
+// requires, leaving every optional one unset.
+func NewDeclineChatJoinRequestMethod(chatID ChatID, userID int64) DeclineChatJoinRequestMethod {
+	return DeclineChatJoinRequestMethod{ChatID: chatID, UserID: userID}
+}
+
 func (m DeclineChatJoinRequestMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8892,6 +11413,12 @@ type AnswerChatJoinRequestQueryMethod struct {
 	Result string `json:"result"`
 }
 
+// NewAnswerChatJoinRequestQueryMethod creates the request of answerChatJoinRequestQuery from the parameters it
+// requires, leaving every optional one unset.
+func NewAnswerChatJoinRequestQueryMethod(chatJoinRequestQueryID string, result string) AnswerChatJoinRequestQueryMethod {
+	return AnswerChatJoinRequestQueryMethod{ChatJoinRequestQueryID: chatJoinRequestQueryID, Result: result}
+}
+
 func (m AnswerChatJoinRequestQueryMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8918,6 +11445,12 @@ type SendChatJoinRequestWebAppMethod struct {
 	WebAppURL string `json:"web_app_url"`
 }
 
+// NewSendChatJoinRequestWebAppMethod creates the request of sendChatJoinRequestWebApp from the parameters it
+// requires, leaving every optional one unset.
+func NewSendChatJoinRequestWebAppMethod(chatJoinRequestQueryID string, webAppURL string) SendChatJoinRequestWebAppMethod {
+	return SendChatJoinRequestWebAppMethod{ChatJoinRequestQueryID: chatJoinRequestQueryID, WebAppURL: webAppURL}
+}
+
 func (m SendChatJoinRequestWebAppMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8944,6 +11477,12 @@ type SetChatPhotoMethod struct {
 	Photo InputFile `json:"photo"`
 }
 
+// NewSetChatPhotoMethod creates the request of setChatPhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatPhotoMethod(chatID ChatID, photo InputFile) SetChatPhotoMethod {
+	return SetChatPhotoMethod{ChatID: chatID, Photo: photo}
+}
+
 func (m SetChatPhotoMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -8976,6 +11515,12 @@ type DeleteChatPhotoMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewDeleteChatPhotoMethod creates the request of deleteChatPhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteChatPhotoMethod(chatID ChatID) DeleteChatPhotoMethod {
+	return DeleteChatPhotoMethod{ChatID: chatID}
+}
+
 func (m DeleteChatPhotoMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9001,6 +11546,12 @@ type SetChatTitleMethod struct {
 	Title string `json:"title"`
 }
 
+// NewSetChatTitleMethod creates the request of setChatTitle from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatTitleMethod(chatID ChatID, title string) SetChatTitleMethod {
+	return SetChatTitleMethod{ChatID: chatID, Title: title}
+}
+
 func (m SetChatTitleMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9026,6 +11577,18 @@ type SetChatDescriptionMethod struct {
 	Description *string `json:"description,omitempty"`
 }
 
+// NewSetChatDescriptionMethod creates the request of setChatDescription from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatDescriptionMethod(chatID ChatID) SetChatDescriptionMethod {
+	return SetChatDescriptionMethod{ChatID: chatID}
+}
+
+// WithDescription returns a copy of m with Description set to description.
+func (m SetChatDescriptionMethod) WithDescription(description string) SetChatDescriptionMethod {
+	m.Description = &description
+	return m
+}
+
 func (m SetChatDescriptionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9060,6 +11623,24 @@ type PinChatMessageMethod struct {
 	DisableNotification *bool `json:"disable_notification,omitempty"`
 }
 
+// NewPinChatMessageMethod creates the request of pinChatMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewPinChatMessageMethod(chatID ChatID, messageID int64) PinChatMessageMethod {
+	return PinChatMessageMethod{ChatID: chatID, MessageID: messageID}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m PinChatMessageMethod) WithBusinessConnectionID(businessConnectionID string) PinChatMessageMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m PinChatMessageMethod) WithDisableNotification(disableNotification bool) PinChatMessageMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
 func (m PinChatMessageMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9092,6 +11673,24 @@ type UnpinChatMessageMethod struct {
 	MessageID *int64 `json:"message_id,omitempty"`
 }
 
+// NewUnpinChatMessageMethod creates the request of unpinChatMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewUnpinChatMessageMethod(chatID ChatID) UnpinChatMessageMethod {
+	return UnpinChatMessageMethod{ChatID: chatID}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m UnpinChatMessageMethod) WithBusinessConnectionID(businessConnectionID string) UnpinChatMessageMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m UnpinChatMessageMethod) WithMessageID(messageID int64) UnpinChatMessageMethod {
+	m.MessageID = &messageID
+	return m
+}
+
 func (m UnpinChatMessageMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9117,6 +11716,12 @@ type UnpinAllChatMessagesMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewUnpinAllChatMessagesMethod creates the request of unpinAllChatMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewUnpinAllChatMessagesMethod(chatID ChatID) UnpinAllChatMessagesMethod {
+	return UnpinAllChatMessagesMethod{ChatID: chatID}
+}
+
 func (m UnpinAllChatMessagesMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9140,6 +11745,12 @@ type LeaveChatMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewLeaveChatMethod creates the request of leaveChat from the parameters it
+// requires, leaving every optional one unset.
+func NewLeaveChatMethod(chatID ChatID) LeaveChatMethod {
+	return LeaveChatMethod{ChatID: chatID}
+}
+
 func (m LeaveChatMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9162,6 +11773,12 @@ type GetChatMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewGetChatMethod creates the request of getChat from the parameters it
+// requires, leaving every optional one unset.
+func NewGetChatMethod(chatID ChatID) GetChatMethod {
+	return GetChatMethod{ChatID: chatID}
+}
+
 func (m GetChatMethod) Call(ctx context.Context, conn Connection) (ChatFullInfo, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9191,6 +11808,18 @@ type GetChatAdministratorsMethod struct {
 	ReturnBots *bool `json:"return_bots,omitempty"`
 }
 
+// NewGetChatAdministratorsMethod creates the request of getChatAdministrators from the parameters it
+// requires, leaving every optional one unset.
+func NewGetChatAdministratorsMethod(chatID ChatID) GetChatAdministratorsMethod {
+	return GetChatAdministratorsMethod{ChatID: chatID}
+}
+
+// WithReturnBots returns a copy of m with ReturnBots set to returnBots.
+func (m GetChatAdministratorsMethod) WithReturnBots(returnBots bool) GetChatAdministratorsMethod {
+	m.ReturnBots = &returnBots
+	return m
+}
+
 func (m GetChatAdministratorsMethod) Call(ctx context.Context, conn Connection) ([]ChatMember, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9225,6 +11854,12 @@ type GetChatMemberCountMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewGetChatMemberCountMethod creates the request of getChatMemberCount from the parameters it
+// requires, leaving every optional one unset.
+func NewGetChatMemberCountMethod(chatID ChatID) GetChatMemberCountMethod {
+	return GetChatMemberCountMethod{ChatID: chatID}
+}
+
 func (m GetChatMemberCountMethod) Call(ctx context.Context, conn Connection) (int64, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9254,6 +11889,12 @@ type GetChatMemberMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewGetChatMemberMethod creates the request of getChatMember from the parameters it
+// requires, leaving every optional one unset.
+func NewGetChatMemberMethod(chatID ChatID, userID int64) GetChatMemberMethod {
+	return GetChatMemberMethod{ChatID: chatID, UserID: userID}
+}
+
 func (m GetChatMemberMethod) Call(ctx context.Context, conn Connection) (ChatMember, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9282,6 +11923,12 @@ type GetUserPersonalChatMessagesMethod struct {
 	Limit int64 `json:"limit"`
 }
 
+// NewGetUserPersonalChatMessagesMethod creates the request of getUserPersonalChatMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewGetUserPersonalChatMessagesMethod(userID int64, limit int64) GetUserPersonalChatMessagesMethod {
+	return GetUserPersonalChatMessagesMethod{UserID: userID, Limit: limit}
+}
+
 func (m GetUserPersonalChatMessagesMethod) Call(ctx context.Context, conn Connection) ([]Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9313,6 +11960,12 @@ type SetChatStickerSetMethod struct {
 	StickerSetName string `json:"sticker_set_name"`
 }
 
+// NewSetChatStickerSetMethod creates the request of setChatStickerSet from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatStickerSetMethod(chatID ChatID, stickerSetName string) SetChatStickerSetMethod {
+	return SetChatStickerSetMethod{ChatID: chatID, StickerSetName: stickerSetName}
+}
+
 func (m SetChatStickerSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9338,6 +11991,12 @@ type DeleteChatStickerSetMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewDeleteChatStickerSetMethod creates the request of deleteChatStickerSet from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteChatStickerSetMethod(chatID ChatID) DeleteChatStickerSetMethod {
+	return DeleteChatStickerSetMethod{ChatID: chatID}
+}
+
 func (m DeleteChatStickerSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9358,6 +12017,12 @@ func (m DeleteChatStickerSetMethod) payload() (jsonPayload, error) {
 type GetForumTopicIconStickersMethod struct {
 }
 
+// NewGetForumTopicIconStickersMethod creates the request of getForumTopicIconStickers from the parameters it
+// requires, leaving every optional one unset.
+func NewGetForumTopicIconStickersMethod() GetForumTopicIconStickersMethod {
+	return GetForumTopicIconStickersMethod{}
+}
+
 func (m GetForumTopicIconStickersMethod) Call(ctx context.Context, conn Connection) ([]Sticker, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9396,6 +12061,24 @@ type CreateForumTopicMethod struct {
 	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
 }
 
+// NewCreateForumTopicMethod creates the request of createForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewCreateForumTopicMethod(chatID ChatID, name string) CreateForumTopicMethod {
+	return CreateForumTopicMethod{ChatID: chatID, Name: name}
+}
+
+// WithIconColor returns a copy of m with IconColor set to iconColor.
+func (m CreateForumTopicMethod) WithIconColor(iconColor int64) CreateForumTopicMethod {
+	m.IconColor = &iconColor
+	return m
+}
+
+// WithIconCustomEmojiID returns a copy of m with IconCustomEmojiID set to iconCustomEmojiID.
+func (m CreateForumTopicMethod) WithIconCustomEmojiID(iconCustomEmojiID string) CreateForumTopicMethod {
+	m.IconCustomEmojiID = &iconCustomEmojiID
+	return m
+}
+
 func (m CreateForumTopicMethod) Call(ctx context.Context, conn Connection) (ForumTopic, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9435,6 +12118,24 @@ type EditForumTopicMethod struct {
 	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
 }
 
+// NewEditForumTopicMethod creates the request of editForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewEditForumTopicMethod(chatID ChatID, messageThreadID int64) EditForumTopicMethod {
+	return EditForumTopicMethod{ChatID: chatID, MessageThreadID: messageThreadID}
+}
+
+// WithName returns a copy of m with Name set to name.
+func (m EditForumTopicMethod) WithName(name string) EditForumTopicMethod {
+	m.Name = &name
+	return m
+}
+
+// WithIconCustomEmojiID returns a copy of m with IconCustomEmojiID set to iconCustomEmojiID.
+func (m EditForumTopicMethod) WithIconCustomEmojiID(iconCustomEmojiID string) EditForumTopicMethod {
+	m.IconCustomEmojiID = &iconCustomEmojiID
+	return m
+}
+
 func (m EditForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9461,6 +12162,12 @@ type CloseForumTopicMethod struct {
 	MessageThreadID int64 `json:"message_thread_id"`
 }
 
+// NewCloseForumTopicMethod creates the request of closeForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewCloseForumTopicMethod(chatID ChatID, messageThreadID int64) CloseForumTopicMethod {
+	return CloseForumTopicMethod{ChatID: chatID, MessageThreadID: messageThreadID}
+}
+
 func (m CloseForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9487,6 +12194,12 @@ type ReopenForumTopicMethod struct {
 	MessageThreadID int64 `json:"message_thread_id"`
 }
 
+// NewReopenForumTopicMethod creates the request of reopenForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewReopenForumTopicMethod(chatID ChatID, messageThreadID int64) ReopenForumTopicMethod {
+	return ReopenForumTopicMethod{ChatID: chatID, MessageThreadID: messageThreadID}
+}
+
 func (m ReopenForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9514,6 +12227,12 @@ type DeleteForumTopicMethod struct {
 	MessageThreadID int64 `json:"message_thread_id"`
 }
 
+// NewDeleteForumTopicMethod creates the request of deleteForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteForumTopicMethod(chatID ChatID, messageThreadID int64) DeleteForumTopicMethod {
+	return DeleteForumTopicMethod{ChatID: chatID, MessageThreadID: messageThreadID}
+}
+
 func (m DeleteForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9541,6 +12260,12 @@ type UnpinAllForumTopicMessagesMethod struct {
 	MessageThreadID int64 `json:"message_thread_id"`
 }
 
+// NewUnpinAllForumTopicMessagesMethod creates the request of unpinAllForumTopicMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewUnpinAllForumTopicMessagesMethod(chatID ChatID, messageThreadID int64) UnpinAllForumTopicMessagesMethod {
+	return UnpinAllForumTopicMessagesMethod{ChatID: chatID, MessageThreadID: messageThreadID}
+}
+
 func (m UnpinAllForumTopicMessagesMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9566,6 +12291,12 @@ type EditGeneralForumTopicMethod struct {
 	Name string `json:"name"`
 }
 
+// NewEditGeneralForumTopicMethod creates the request of editGeneralForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewEditGeneralForumTopicMethod(chatID ChatID, name string) EditGeneralForumTopicMethod {
+	return EditGeneralForumTopicMethod{ChatID: chatID, Name: name}
+}
+
 func (m EditGeneralForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9589,6 +12320,12 @@ type CloseGeneralForumTopicMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewCloseGeneralForumTopicMethod creates the request of closeGeneralForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewCloseGeneralForumTopicMethod(chatID ChatID) CloseGeneralForumTopicMethod {
+	return CloseGeneralForumTopicMethod{ChatID: chatID}
+}
+
 func (m CloseGeneralForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9613,6 +12350,12 @@ type ReopenGeneralForumTopicMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewReopenGeneralForumTopicMethod creates the request of reopenGeneralForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewReopenGeneralForumTopicMethod(chatID ChatID) ReopenGeneralForumTopicMethod {
+	return ReopenGeneralForumTopicMethod{ChatID: chatID}
+}
+
 func (m ReopenGeneralForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9637,6 +12380,12 @@ type HideGeneralForumTopicMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewHideGeneralForumTopicMethod creates the request of hideGeneralForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewHideGeneralForumTopicMethod(chatID ChatID) HideGeneralForumTopicMethod {
+	return HideGeneralForumTopicMethod{ChatID: chatID}
+}
+
 func (m HideGeneralForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9660,6 +12409,12 @@ type UnhideGeneralForumTopicMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewUnhideGeneralForumTopicMethod creates the request of unhideGeneralForumTopic from the parameters it
+// requires, leaving every optional one unset.
+func NewUnhideGeneralForumTopicMethod(chatID ChatID) UnhideGeneralForumTopicMethod {
+	return UnhideGeneralForumTopicMethod{ChatID: chatID}
+}
+
 func (m UnhideGeneralForumTopicMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9684,6 +12439,12 @@ type UnpinAllGeneralForumTopicMessagesMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewUnpinAllGeneralForumTopicMessagesMethod creates the request of unpinAllGeneralForumTopicMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewUnpinAllGeneralForumTopicMessagesMethod(chatID ChatID) UnpinAllGeneralForumTopicMessagesMethod {
+	return UnpinAllGeneralForumTopicMessagesMethod{ChatID: chatID}
+}
+
 func (m UnpinAllGeneralForumTopicMessagesMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9729,6 +12490,36 @@ type AnswerCallbackQueryMethod struct {
 	CacheTime *int64 `json:"cache_time,omitempty"`
 }
 
+// NewAnswerCallbackQueryMethod creates the request of answerCallbackQuery from the parameters it
+// requires, leaving every optional one unset.
+func NewAnswerCallbackQueryMethod(callbackQueryID string) AnswerCallbackQueryMethod {
+	return AnswerCallbackQueryMethod{CallbackQueryID: callbackQueryID}
+}
+
+// WithText returns a copy of m with Text set to text.
+func (m AnswerCallbackQueryMethod) WithText(text string) AnswerCallbackQueryMethod {
+	m.Text = &text
+	return m
+}
+
+// WithShowAlert returns a copy of m with ShowAlert set to showAlert.
+func (m AnswerCallbackQueryMethod) WithShowAlert(showAlert bool) AnswerCallbackQueryMethod {
+	m.ShowAlert = &showAlert
+	return m
+}
+
+// WithURL returns a copy of m with URL set to url.
+func (m AnswerCallbackQueryMethod) WithURL(url string) AnswerCallbackQueryMethod {
+	m.URL = &url
+	return m
+}
+
+// WithCacheTime returns a copy of m with CacheTime set to cacheTime.
+func (m AnswerCallbackQueryMethod) WithCacheTime(cacheTime int64) AnswerCallbackQueryMethod {
+	m.CacheTime = &cacheTime
+	return m
+}
+
 func (m AnswerCallbackQueryMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9752,6 +12543,12 @@ type AnswerGuestQueryMethod struct {
 	Result InlineQueryResult `json:"result"`
 }
 
+// NewAnswerGuestQueryMethod creates the request of answerGuestQuery from the parameters it
+// requires, leaving every optional one unset.
+func NewAnswerGuestQueryMethod(guestQueryID string, result InlineQueryResult) AnswerGuestQueryMethod {
+	return AnswerGuestQueryMethod{GuestQueryID: guestQueryID, Result: result}
+}
+
 func (m AnswerGuestQueryMethod) Call(ctx context.Context, conn Connection) (SentGuestMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9792,6 +12589,12 @@ type GetUserChatBoostsMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewGetUserChatBoostsMethod creates the request of getUserChatBoosts from the parameters it
+// requires, leaving every optional one unset.
+func NewGetUserChatBoostsMethod(chatID ChatID, userID int64) GetUserChatBoostsMethod {
+	return GetUserChatBoostsMethod{ChatID: chatID, UserID: userID}
+}
+
 func (m GetUserChatBoostsMethod) Call(ctx context.Context, conn Connection) (UserChatBoosts, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9817,6 +12620,12 @@ type GetBusinessConnectionMethod struct {
 	BusinessConnectionID string `json:"business_connection_id"`
 }
 
+// NewGetBusinessConnectionMethod creates the request of getBusinessConnection from the parameters it
+// requires, leaving every optional one unset.
+func NewGetBusinessConnectionMethod(businessConnectionID string) GetBusinessConnectionMethod {
+	return GetBusinessConnectionMethod{BusinessConnectionID: businessConnectionID}
+}
+
 func (m GetBusinessConnectionMethod) Call(ctx context.Context, conn Connection) (BusinessConnection, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9842,6 +12651,12 @@ type GetManagedBotTokenMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewGetManagedBotTokenMethod creates the request of getManagedBotToken from the parameters it
+// requires, leaving every optional one unset.
+func NewGetManagedBotTokenMethod(userID int64) GetManagedBotTokenMethod {
+	return GetManagedBotTokenMethod{UserID: userID}
+}
+
 func (m GetManagedBotTokenMethod) Call(ctx context.Context, conn Connection) (string, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9867,6 +12682,12 @@ type ReplaceManagedBotTokenMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewReplaceManagedBotTokenMethod creates the request of replaceManagedBotToken from the parameters it
+// requires, leaving every optional one unset.
+func NewReplaceManagedBotTokenMethod(userID int64) ReplaceManagedBotTokenMethod {
+	return ReplaceManagedBotTokenMethod{UserID: userID}
+}
+
 func (m ReplaceManagedBotTokenMethod) Call(ctx context.Context, conn Connection) (string, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9892,6 +12713,12 @@ type GetManagedBotAccessSettingsMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewGetManagedBotAccessSettingsMethod creates the request of getManagedBotAccessSettings from the parameters it
+// requires, leaving every optional one unset.
+func NewGetManagedBotAccessSettingsMethod(userID int64) GetManagedBotAccessSettingsMethod {
+	return GetManagedBotAccessSettingsMethod{UserID: userID}
+}
+
 func (m GetManagedBotAccessSettingsMethod) Call(ctx context.Context, conn Connection) (BotAccessSettings, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -9924,6 +12751,18 @@ type SetManagedBotAccessSettingsMethod struct {
 	AddedUserIDs []int64 `json:"added_user_ids,omitempty"`
 }
 
+// NewSetManagedBotAccessSettingsMethod creates the request of setManagedBotAccessSettings from the parameters it
+// requires, leaving every optional one unset.
+func NewSetManagedBotAccessSettingsMethod(userID int64, isAccessRestricted bool) SetManagedBotAccessSettingsMethod {
+	return SetManagedBotAccessSettingsMethod{UserID: userID, IsAccessRestricted: isAccessRestricted}
+}
+
+// WithAddedUserIDs returns a copy of m with AddedUserIDs set to addedUserIDs.
+func (m SetManagedBotAccessSettingsMethod) WithAddedUserIDs(addedUserIDs []int64) SetManagedBotAccessSettingsMethod {
+	m.AddedUserIDs = addedUserIDs
+	return m
+}
+
 func (m SetManagedBotAccessSettingsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9953,6 +12792,24 @@ type SetMyCommandsMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewSetMyCommandsMethod creates the request of setMyCommands from the parameters it
+// requires, leaving every optional one unset.
+func NewSetMyCommandsMethod(commands []BotCommand) SetMyCommandsMethod {
+	return SetMyCommandsMethod{Commands: commands}
+}
+
+// WithScope returns a copy of m with Scope set to scope.
+func (m SetMyCommandsMethod) WithScope(scope BotCommandScope) SetMyCommandsMethod {
+	m.Scope = scope
+	return m
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m SetMyCommandsMethod) WithLanguageCode(languageCode string) SetMyCommandsMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m SetMyCommandsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -9980,6 +12837,24 @@ type DeleteMyCommandsMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewDeleteMyCommandsMethod creates the request of deleteMyCommands from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteMyCommandsMethod() DeleteMyCommandsMethod {
+	return DeleteMyCommandsMethod{}
+}
+
+// WithScope returns a copy of m with Scope set to scope.
+func (m DeleteMyCommandsMethod) WithScope(scope BotCommandScope) DeleteMyCommandsMethod {
+	m.Scope = scope
+	return m
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m DeleteMyCommandsMethod) WithLanguageCode(languageCode string) DeleteMyCommandsMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m DeleteMyCommandsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10005,6 +12880,24 @@ type GetMyCommandsMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewGetMyCommandsMethod creates the request of getMyCommands from the parameters it
+// requires, leaving every optional one unset.
+func NewGetMyCommandsMethod() GetMyCommandsMethod {
+	return GetMyCommandsMethod{}
+}
+
+// WithScope returns a copy of m with Scope set to scope.
+func (m GetMyCommandsMethod) WithScope(scope BotCommandScope) GetMyCommandsMethod {
+	m.Scope = scope
+	return m
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m GetMyCommandsMethod) WithLanguageCode(languageCode string) GetMyCommandsMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m GetMyCommandsMethod) Call(ctx context.Context, conn Connection) ([]BotCommand, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10033,6 +12926,24 @@ type SetMyNameMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewSetMyNameMethod creates the request of setMyName from the parameters it
+// requires, leaving every optional one unset.
+func NewSetMyNameMethod() SetMyNameMethod {
+	return SetMyNameMethod{}
+}
+
+// WithName returns a copy of m with Name set to name.
+func (m SetMyNameMethod) WithName(name string) SetMyNameMethod {
+	m.Name = &name
+	return m
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m SetMyNameMethod) WithLanguageCode(languageCode string) SetMyNameMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m SetMyNameMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10054,6 +12965,18 @@ type GetMyNameMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewGetMyNameMethod creates the request of getMyName from the parameters it
+// requires, leaving every optional one unset.
+func NewGetMyNameMethod() GetMyNameMethod {
+	return GetMyNameMethod{}
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m GetMyNameMethod) WithLanguageCode(languageCode string) GetMyNameMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m GetMyNameMethod) Call(ctx context.Context, conn Connection) (BotName, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10083,6 +13006,24 @@ type SetMyDescriptionMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewSetMyDescriptionMethod creates the request of setMyDescription from the parameters it
+// requires, leaving every optional one unset.
+func NewSetMyDescriptionMethod() SetMyDescriptionMethod {
+	return SetMyDescriptionMethod{}
+}
+
+// WithDescription returns a copy of m with Description set to description.
+func (m SetMyDescriptionMethod) WithDescription(description string) SetMyDescriptionMethod {
+	m.Description = &description
+	return m
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m SetMyDescriptionMethod) WithLanguageCode(languageCode string) SetMyDescriptionMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m SetMyDescriptionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10104,6 +13045,18 @@ type GetMyDescriptionMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewGetMyDescriptionMethod creates the request of getMyDescription from the parameters it
+// requires, leaving every optional one unset.
+func NewGetMyDescriptionMethod() GetMyDescriptionMethod {
+	return GetMyDescriptionMethod{}
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m GetMyDescriptionMethod) WithLanguageCode(languageCode string) GetMyDescriptionMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m GetMyDescriptionMethod) Call(ctx context.Context, conn Connection) (BotDescription, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10135,6 +13088,24 @@ type SetMyShortDescriptionMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewSetMyShortDescriptionMethod creates the request of setMyShortDescription from the parameters it
+// requires, leaving every optional one unset.
+func NewSetMyShortDescriptionMethod() SetMyShortDescriptionMethod {
+	return SetMyShortDescriptionMethod{}
+}
+
+// WithShortDescription returns a copy of m with ShortDescription set to shortDescription.
+func (m SetMyShortDescriptionMethod) WithShortDescription(shortDescription string) SetMyShortDescriptionMethod {
+	m.ShortDescription = &shortDescription
+	return m
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m SetMyShortDescriptionMethod) WithLanguageCode(languageCode string) SetMyShortDescriptionMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m SetMyShortDescriptionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10156,6 +13127,18 @@ type GetMyShortDescriptionMethod struct {
 	LanguageCode *string `json:"language_code,omitempty"`
 }
 
+// NewGetMyShortDescriptionMethod creates the request of getMyShortDescription from the parameters it
+// requires, leaving every optional one unset.
+func NewGetMyShortDescriptionMethod() GetMyShortDescriptionMethod {
+	return GetMyShortDescriptionMethod{}
+}
+
+// WithLanguageCode returns a copy of m with LanguageCode set to languageCode.
+func (m GetMyShortDescriptionMethod) WithLanguageCode(languageCode string) GetMyShortDescriptionMethod {
+	m.LanguageCode = &languageCode
+	return m
+}
+
 func (m GetMyShortDescriptionMethod) Call(ctx context.Context, conn Connection) (BotShortDescription, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10180,6 +13163,12 @@ type SetMyProfilePhotoMethod struct {
 	Photo InputProfilePhoto `json:"photo"`
 }
 
+// NewSetMyProfilePhotoMethod creates the request of setMyProfilePhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewSetMyProfilePhotoMethod(photo InputProfilePhoto) SetMyProfilePhotoMethod {
+	return SetMyProfilePhotoMethod{Photo: photo}
+}
+
 func (m SetMyProfilePhotoMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10211,6 +13200,12 @@ func (m SetMyProfilePhotoMethod) payload() (formPayload, error) {
 type RemoveMyProfilePhotoMethod struct {
 }
 
+// NewRemoveMyProfilePhotoMethod creates the request of removeMyProfilePhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewRemoveMyProfilePhotoMethod() RemoveMyProfilePhotoMethod {
+	return RemoveMyProfilePhotoMethod{}
+}
+
 func (m RemoveMyProfilePhotoMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10236,6 +13231,24 @@ type SetChatMenuButtonMethod struct {
 	MenuButton MenuButton `json:"menu_button,omitempty"`
 }
 
+// NewSetChatMenuButtonMethod creates the request of setChatMenuButton from the parameters it
+// requires, leaving every optional one unset.
+func NewSetChatMenuButtonMethod() SetChatMenuButtonMethod {
+	return SetChatMenuButtonMethod{}
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m SetChatMenuButtonMethod) WithChatID(chatID int64) SetChatMenuButtonMethod {
+	m.ChatID = &chatID
+	return m
+}
+
+// WithMenuButton returns a copy of m with MenuButton set to menuButton.
+func (m SetChatMenuButtonMethod) WithMenuButton(menuButton MenuButton) SetChatMenuButtonMethod {
+	m.MenuButton = menuButton
+	return m
+}
+
 func (m SetChatMenuButtonMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10258,6 +13271,18 @@ type GetChatMenuButtonMethod struct {
 	ChatID *int64 `json:"chat_id,omitempty"`
 }
 
+// NewGetChatMenuButtonMethod creates the request of getChatMenuButton from the parameters it
+// requires, leaving every optional one unset.
+func NewGetChatMenuButtonMethod() GetChatMenuButtonMethod {
+	return GetChatMenuButtonMethod{}
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m GetChatMenuButtonMethod) WithChatID(chatID int64) GetChatMenuButtonMethod {
+	m.ChatID = &chatID
+	return m
+}
+
 func (m GetChatMenuButtonMethod) Call(ctx context.Context, conn Connection) (MenuButton, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10290,6 +13315,24 @@ type SetMyDefaultAdministratorRightsMethod struct {
 	ForChannels *bool `json:"for_channels,omitempty"`
 }
 
+// NewSetMyDefaultAdministratorRightsMethod creates the request of setMyDefaultAdministratorRights from the parameters it
+// requires, leaving every optional one unset.
+func NewSetMyDefaultAdministratorRightsMethod() SetMyDefaultAdministratorRightsMethod {
+	return SetMyDefaultAdministratorRightsMethod{}
+}
+
+// WithRights returns a copy of m with Rights set to rights.
+func (m SetMyDefaultAdministratorRightsMethod) WithRights(rights ChatAdministratorRights) SetMyDefaultAdministratorRightsMethod {
+	m.Rights = &rights
+	return m
+}
+
+// WithForChannels returns a copy of m with ForChannels set to forChannels.
+func (m SetMyDefaultAdministratorRightsMethod) WithForChannels(forChannels bool) SetMyDefaultAdministratorRightsMethod {
+	m.ForChannels = &forChannels
+	return m
+}
+
 func (m SetMyDefaultAdministratorRightsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10313,6 +13356,18 @@ type GetMyDefaultAdministratorRightsMethod struct {
 	ForChannels *bool `json:"for_channels,omitempty"`
 }
 
+// NewGetMyDefaultAdministratorRightsMethod creates the request of getMyDefaultAdministratorRights from the parameters it
+// requires, leaving every optional one unset.
+func NewGetMyDefaultAdministratorRightsMethod() GetMyDefaultAdministratorRightsMethod {
+	return GetMyDefaultAdministratorRightsMethod{}
+}
+
+// WithForChannels returns a copy of m with ForChannels set to forChannels.
+func (m GetMyDefaultAdministratorRightsMethod) WithForChannels(forChannels bool) GetMyDefaultAdministratorRightsMethod {
+	m.ForChannels = &forChannels
+	return m
+}
+
 func (m GetMyDefaultAdministratorRightsMethod) Call(ctx context.Context, conn Connection) (ChatAdministratorRights, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10336,6 +13391,12 @@ func (m GetMyDefaultAdministratorRightsMethod) payload() (jsonPayload, error) {
 type GetAvailableGiftsMethod struct {
 }
 
+// NewGetAvailableGiftsMethod creates the request of getAvailableGifts from the parameters it
+// requires, leaving every optional one unset.
+func NewGetAvailableGiftsMethod() GetAvailableGiftsMethod {
+	return GetAvailableGiftsMethod{}
+}
+
 func (m GetAvailableGiftsMethod) Call(ctx context.Context, conn Connection) (Gifts, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10381,6 +13442,48 @@ type SendGiftMethod struct {
 	TextEntities []MessageEntity `json:"text_entities,omitempty"`
 }
 
+// NewSendGiftMethod creates the request of sendGift from the parameters it
+// requires, leaving every optional one unset.
+func NewSendGiftMethod(giftID string) SendGiftMethod {
+	return SendGiftMethod{GiftID: giftID}
+}
+
+// WithUserID returns a copy of m with UserID set to userID.
+func (m SendGiftMethod) WithUserID(userID int64) SendGiftMethod {
+	m.UserID = &userID
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m SendGiftMethod) WithChatID(chatID ChatID) SendGiftMethod {
+	m.ChatID = chatID
+	return m
+}
+
+// WithPayForUpgrade returns a copy of m with PayForUpgrade set to payForUpgrade.
+func (m SendGiftMethod) WithPayForUpgrade(payForUpgrade bool) SendGiftMethod {
+	m.PayForUpgrade = &payForUpgrade
+	return m
+}
+
+// WithText returns a copy of m with Text set to text.
+func (m SendGiftMethod) WithText(text string) SendGiftMethod {
+	m.Text = &text
+	return m
+}
+
+// WithTextParseMode returns a copy of m with TextParseMode set to textParseMode.
+func (m SendGiftMethod) WithTextParseMode(textParseMode string) SendGiftMethod {
+	m.TextParseMode = &textParseMode
+	return m
+}
+
+// WithTextEntities returns a copy of m with TextEntities set to textEntities.
+func (m SendGiftMethod) WithTextEntities(textEntities []MessageEntity) SendGiftMethod {
+	m.TextEntities = textEntities
+	return m
+}
+
 func (m SendGiftMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10421,6 +13524,30 @@ type GiftPremiumSubscriptionMethod struct {
 	TextEntities []MessageEntity `json:"text_entities,omitempty"`
 }
 
+// NewGiftPremiumSubscriptionMethod creates the request of giftPremiumSubscription from the parameters it
+// requires, leaving every optional one unset.
+func NewGiftPremiumSubscriptionMethod(userID int64, monthCount int64, starCount int64) GiftPremiumSubscriptionMethod {
+	return GiftPremiumSubscriptionMethod{UserID: userID, MonthCount: monthCount, StarCount: starCount}
+}
+
+// WithText returns a copy of m with Text set to text.
+func (m GiftPremiumSubscriptionMethod) WithText(text string) GiftPremiumSubscriptionMethod {
+	m.Text = &text
+	return m
+}
+
+// WithTextParseMode returns a copy of m with TextParseMode set to textParseMode.
+func (m GiftPremiumSubscriptionMethod) WithTextParseMode(textParseMode string) GiftPremiumSubscriptionMethod {
+	m.TextParseMode = &textParseMode
+	return m
+}
+
+// WithTextEntities returns a copy of m with TextEntities set to textEntities.
+func (m GiftPremiumSubscriptionMethod) WithTextEntities(textEntities []MessageEntity) GiftPremiumSubscriptionMethod {
+	m.TextEntities = textEntities
+	return m
+}
+
 func (m GiftPremiumSubscriptionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10445,6 +13572,18 @@ type VerifyUserMethod struct {
 	CustomDescription *string `json:"custom_description,omitempty"`
 }
 
+// NewVerifyUserMethod creates the request of verifyUser from the parameters it
+// requires, leaving every optional one unset.
+func NewVerifyUserMethod(userID int64) VerifyUserMethod {
+	return VerifyUserMethod{UserID: userID}
+}
+
+// WithCustomDescription returns a copy of m with CustomDescription set to customDescription.
+func (m VerifyUserMethod) WithCustomDescription(customDescription string) VerifyUserMethod {
+	m.CustomDescription = &customDescription
+	return m
+}
+
 func (m VerifyUserMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10471,6 +13610,18 @@ type VerifyChatMethod struct {
 	CustomDescription *string `json:"custom_description,omitempty"`
 }
 
+// NewVerifyChatMethod creates the request of verifyChat from the parameters it
+// requires, leaving every optional one unset.
+func NewVerifyChatMethod(chatID ChatID) VerifyChatMethod {
+	return VerifyChatMethod{ChatID: chatID}
+}
+
+// WithCustomDescription returns a copy of m with CustomDescription set to customDescription.
+func (m VerifyChatMethod) WithCustomDescription(customDescription string) VerifyChatMethod {
+	m.CustomDescription = &customDescription
+	return m
+}
+
 func (m VerifyChatMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10492,6 +13643,12 @@ type RemoveUserVerificationMethod struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewRemoveUserVerificationMethod creates the request of removeUserVerification from the parameters it
+// requires, leaving every optional one unset.
+func NewRemoveUserVerificationMethod(userID int64) RemoveUserVerificationMethod {
+	return RemoveUserVerificationMethod{UserID: userID}
+}
+
 func (m RemoveUserVerificationMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10514,6 +13671,12 @@ type RemoveChatVerificationMethod struct {
 	ChatID ChatID `json:"chat_id"`
 }
 
+// NewRemoveChatVerificationMethod creates the request of removeChatVerification from the parameters it
+// requires, leaving every optional one unset.
+func NewRemoveChatVerificationMethod(chatID ChatID) RemoveChatVerificationMethod {
+	return RemoveChatVerificationMethod{ChatID: chatID}
+}
+
 func (m RemoveChatVerificationMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10541,6 +13704,12 @@ type ReadBusinessMessageMethod struct {
 	MessageID int64 `json:"message_id"`
 }
 
+// NewReadBusinessMessageMethod creates the request of readBusinessMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewReadBusinessMessageMethod(businessConnectionID string, chatID int64, messageID int64) ReadBusinessMessageMethod {
+	return ReadBusinessMessageMethod{BusinessConnectionID: businessConnectionID, ChatID: chatID, MessageID: messageID}
+}
+
 func (m ReadBusinessMessageMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10569,6 +13738,12 @@ type DeleteBusinessMessagesMethod struct {
 	MessageIDs []int64 `json:"message_ids"`
 }
 
+// NewDeleteBusinessMessagesMethod creates the request of deleteBusinessMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteBusinessMessagesMethod(businessConnectionID string, messageIDs []int64) DeleteBusinessMessagesMethod {
+	return DeleteBusinessMessagesMethod{BusinessConnectionID: businessConnectionID, MessageIDs: messageIDs}
+}
+
 func (m DeleteBusinessMessagesMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10594,6 +13769,18 @@ type SetBusinessAccountNameMethod struct {
 	LastName *string `json:"last_name,omitempty"`
 }
 
+// NewSetBusinessAccountNameMethod creates the request of setBusinessAccountName from the parameters it
+// requires, leaving every optional one unset.
+func NewSetBusinessAccountNameMethod(businessConnectionID string, firstName string) SetBusinessAccountNameMethod {
+	return SetBusinessAccountNameMethod{BusinessConnectionID: businessConnectionID, FirstName: firstName}
+}
+
+// WithLastName returns a copy of m with LastName set to lastName.
+func (m SetBusinessAccountNameMethod) WithLastName(lastName string) SetBusinessAccountNameMethod {
+	m.LastName = &lastName
+	return m
+}
+
 func (m SetBusinessAccountNameMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10617,6 +13804,18 @@ type SetBusinessAccountUsernameMethod struct {
 	Username *string `json:"username,omitempty"`
 }
 
+// NewSetBusinessAccountUsernameMethod creates the request of setBusinessAccountUsername from the parameters it
+// requires, leaving every optional one unset.
+func NewSetBusinessAccountUsernameMethod(businessConnectionID string) SetBusinessAccountUsernameMethod {
+	return SetBusinessAccountUsernameMethod{BusinessConnectionID: businessConnectionID}
+}
+
+// WithUsername returns a copy of m with Username set to username.
+func (m SetBusinessAccountUsernameMethod) WithUsername(username string) SetBusinessAccountUsernameMethod {
+	m.Username = &username
+	return m
+}
+
 func (m SetBusinessAccountUsernameMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10640,6 +13839,18 @@ type SetBusinessAccountBioMethod struct {
 	Bio *string `json:"bio,omitempty"`
 }
 
+// NewSetBusinessAccountBioMethod creates the request of setBusinessAccountBio from the parameters it
+// requires, leaving every optional one unset.
+func NewSetBusinessAccountBioMethod(businessConnectionID string) SetBusinessAccountBioMethod {
+	return SetBusinessAccountBioMethod{BusinessConnectionID: businessConnectionID}
+}
+
+// WithBio returns a copy of m with Bio set to bio.
+func (m SetBusinessAccountBioMethod) WithBio(bio string) SetBusinessAccountBioMethod {
+	m.Bio = &bio
+	return m
+}
+
 func (m SetBusinessAccountBioMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10667,6 +13878,18 @@ type SetBusinessAccountProfilePhotoMethod struct {
 	IsPublic *bool `json:"is_public,omitempty"`
 }
 
+// NewSetBusinessAccountProfilePhotoMethod creates the request of setBusinessAccountProfilePhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewSetBusinessAccountProfilePhotoMethod(businessConnectionID string, photo InputProfilePhoto) SetBusinessAccountProfilePhotoMethod {
+	return SetBusinessAccountProfilePhotoMethod{BusinessConnectionID: businessConnectionID, Photo: photo}
+}
+
+// WithIsPublic returns a copy of m with IsPublic set to isPublic.
+func (m SetBusinessAccountProfilePhotoMethod) WithIsPublic(isPublic bool) SetBusinessAccountProfilePhotoMethod {
+	m.IsPublic = &isPublic
+	return m
+}
+
 func (m SetBusinessAccountProfilePhotoMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10704,6 +13927,18 @@ type RemoveBusinessAccountProfilePhotoMethod struct {
 	IsPublic *bool `json:"is_public,omitempty"`
 }
 
+// NewRemoveBusinessAccountProfilePhotoMethod creates the request of removeBusinessAccountProfilePhoto from the parameters it
+// requires, leaving every optional one unset.
+func NewRemoveBusinessAccountProfilePhotoMethod(businessConnectionID string) RemoveBusinessAccountProfilePhotoMethod {
+	return RemoveBusinessAccountProfilePhotoMethod{BusinessConnectionID: businessConnectionID}
+}
+
+// WithIsPublic returns a copy of m with IsPublic set to isPublic.
+func (m RemoveBusinessAccountProfilePhotoMethod) WithIsPublic(isPublic bool) RemoveBusinessAccountProfilePhotoMethod {
+	m.IsPublic = &isPublic
+	return m
+}
+
 func (m RemoveBusinessAccountProfilePhotoMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10731,6 +13966,12 @@ type SetBusinessAccountGiftSettingsMethod struct {
 	AcceptedGiftTypes AcceptedGiftTypes `json:"accepted_gift_types"`
 }
 
+// NewSetBusinessAccountGiftSettingsMethod creates the request of setBusinessAccountGiftSettings from the parameters it
+// requires, leaving every optional one unset.
+func NewSetBusinessAccountGiftSettingsMethod(businessConnectionID string, showGiftButton bool, acceptedGiftTypes AcceptedGiftTypes) SetBusinessAccountGiftSettingsMethod {
+	return SetBusinessAccountGiftSettingsMethod{BusinessConnectionID: businessConnectionID, ShowGiftButton: showGiftButton, AcceptedGiftTypes: acceptedGiftTypes}
+}
+
 func (m SetBusinessAccountGiftSettingsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10753,6 +13994,12 @@ type GetBusinessAccountStarBalanceMethod struct {
 	BusinessConnectionID string `json:"business_connection_id"`
 }
 
+// NewGetBusinessAccountStarBalanceMethod creates the request of getBusinessAccountStarBalance from the parameters it
+// requires, leaving every optional one unset.
+func NewGetBusinessAccountStarBalanceMethod(businessConnectionID string) GetBusinessAccountStarBalanceMethod {
+	return GetBusinessAccountStarBalanceMethod{BusinessConnectionID: businessConnectionID}
+}
+
 func (m GetBusinessAccountStarBalanceMethod) Call(ctx context.Context, conn Connection) (StarAmount, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10781,6 +14028,12 @@ type TransferBusinessAccountStarsMethod struct {
 	StarCount int64 `json:"star_count"`
 }
 
+// NewTransferBusinessAccountStarsMethod creates the request of transferBusinessAccountStars from the parameters it
+// requires, leaving every optional one unset.
+func NewTransferBusinessAccountStarsMethod(businessConnectionID string, starCount int64) TransferBusinessAccountStarsMethod {
+	return TransferBusinessAccountStarsMethod{BusinessConnectionID: businessConnectionID, StarCount: starCount}
+}
+
 func (m TransferBusinessAccountStarsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10828,6 +14081,72 @@ type GetBusinessAccountGiftsMethod struct {
 	Limit *int64 `json:"limit,omitempty"`
 }
 
+// NewGetBusinessAccountGiftsMethod creates the request of getBusinessAccountGifts from the parameters it
+// requires, leaving every optional one unset.
+func NewGetBusinessAccountGiftsMethod(businessConnectionID string) GetBusinessAccountGiftsMethod {
+	return GetBusinessAccountGiftsMethod{BusinessConnectionID: businessConnectionID}
+}
+
+// WithExcludeUnsaved returns a copy of m with ExcludeUnsaved set to excludeUnsaved.
+func (m GetBusinessAccountGiftsMethod) WithExcludeUnsaved(excludeUnsaved bool) GetBusinessAccountGiftsMethod {
+	m.ExcludeUnsaved = &excludeUnsaved
+	return m
+}
+
+// WithExcludeSaved returns a copy of m with ExcludeSaved set to excludeSaved.
+func (m GetBusinessAccountGiftsMethod) WithExcludeSaved(excludeSaved bool) GetBusinessAccountGiftsMethod {
+	m.ExcludeSaved = &excludeSaved
+	return m
+}
+
+// WithExcludeUnlimited returns a copy of m with ExcludeUnlimited set to excludeUnlimited.
+func (m GetBusinessAccountGiftsMethod) WithExcludeUnlimited(excludeUnlimited bool) GetBusinessAccountGiftsMethod {
+	m.ExcludeUnlimited = &excludeUnlimited
+	return m
+}
+
+// WithExcludeLimitedUpgradable returns a copy of m with ExcludeLimitedUpgradable set to excludeLimitedUpgradable.
+func (m GetBusinessAccountGiftsMethod) WithExcludeLimitedUpgradable(excludeLimitedUpgradable bool) GetBusinessAccountGiftsMethod {
+	m.ExcludeLimitedUpgradable = &excludeLimitedUpgradable
+	return m
+}
+
+// WithExcludeLimitedNonUpgradable returns a copy of m with ExcludeLimitedNonUpgradable set to excludeLimitedNonUpgradable.
+func (m GetBusinessAccountGiftsMethod) WithExcludeLimitedNonUpgradable(excludeLimitedNonUpgradable bool) GetBusinessAccountGiftsMethod {
+	m.ExcludeLimitedNonUpgradable = &excludeLimitedNonUpgradable
+	return m
+}
+
+// WithExcludeUnique returns a copy of m with ExcludeUnique set to excludeUnique.
+func (m GetBusinessAccountGiftsMethod) WithExcludeUnique(excludeUnique bool) GetBusinessAccountGiftsMethod {
+	m.ExcludeUnique = &excludeUnique
+	return m
+}
+
+// WithExcludeFromBlockchain returns a copy of m with ExcludeFromBlockchain set to excludeFromBlockchain.
+func (m GetBusinessAccountGiftsMethod) WithExcludeFromBlockchain(excludeFromBlockchain bool) GetBusinessAccountGiftsMethod {
+	m.ExcludeFromBlockchain = &excludeFromBlockchain
+	return m
+}
+
+// WithSortByPrice returns a copy of m with SortByPrice set to sortByPrice.
+func (m GetBusinessAccountGiftsMethod) WithSortByPrice(sortByPrice bool) GetBusinessAccountGiftsMethod {
+	m.SortByPrice = &sortByPrice
+	return m
+}
+
+// WithOffset returns a copy of m with Offset set to offset.
+func (m GetBusinessAccountGiftsMethod) WithOffset(offset string) GetBusinessAccountGiftsMethod {
+	m.Offset = &offset
+	return m
+}
+
+// WithLimit returns a copy of m with Limit set to limit.
+func (m GetBusinessAccountGiftsMethod) WithLimit(limit int64) GetBusinessAccountGiftsMethod {
+	m.Limit = &limit
+	return m
+}
+
 func (m GetBusinessAccountGiftsMethod) Call(ctx context.Context, conn Connection) (OwnedGifts, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10873,6 +14192,60 @@ type GetUserGiftsMethod struct {
 	Limit *int64 `json:"limit,omitempty"`
 }
 
+// NewGetUserGiftsMethod creates the request of getUserGifts from the parameters it
+// requires, leaving every optional one unset.
+func NewGetUserGiftsMethod(userID int64) GetUserGiftsMethod {
+	return GetUserGiftsMethod{UserID: userID}
+}
+
+// WithExcludeUnlimited returns a copy of m with ExcludeUnlimited set to excludeUnlimited.
+func (m GetUserGiftsMethod) WithExcludeUnlimited(excludeUnlimited bool) GetUserGiftsMethod {
+	m.ExcludeUnlimited = &excludeUnlimited
+	return m
+}
+
+// WithExcludeLimitedUpgradable returns a copy of m with ExcludeLimitedUpgradable set to excludeLimitedUpgradable.
+func (m GetUserGiftsMethod) WithExcludeLimitedUpgradable(excludeLimitedUpgradable bool) GetUserGiftsMethod {
+	m.ExcludeLimitedUpgradable = &excludeLimitedUpgradable
+	return m
+}
+
+// WithExcludeLimitedNonUpgradable returns a copy of m with ExcludeLimitedNonUpgradable set to excludeLimitedNonUpgradable.
+func (m GetUserGiftsMethod) WithExcludeLimitedNonUpgradable(excludeLimitedNonUpgradable bool) GetUserGiftsMethod {
+	m.ExcludeLimitedNonUpgradable = &excludeLimitedNonUpgradable
+	return m
+}
+
+// WithExcludeFromBlockchain returns a copy of m with ExcludeFromBlockchain set to excludeFromBlockchain.
+func (m GetUserGiftsMethod) WithExcludeFromBlockchain(excludeFromBlockchain bool) GetUserGiftsMethod {
+	m.ExcludeFromBlockchain = &excludeFromBlockchain
+	return m
+}
+
+// WithExcludeUnique returns a copy of m with ExcludeUnique set to excludeUnique.
+func (m GetUserGiftsMethod) WithExcludeUnique(excludeUnique bool) GetUserGiftsMethod {
+	m.ExcludeUnique = &excludeUnique
+	return m
+}
+
+// WithSortByPrice returns a copy of m with SortByPrice set to sortByPrice.
+func (m GetUserGiftsMethod) WithSortByPrice(sortByPrice bool) GetUserGiftsMethod {
+	m.SortByPrice = &sortByPrice
+	return m
+}
+
+// WithOffset returns a copy of m with Offset set to offset.
+func (m GetUserGiftsMethod) WithOffset(offset string) GetUserGiftsMethod {
+	m.Offset = &offset
+	return m
+}
+
+// WithLimit returns a copy of m with Limit set to limit.
+func (m GetUserGiftsMethod) WithLimit(limit int64) GetUserGiftsMethod {
+	m.Limit = &limit
+	return m
+}
+
 func (m GetUserGiftsMethod) Call(ctx context.Context, conn Connection) (OwnedGifts, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10927,6 +14300,72 @@ type GetChatGiftsMethod struct {
 	Limit *int64 `json:"limit,omitempty"`
 }
 
+// NewGetChatGiftsMethod creates the request of getChatGifts from the parameters it
+// requires, leaving every optional one unset.
+func NewGetChatGiftsMethod(chatID ChatID) GetChatGiftsMethod {
+	return GetChatGiftsMethod{ChatID: chatID}
+}
+
+// WithExcludeUnsaved returns a copy of m with ExcludeUnsaved set to excludeUnsaved.
+func (m GetChatGiftsMethod) WithExcludeUnsaved(excludeUnsaved bool) GetChatGiftsMethod {
+	m.ExcludeUnsaved = &excludeUnsaved
+	return m
+}
+
+// WithExcludeSaved returns a copy of m with ExcludeSaved set to excludeSaved.
+func (m GetChatGiftsMethod) WithExcludeSaved(excludeSaved bool) GetChatGiftsMethod {
+	m.ExcludeSaved = &excludeSaved
+	return m
+}
+
+// WithExcludeUnlimited returns a copy of m with ExcludeUnlimited set to excludeUnlimited.
+func (m GetChatGiftsMethod) WithExcludeUnlimited(excludeUnlimited bool) GetChatGiftsMethod {
+	m.ExcludeUnlimited = &excludeUnlimited
+	return m
+}
+
+// WithExcludeLimitedUpgradable returns a copy of m with ExcludeLimitedUpgradable set to excludeLimitedUpgradable.
+func (m GetChatGiftsMethod) WithExcludeLimitedUpgradable(excludeLimitedUpgradable bool) GetChatGiftsMethod {
+	m.ExcludeLimitedUpgradable = &excludeLimitedUpgradable
+	return m
+}
+
+// WithExcludeLimitedNonUpgradable returns a copy of m with ExcludeLimitedNonUpgradable set to excludeLimitedNonUpgradable.
+func (m GetChatGiftsMethod) WithExcludeLimitedNonUpgradable(excludeLimitedNonUpgradable bool) GetChatGiftsMethod {
+	m.ExcludeLimitedNonUpgradable = &excludeLimitedNonUpgradable
+	return m
+}
+
+// WithExcludeFromBlockchain returns a copy of m with ExcludeFromBlockchain set to excludeFromBlockchain.
+func (m GetChatGiftsMethod) WithExcludeFromBlockchain(excludeFromBlockchain bool) GetChatGiftsMethod {
+	m.ExcludeFromBlockchain = &excludeFromBlockchain
+	return m
+}
+
+// WithExcludeUnique returns a copy of m with ExcludeUnique set to excludeUnique.
+func (m GetChatGiftsMethod) WithExcludeUnique(excludeUnique bool) GetChatGiftsMethod {
+	m.ExcludeUnique = &excludeUnique
+	return m
+}
+
+// WithSortByPrice returns a copy of m with SortByPrice set to sortByPrice.
+func (m GetChatGiftsMethod) WithSortByPrice(sortByPrice bool) GetChatGiftsMethod {
+	m.SortByPrice = &sortByPrice
+	return m
+}
+
+// WithOffset returns a copy of m with Offset set to offset.
+func (m GetChatGiftsMethod) WithOffset(offset string) GetChatGiftsMethod {
+	m.Offset = &offset
+	return m
+}
+
+// WithLimit returns a copy of m with Limit set to limit.
+func (m GetChatGiftsMethod) WithLimit(limit int64) GetChatGiftsMethod {
+	m.Limit = &limit
+	return m
+}
+
 func (m GetChatGiftsMethod) Call(ctx context.Context, conn Connection) (OwnedGifts, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -10955,6 +14394,12 @@ type ConvertGiftToStarsMethod struct {
 	OwnedGiftID string `json:"owned_gift_id"`
 }
 
+// NewConvertGiftToStarsMethod creates the request of convertGiftToStars from the parameters it
+// requires, leaving every optional one unset.
+func NewConvertGiftToStarsMethod(businessConnectionID string, ownedGiftID string) ConvertGiftToStarsMethod {
+	return ConvertGiftToStarsMethod{BusinessConnectionID: businessConnectionID, OwnedGiftID: ownedGiftID}
+}
+
 func (m ConvertGiftToStarsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -10988,6 +14433,24 @@ type UpgradeGiftMethod struct {
 	StarCount *int64 `json:"star_count,omitempty"`
 }
 
+// NewUpgradeGiftMethod creates the request of upgradeGift from the parameters it
+// requires, leaving every optional one unset.
+func NewUpgradeGiftMethod(businessConnectionID string, ownedGiftID string) UpgradeGiftMethod {
+	return UpgradeGiftMethod{BusinessConnectionID: businessConnectionID, OwnedGiftID: ownedGiftID}
+}
+
+// WithKeepOriginalDetails returns a copy of m with KeepOriginalDetails set to keepOriginalDetails.
+func (m UpgradeGiftMethod) WithKeepOriginalDetails(keepOriginalDetails bool) UpgradeGiftMethod {
+	m.KeepOriginalDetails = &keepOriginalDetails
+	return m
+}
+
+// WithStarCount returns a copy of m with StarCount set to starCount.
+func (m UpgradeGiftMethod) WithStarCount(starCount int64) UpgradeGiftMethod {
+	m.StarCount = &starCount
+	return m
+}
+
 func (m UpgradeGiftMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11020,6 +14483,18 @@ type TransferGiftMethod struct {
 	StarCount *int64 `json:"star_count,omitempty"`
 }
 
+// NewTransferGiftMethod creates the request of transferGift from the parameters it
+// requires, leaving every optional one unset.
+func NewTransferGiftMethod(businessConnectionID string, ownedGiftID string, newOwnerChatID int64) TransferGiftMethod {
+	return TransferGiftMethod{BusinessConnectionID: businessConnectionID, OwnedGiftID: ownedGiftID, NewOwnerChatID: newOwnerChatID}
+}
+
+// WithStarCount returns a copy of m with StarCount set to starCount.
+func (m TransferGiftMethod) WithStarCount(starCount int64) TransferGiftMethod {
+	m.StarCount = &starCount
+	return m
+}
+
 func (m TransferGiftMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11061,6 +14536,48 @@ type PostStoryMethod struct {
 	ProtectContent *bool `json:"protect_content,omitempty"`
 }
 
+// NewPostStoryMethod creates the request of postStory from the parameters it
+// requires, leaving every optional one unset.
+func NewPostStoryMethod(businessConnectionID string, content InputStoryContent, activePeriod int64) PostStoryMethod {
+	return PostStoryMethod{BusinessConnectionID: businessConnectionID, Content: content, ActivePeriod: activePeriod}
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m PostStoryMethod) WithCaption(caption string) PostStoryMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m PostStoryMethod) WithParseMode(parseMode string) PostStoryMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m PostStoryMethod) WithCaptionEntities(captionEntities []MessageEntity) PostStoryMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithAreas returns a copy of m with Areas set to areas.
+func (m PostStoryMethod) WithAreas(areas []StoryArea) PostStoryMethod {
+	m.Areas = areas
+	return m
+}
+
+// WithPostToChatPage returns a copy of m with PostToChatPage set to postToChatPage.
+func (m PostStoryMethod) WithPostToChatPage(postToChatPage bool) PostStoryMethod {
+	m.PostToChatPage = &postToChatPage
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m PostStoryMethod) WithProtectContent(protectContent bool) PostStoryMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
 func (m PostStoryMethod) Call(ctx context.Context, conn Connection) (Story, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11113,6 +14630,24 @@ type RepostStoryMethod struct {
 	ProtectContent *bool `json:"protect_content,omitempty"`
 }
 
+// NewRepostStoryMethod creates the request of repostStory from the parameters it
+// requires, leaving every optional one unset.
+func NewRepostStoryMethod(businessConnectionID string, fromChatID int64, fromStoryID int64, activePeriod int64) RepostStoryMethod {
+	return RepostStoryMethod{BusinessConnectionID: businessConnectionID, FromChatID: fromChatID, FromStoryID: fromStoryID, ActivePeriod: activePeriod}
+}
+
+// WithPostToChatPage returns a copy of m with PostToChatPage set to postToChatPage.
+func (m RepostStoryMethod) WithPostToChatPage(postToChatPage bool) RepostStoryMethod {
+	m.PostToChatPage = &postToChatPage
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m RepostStoryMethod) WithProtectContent(protectContent bool) RepostStoryMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
 func (m RepostStoryMethod) Call(ctx context.Context, conn Connection) (Story, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11153,6 +14688,36 @@ type EditStoryMethod struct {
 	Areas []StoryArea `json:"areas,omitempty"`
 }
 
+// NewEditStoryMethod creates the request of editStory from the parameters it
+// requires, leaving every optional one unset.
+func NewEditStoryMethod(businessConnectionID string, storyID int64, content InputStoryContent) EditStoryMethod {
+	return EditStoryMethod{BusinessConnectionID: businessConnectionID, StoryID: storyID, Content: content}
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m EditStoryMethod) WithCaption(caption string) EditStoryMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m EditStoryMethod) WithParseMode(parseMode string) EditStoryMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m EditStoryMethod) WithCaptionEntities(captionEntities []MessageEntity) EditStoryMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithAreas returns a copy of m with Areas set to areas.
+func (m EditStoryMethod) WithAreas(areas []StoryArea) EditStoryMethod {
+	m.Areas = areas
+	return m
+}
+
 func (m EditStoryMethod) Call(ctx context.Context, conn Connection) (Story, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11193,6 +14758,12 @@ type DeleteStoryMethod struct {
 	StoryID int64 `json:"story_id"`
 }
 
+// NewDeleteStoryMethod creates the request of deleteStory from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteStoryMethod(businessConnectionID string, storyID int64) DeleteStoryMethod {
+	return DeleteStoryMethod{BusinessConnectionID: businessConnectionID, StoryID: storyID}
+}
+
 func (m DeleteStoryMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11217,6 +14788,12 @@ type AnswerWebAppQueryMethod struct {
 	Result InlineQueryResult `json:"result"`
 }
 
+// NewAnswerWebAppQueryMethod creates the request of answerWebAppQuery from the parameters it
+// requires, leaving every optional one unset.
+func NewAnswerWebAppQueryMethod(webAppQueryID string, result InlineQueryResult) AnswerWebAppQueryMethod {
+	return AnswerWebAppQueryMethod{WebAppQueryID: webAppQueryID, Result: result}
+}
+
 func (m AnswerWebAppQueryMethod) Call(ctx context.Context, conn Connection) (SentWebAppMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11264,6 +14841,36 @@ type SavePreparedInlineMessageMethod struct {
 	AllowChannelChats *bool `json:"allow_channel_chats,omitempty"`
 }
 
+// NewSavePreparedInlineMessageMethod creates the request of savePreparedInlineMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewSavePreparedInlineMessageMethod(userID int64, result InlineQueryResult) SavePreparedInlineMessageMethod {
+	return SavePreparedInlineMessageMethod{UserID: userID, Result: result}
+}
+
+// WithAllowUserChats returns a copy of m with AllowUserChats set to allowUserChats.
+func (m SavePreparedInlineMessageMethod) WithAllowUserChats(allowUserChats bool) SavePreparedInlineMessageMethod {
+	m.AllowUserChats = &allowUserChats
+	return m
+}
+
+// WithAllowBotChats returns a copy of m with AllowBotChats set to allowBotChats.
+func (m SavePreparedInlineMessageMethod) WithAllowBotChats(allowBotChats bool) SavePreparedInlineMessageMethod {
+	m.AllowBotChats = &allowBotChats
+	return m
+}
+
+// WithAllowGroupChats returns a copy of m with AllowGroupChats set to allowGroupChats.
+func (m SavePreparedInlineMessageMethod) WithAllowGroupChats(allowGroupChats bool) SavePreparedInlineMessageMethod {
+	m.AllowGroupChats = &allowGroupChats
+	return m
+}
+
+// WithAllowChannelChats returns a copy of m with AllowChannelChats set to allowChannelChats.
+func (m SavePreparedInlineMessageMethod) WithAllowChannelChats(allowChannelChats bool) SavePreparedInlineMessageMethod {
+	m.AllowChannelChats = &allowChannelChats
+	return m
+}
+
 func (m SavePreparedInlineMessageMethod) Call(ctx context.Context, conn Connection) (PreparedInlineMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11304,6 +14911,12 @@ type SavePreparedKeyboardButtonMethod struct {
 	Button KeyboardButton `json:"button"`
 }
 
+// NewSavePreparedKeyboardButtonMethod creates the request of savePreparedKeyboardButton from the parameters it
+// requires, leaving every optional one unset.
+func NewSavePreparedKeyboardButtonMethod(userID int64, button KeyboardButton) SavePreparedKeyboardButtonMethod {
+	return SavePreparedKeyboardButtonMethod{UserID: userID, Button: button}
+}
+
 func (m SavePreparedKeyboardButtonMethod) Call(ctx context.Context, conn Connection) (PreparedKeyboardButton, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11359,6 +14972,72 @@ type EditMessageTextMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditMessageTextMethod creates the request of editMessageText from the parameters it
+// requires, leaving every optional one unset.
+func NewEditMessageTextMethod() EditMessageTextMethod {
+	return EditMessageTextMethod{}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m EditMessageTextMethod) WithBusinessConnectionID(businessConnectionID string) EditMessageTextMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m EditMessageTextMethod) WithChatID(chatID ChatID) EditMessageTextMethod {
+	m.ChatID = chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m EditMessageTextMethod) WithMessageID(messageID int64) EditMessageTextMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m EditMessageTextMethod) WithInlineMessageID(inlineMessageID string) EditMessageTextMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
+// WithText returns a copy of m with Text set to text.
+func (m EditMessageTextMethod) WithText(text string) EditMessageTextMethod {
+	m.Text = &text
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m EditMessageTextMethod) WithParseMode(parseMode string) EditMessageTextMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithEntities returns a copy of m with Entities set to entities.
+func (m EditMessageTextMethod) WithEntities(entities []MessageEntity) EditMessageTextMethod {
+	m.Entities = entities
+	return m
+}
+
+// WithLinkPreviewOptions returns a copy of m with LinkPreviewOptions set to linkPreviewOptions.
+func (m EditMessageTextMethod) WithLinkPreviewOptions(linkPreviewOptions LinkPreviewOptions) EditMessageTextMethod {
+	m.LinkPreviewOptions = &linkPreviewOptions
+	return m
+}
+
+// WithRichMessage returns a copy of m with RichMessage set to richMessage.
+func (m EditMessageTextMethod) WithRichMessage(richMessage InputRichMessage) EditMessageTextMethod {
+	m.RichMessage = &richMessage
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditMessageTextMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditMessageTextMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditMessageTextMethod) Call(ctx context.Context, conn Connection) (MaybeMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11427,6 +15106,66 @@ type EditMessageCaptionMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditMessageCaptionMethod creates the request of editMessageCaption from the parameters it
+// requires, leaving every optional one unset.
+func NewEditMessageCaptionMethod() EditMessageCaptionMethod {
+	return EditMessageCaptionMethod{}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m EditMessageCaptionMethod) WithBusinessConnectionID(businessConnectionID string) EditMessageCaptionMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m EditMessageCaptionMethod) WithChatID(chatID ChatID) EditMessageCaptionMethod {
+	m.ChatID = chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m EditMessageCaptionMethod) WithMessageID(messageID int64) EditMessageCaptionMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m EditMessageCaptionMethod) WithInlineMessageID(inlineMessageID string) EditMessageCaptionMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m EditMessageCaptionMethod) WithCaption(caption string) EditMessageCaptionMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m EditMessageCaptionMethod) WithParseMode(parseMode string) EditMessageCaptionMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m EditMessageCaptionMethod) WithCaptionEntities(captionEntities []MessageEntity) EditMessageCaptionMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithShowCaptionAboveMedia returns a copy of m with ShowCaptionAboveMedia set to showCaptionAboveMedia.
+func (m EditMessageCaptionMethod) WithShowCaptionAboveMedia(showCaptionAboveMedia bool) EditMessageCaptionMethod {
+	m.ShowCaptionAboveMedia = &showCaptionAboveMedia
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditMessageCaptionMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditMessageCaptionMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditMessageCaptionMethod) Call(ctx context.Context, conn Connection) (MaybeMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11475,6 +15214,42 @@ type EditMessageMediaMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditMessageMediaMethod creates the request of editMessageMedia from the parameters it
+// requires, leaving every optional one unset.
+func NewEditMessageMediaMethod(media InputMedia) EditMessageMediaMethod {
+	return EditMessageMediaMethod{Media: media}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m EditMessageMediaMethod) WithBusinessConnectionID(businessConnectionID string) EditMessageMediaMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m EditMessageMediaMethod) WithChatID(chatID ChatID) EditMessageMediaMethod {
+	m.ChatID = chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m EditMessageMediaMethod) WithMessageID(messageID int64) EditMessageMediaMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m EditMessageMediaMethod) WithInlineMessageID(inlineMessageID string) EditMessageMediaMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditMessageMediaMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditMessageMediaMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditMessageMediaMethod) Call(ctx context.Context, conn Connection) (MaybeMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11546,6 +15321,66 @@ type EditMessageLiveLocationMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditMessageLiveLocationMethod creates the request of editMessageLiveLocation from the parameters it
+// requires, leaving every optional one unset.
+func NewEditMessageLiveLocationMethod(latitude float64, longitude float64) EditMessageLiveLocationMethod {
+	return EditMessageLiveLocationMethod{Latitude: latitude, Longitude: longitude}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m EditMessageLiveLocationMethod) WithBusinessConnectionID(businessConnectionID string) EditMessageLiveLocationMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m EditMessageLiveLocationMethod) WithChatID(chatID ChatID) EditMessageLiveLocationMethod {
+	m.ChatID = chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m EditMessageLiveLocationMethod) WithMessageID(messageID int64) EditMessageLiveLocationMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m EditMessageLiveLocationMethod) WithInlineMessageID(inlineMessageID string) EditMessageLiveLocationMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
+// WithLivePeriod returns a copy of m with LivePeriod set to livePeriod.
+func (m EditMessageLiveLocationMethod) WithLivePeriod(livePeriod int64) EditMessageLiveLocationMethod {
+	m.LivePeriod = &livePeriod
+	return m
+}
+
+// WithHorizontalAccuracy returns a copy of m with HorizontalAccuracy set to horizontalAccuracy.
+func (m EditMessageLiveLocationMethod) WithHorizontalAccuracy(horizontalAccuracy float64) EditMessageLiveLocationMethod {
+	m.HorizontalAccuracy = &horizontalAccuracy
+	return m
+}
+
+// WithHeading returns a copy of m with Heading set to heading.
+func (m EditMessageLiveLocationMethod) WithHeading(heading int64) EditMessageLiveLocationMethod {
+	m.Heading = &heading
+	return m
+}
+
+// WithProximityAlertRadius returns a copy of m with ProximityAlertRadius set to proximityAlertRadius.
+func (m EditMessageLiveLocationMethod) WithProximityAlertRadius(proximityAlertRadius int64) EditMessageLiveLocationMethod {
+	m.ProximityAlertRadius = &proximityAlertRadius
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditMessageLiveLocationMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditMessageLiveLocationMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditMessageLiveLocationMethod) Call(ctx context.Context, conn Connection) (MaybeMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11585,6 +15420,42 @@ type StopMessageLiveLocationMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewStopMessageLiveLocationMethod creates the request of stopMessageLiveLocation from the parameters it
+// requires, leaving every optional one unset.
+func NewStopMessageLiveLocationMethod() StopMessageLiveLocationMethod {
+	return StopMessageLiveLocationMethod{}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m StopMessageLiveLocationMethod) WithBusinessConnectionID(businessConnectionID string) StopMessageLiveLocationMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m StopMessageLiveLocationMethod) WithChatID(chatID ChatID) StopMessageLiveLocationMethod {
+	m.ChatID = chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m StopMessageLiveLocationMethod) WithMessageID(messageID int64) StopMessageLiveLocationMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m StopMessageLiveLocationMethod) WithInlineMessageID(inlineMessageID string) StopMessageLiveLocationMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m StopMessageLiveLocationMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) StopMessageLiveLocationMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m StopMessageLiveLocationMethod) Call(ctx context.Context, conn Connection) (MaybeMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11620,6 +15491,18 @@ type EditMessageChecklistMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditMessageChecklistMethod creates the request of editMessageChecklist from the parameters it
+// requires, leaving every optional one unset.
+func NewEditMessageChecklistMethod(businessConnectionID string, chatID ChatID, messageID int64, checklist InputChecklist) EditMessageChecklistMethod {
+	return EditMessageChecklistMethod{BusinessConnectionID: businessConnectionID, ChatID: chatID, MessageID: messageID, Checklist: checklist}
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditMessageChecklistMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditMessageChecklistMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditMessageChecklistMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11661,6 +15544,42 @@ type EditMessageReplyMarkupMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditMessageReplyMarkupMethod creates the request of editMessageReplyMarkup from the parameters it
+// requires, leaving every optional one unset.
+func NewEditMessageReplyMarkupMethod() EditMessageReplyMarkupMethod {
+	return EditMessageReplyMarkupMethod{}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m EditMessageReplyMarkupMethod) WithBusinessConnectionID(businessConnectionID string) EditMessageReplyMarkupMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m EditMessageReplyMarkupMethod) WithChatID(chatID ChatID) EditMessageReplyMarkupMethod {
+	m.ChatID = chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m EditMessageReplyMarkupMethod) WithMessageID(messageID int64) EditMessageReplyMarkupMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m EditMessageReplyMarkupMethod) WithInlineMessageID(inlineMessageID string) EditMessageReplyMarkupMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditMessageReplyMarkupMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditMessageReplyMarkupMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditMessageReplyMarkupMethod) Call(ctx context.Context, conn Connection) (MaybeMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11694,6 +15613,24 @@ type StopPollMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewStopPollMethod creates the request of stopPoll from the parameters it
+// requires, leaving every optional one unset.
+func NewStopPollMethod(chatID ChatID, messageID int64) StopPollMethod {
+	return StopPollMethod{ChatID: chatID, MessageID: messageID}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m StopPollMethod) WithBusinessConnectionID(businessConnectionID string) StopPollMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m StopPollMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) StopPollMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m StopPollMethod) Call(ctx context.Context, conn Connection) (Poll, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -11737,6 +15674,36 @@ type EditEphemeralMessageTextMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditEphemeralMessageTextMethod creates the request of editEphemeralMessageText from the parameters it
+// requires, leaving every optional one unset.
+func NewEditEphemeralMessageTextMethod(chatID ChatID, receiverUserID int64, ephemeralMessageID int64, text string) EditEphemeralMessageTextMethod {
+	return EditEphemeralMessageTextMethod{ChatID: chatID, ReceiverUserID: receiverUserID, EphemeralMessageID: ephemeralMessageID, Text: text}
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m EditEphemeralMessageTextMethod) WithParseMode(parseMode string) EditEphemeralMessageTextMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithEntities returns a copy of m with Entities set to entities.
+func (m EditEphemeralMessageTextMethod) WithEntities(entities []MessageEntity) EditEphemeralMessageTextMethod {
+	m.Entities = entities
+	return m
+}
+
+// WithLinkPreviewOptions returns a copy of m with LinkPreviewOptions set to linkPreviewOptions.
+func (m EditEphemeralMessageTextMethod) WithLinkPreviewOptions(linkPreviewOptions LinkPreviewOptions) EditEphemeralMessageTextMethod {
+	m.LinkPreviewOptions = &linkPreviewOptions
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditEphemeralMessageTextMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditEphemeralMessageTextMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditEphemeralMessageTextMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11770,6 +15737,18 @@ type EditEphemeralMessageMediaMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditEphemeralMessageMediaMethod creates the request of editEphemeralMessageMedia from the parameters it
+// requires, leaving every optional one unset.
+func NewEditEphemeralMessageMediaMethod(chatID ChatID, receiverUserID int64, ephemeralMessageID int64, media InputMedia) EditEphemeralMessageMediaMethod {
+	return EditEphemeralMessageMediaMethod{ChatID: chatID, ReceiverUserID: receiverUserID, EphemeralMessageID: ephemeralMessageID, Media: media}
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditEphemeralMessageMediaMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditEphemeralMessageMediaMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditEphemeralMessageMediaMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11819,6 +15798,36 @@ type EditEphemeralMessageCaptionMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditEphemeralMessageCaptionMethod creates the request of editEphemeralMessageCaption from the parameters it
+// requires, leaving every optional one unset.
+func NewEditEphemeralMessageCaptionMethod(chatID ChatID, receiverUserID int64, ephemeralMessageID int64) EditEphemeralMessageCaptionMethod {
+	return EditEphemeralMessageCaptionMethod{ChatID: chatID, ReceiverUserID: receiverUserID, EphemeralMessageID: ephemeralMessageID}
+}
+
+// WithCaption returns a copy of m with Caption set to caption.
+func (m EditEphemeralMessageCaptionMethod) WithCaption(caption string) EditEphemeralMessageCaptionMethod {
+	m.Caption = &caption
+	return m
+}
+
+// WithParseMode returns a copy of m with ParseMode set to parseMode.
+func (m EditEphemeralMessageCaptionMethod) WithParseMode(parseMode string) EditEphemeralMessageCaptionMethod {
+	m.ParseMode = &parseMode
+	return m
+}
+
+// WithCaptionEntities returns a copy of m with CaptionEntities set to captionEntities.
+func (m EditEphemeralMessageCaptionMethod) WithCaptionEntities(captionEntities []MessageEntity) EditEphemeralMessageCaptionMethod {
+	m.CaptionEntities = captionEntities
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditEphemeralMessageCaptionMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditEphemeralMessageCaptionMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditEphemeralMessageCaptionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11848,6 +15857,18 @@ type EditEphemeralMessageReplyMarkupMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewEditEphemeralMessageReplyMarkupMethod creates the request of editEphemeralMessageReplyMarkup from the parameters it
+// requires, leaving every optional one unset.
+func NewEditEphemeralMessageReplyMarkupMethod(chatID ChatID, receiverUserID int64, ephemeralMessageID int64) EditEphemeralMessageReplyMarkupMethod {
+	return EditEphemeralMessageReplyMarkupMethod{ChatID: chatID, ReceiverUserID: receiverUserID, EphemeralMessageID: ephemeralMessageID}
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m EditEphemeralMessageReplyMarkupMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) EditEphemeralMessageReplyMarkupMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m EditEphemeralMessageReplyMarkupMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11877,6 +15898,18 @@ type ApproveSuggestedPostMethod struct {
 	SendDate *int64 `json:"send_date,omitempty"`
 }
 
+// NewApproveSuggestedPostMethod creates the request of approveSuggestedPost from the parameters it
+// requires, leaving every optional one unset.
+func NewApproveSuggestedPostMethod(chatID int64, messageID int64) ApproveSuggestedPostMethod {
+	return ApproveSuggestedPostMethod{ChatID: chatID, MessageID: messageID}
+}
+
+// WithSendDate returns a copy of m with SendDate set to sendDate.
+func (m ApproveSuggestedPostMethod) WithSendDate(sendDate int64) ApproveSuggestedPostMethod {
+	m.SendDate = &sendDate
+	return m
+}
+
 func (m ApproveSuggestedPostMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11903,6 +15936,18 @@ type DeclineSuggestedPostMethod struct {
 	Comment *string `json:"comment,omitempty"`
 }
 
+// NewDeclineSuggestedPostMethod creates the request of declineSuggestedPost from the parameters it
+// requires, leaving every optional one unset.
+func NewDeclineSuggestedPostMethod(chatID int64, messageID int64) DeclineSuggestedPostMethod {
+	return DeclineSuggestedPostMethod{ChatID: chatID, MessageID: messageID}
+}
+
+// WithComment returns a copy of m with Comment set to comment.
+func (m DeclineSuggestedPostMethod) WithComment(comment string) DeclineSuggestedPostMethod {
+	m.Comment = &comment
+	return m
+}
+
 func (m DeclineSuggestedPostMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11943,6 +15988,12 @@ type DeleteMessageMethod struct {
 	MessageID int64 `json:"message_id"`
 }
 
+// NewDeleteMessageMethod creates the request of deleteMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteMessageMethod(chatID ChatID, messageID int64) DeleteMessageMethod {
+	return DeleteMessageMethod{ChatID: chatID, MessageID: messageID}
+}
+
 func (m DeleteMessageMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11968,6 +16019,12 @@ type DeleteMessagesMethod struct {
 	MessageIDs []int64 `json:"message_ids"`
 }
 
+// NewDeleteMessagesMethod creates the request of deleteMessages from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteMessagesMethod(chatID ChatID, messageIDs []int64) DeleteMessagesMethod {
+	return DeleteMessagesMethod{ChatID: chatID, MessageIDs: messageIDs}
+}
+
 func (m DeleteMessagesMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -11995,6 +16052,12 @@ type DeleteEphemeralMessageMethod struct {
 	EphemeralMessageID int64 `json:"ephemeral_message_id"`
 }
 
+// NewDeleteEphemeralMessageMethod creates the request of deleteEphemeralMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteEphemeralMessageMethod(chatID ChatID, receiverUserID int64, ephemeralMessageID int64) DeleteEphemeralMessageMethod {
+	return DeleteEphemeralMessageMethod{ChatID: chatID, ReceiverUserID: receiverUserID, EphemeralMessageID: ephemeralMessageID}
+}
+
 func (m DeleteEphemeralMessageMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12026,6 +16089,24 @@ type DeleteMessageReactionMethod struct {
 	ActorChatID *int64 `json:"actor_chat_id,omitempty"`
 }
 
+// NewDeleteMessageReactionMethod creates the request of deleteMessageReaction from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteMessageReactionMethod(chatID ChatID, messageID int64) DeleteMessageReactionMethod {
+	return DeleteMessageReactionMethod{ChatID: chatID, MessageID: messageID}
+}
+
+// WithUserID returns a copy of m with UserID set to userID.
+func (m DeleteMessageReactionMethod) WithUserID(userID int64) DeleteMessageReactionMethod {
+	m.UserID = &userID
+	return m
+}
+
+// WithActorChatID returns a copy of m with ActorChatID set to actorChatID.
+func (m DeleteMessageReactionMethod) WithActorChatID(actorChatID int64) DeleteMessageReactionMethod {
+	m.ActorChatID = &actorChatID
+	return m
+}
+
 func (m DeleteMessageReactionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12056,6 +16137,24 @@ type DeleteAllMessageReactionsMethod struct {
 	ActorChatID *int64 `json:"actor_chat_id,omitempty"`
 }
 
+// NewDeleteAllMessageReactionsMethod creates the request of deleteAllMessageReactions from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteAllMessageReactionsMethod(chatID ChatID) DeleteAllMessageReactionsMethod {
+	return DeleteAllMessageReactionsMethod{ChatID: chatID}
+}
+
+// WithUserID returns a copy of m with UserID set to userID.
+func (m DeleteAllMessageReactionsMethod) WithUserID(userID int64) DeleteAllMessageReactionsMethod {
+	m.UserID = &userID
+	return m
+}
+
+// WithActorChatID returns a copy of m with ActorChatID set to actorChatID.
+func (m DeleteAllMessageReactionsMethod) WithActorChatID(actorChatID int64) DeleteAllMessageReactionsMethod {
+	m.ActorChatID = &actorChatID
+	return m
+}
+
 func (m DeleteAllMessageReactionsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12172,7 +16271,7 @@ type InputSticker struct {
 func (o InputSticker) resolve(sink *fileSink) (json.RawMessage, error) {
 	sticker := o.Sticker.attach(sink)
 	type alias InputSticker
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Sticker string `json:"sticker"`
 		alias
 	}{
@@ -12237,6 +16336,90 @@ type SendStickerMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendStickerMethod creates the request of sendSticker from the parameters it
+// requires, leaving every optional one unset.
+func NewSendStickerMethod(chatID ChatID, sticker InputFile) SendStickerMethod {
+	return SendStickerMethod{ChatID: chatID, Sticker: sticker}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendStickerMethod) WithBusinessConnectionID(businessConnectionID string) SendStickerMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendStickerMethod) WithMessageThreadID(messageThreadID int64) SendStickerMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendStickerMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendStickerMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithReceiverUserID returns a copy of m with ReceiverUserID set to receiverUserID.
+func (m SendStickerMethod) WithReceiverUserID(receiverUserID int64) SendStickerMethod {
+	m.ReceiverUserID = &receiverUserID
+	return m
+}
+
+// WithCallbackQueryID returns a copy of m with CallbackQueryID set to callbackQueryID.
+func (m SendStickerMethod) WithCallbackQueryID(callbackQueryID string) SendStickerMethod {
+	m.CallbackQueryID = &callbackQueryID
+	return m
+}
+
+// WithEmoji returns a copy of m with Emoji set to emoji.
+func (m SendStickerMethod) WithEmoji(emoji string) SendStickerMethod {
+	m.Emoji = &emoji
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendStickerMethod) WithDisableNotification(disableNotification bool) SendStickerMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendStickerMethod) WithProtectContent(protectContent bool) SendStickerMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendStickerMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendStickerMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendStickerMethod) WithMessageEffectID(messageEffectID string) SendStickerMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendStickerMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendStickerMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendStickerMethod) WithReplyParameters(replyParameters ReplyParameters) SendStickerMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendStickerMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendStickerMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendStickerMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -12271,6 +16454,12 @@ type GetStickerSetMethod struct {
 	Name string `json:"name"`
 }
 
+// NewGetStickerSetMethod creates the request of getStickerSet from the parameters it
+// requires, leaving every optional one unset.
+func NewGetStickerSetMethod(name string) GetStickerSetMethod {
+	return GetStickerSetMethod{Name: name}
+}
+
 func (m GetStickerSetMethod) Call(ctx context.Context, conn Connection) (StickerSet, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -12297,6 +16486,12 @@ type GetCustomEmojiStickersMethod struct {
 	CustomEmojiIDs []string `json:"custom_emoji_ids"`
 }
 
+// NewGetCustomEmojiStickersMethod creates the request of getCustomEmojiStickers from the parameters it
+// requires, leaving every optional one unset.
+func NewGetCustomEmojiStickersMethod(customEmojiIDs []string) GetCustomEmojiStickersMethod {
+	return GetCustomEmojiStickersMethod{CustomEmojiIDs: customEmojiIDs}
+}
+
 func (m GetCustomEmojiStickersMethod) Call(ctx context.Context, conn Connection) ([]Sticker, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -12329,6 +16524,12 @@ type UploadStickerFileMethod struct {
 	StickerFormat string `json:"sticker_format"`
 }
 
+// NewUploadStickerFileMethod creates the request of uploadStickerFile from the parameters it
+// requires, leaving every optional one unset.
+func NewUploadStickerFileMethod(userID int64, sticker InputFile, stickerFormat string) UploadStickerFileMethod {
+	return UploadStickerFileMethod{UserID: userID, Sticker: sticker, StickerFormat: stickerFormat}
+}
+
 func (m UploadStickerFileMethod) Call(ctx context.Context, conn Connection) (File, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -12381,6 +16582,24 @@ type CreateNewStickerSetMethod struct {
 	NeedsRepainting *bool `json:"needs_repainting,omitempty"`
 }
 
+// NewCreateNewStickerSetMethod creates the request of createNewStickerSet from the parameters it
+// requires, leaving every optional one unset.
+func NewCreateNewStickerSetMethod(userID int64, name string, title string, stickers []InputSticker) CreateNewStickerSetMethod {
+	return CreateNewStickerSetMethod{UserID: userID, Name: name, Title: title, Stickers: stickers}
+}
+
+// WithStickerType returns a copy of m with StickerType set to stickerType.
+func (m CreateNewStickerSetMethod) WithStickerType(stickerType string) CreateNewStickerSetMethod {
+	m.StickerType = &stickerType
+	return m
+}
+
+// WithNeedsRepainting returns a copy of m with NeedsRepainting set to needsRepainting.
+func (m CreateNewStickerSetMethod) WithNeedsRepainting(needsRepainting bool) CreateNewStickerSetMethod {
+	m.NeedsRepainting = &needsRepainting
+	return m
+}
+
 func (m CreateNewStickerSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12425,6 +16644,12 @@ type AddStickerToSetMethod struct {
 	Sticker InputSticker `json:"sticker"`
 }
 
+// NewAddStickerToSetMethod creates the request of addStickerToSet from the parameters it
+// requires, leaving every optional one unset.
+func NewAddStickerToSetMethod(userID int64, name string, sticker InputSticker) AddStickerToSetMethod {
+	return AddStickerToSetMethod{UserID: userID, Name: name, Sticker: sticker}
+}
+
 func (m AddStickerToSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12460,6 +16685,12 @@ type SetStickerPositionInSetMethod struct {
 	Position int64 `json:"position"`
 }
 
+// NewSetStickerPositionInSetMethod creates the request of setStickerPositionInSet from the parameters it
+// requires, leaving every optional one unset.
+func NewSetStickerPositionInSetMethod(sticker string, position int64) SetStickerPositionInSetMethod {
+	return SetStickerPositionInSetMethod{Sticker: sticker, Position: position}
+}
+
 func (m SetStickerPositionInSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12481,6 +16712,12 @@ type DeleteStickerFromSetMethod struct {
 	Sticker string `json:"sticker"`
 }
 
+// NewDeleteStickerFromSetMethod creates the request of deleteStickerFromSet from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteStickerFromSetMethod(sticker string) DeleteStickerFromSetMethod {
+	return DeleteStickerFromSetMethod{Sticker: sticker}
+}
+
 func (m DeleteStickerFromSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12511,6 +16748,12 @@ type ReplaceStickerInSetMethod struct {
 	Sticker InputSticker `json:"sticker"`
 }
 
+// NewReplaceStickerInSetMethod creates the request of replaceStickerInSet from the parameters it
+// requires, leaving every optional one unset.
+func NewReplaceStickerInSetMethod(userID int64, name string, oldSticker string, sticker InputSticker) ReplaceStickerInSetMethod {
+	return ReplaceStickerInSetMethod{UserID: userID, Name: name, OldSticker: oldSticker, Sticker: sticker}
+}
+
 func (m ReplaceStickerInSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12547,6 +16790,12 @@ type SetStickerEmojiListMethod struct {
 	EmojiList []string `json:"emoji_list"`
 }
 
+// NewSetStickerEmojiListMethod creates the request of setStickerEmojiList from the parameters it
+// requires, leaving every optional one unset.
+func NewSetStickerEmojiListMethod(sticker string, emojiList []string) SetStickerEmojiListMethod {
+	return SetStickerEmojiListMethod{Sticker: sticker, EmojiList: emojiList}
+}
+
 func (m SetStickerEmojiListMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12572,6 +16821,18 @@ type SetStickerKeywordsMethod struct {
 	Keywords []string `json:"keywords,omitempty"`
 }
 
+// NewSetStickerKeywordsMethod creates the request of setStickerKeywords from the parameters it
+// requires, leaving every optional one unset.
+func NewSetStickerKeywordsMethod(sticker string) SetStickerKeywordsMethod {
+	return SetStickerKeywordsMethod{Sticker: sticker}
+}
+
+// WithKeywords returns a copy of m with Keywords set to keywords.
+func (m SetStickerKeywordsMethod) WithKeywords(keywords []string) SetStickerKeywordsMethod {
+	m.Keywords = keywords
+	return m
+}
+
 func (m SetStickerKeywordsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12597,6 +16858,18 @@ type SetStickerMaskPositionMethod struct {
 	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
 }
 
+// NewSetStickerMaskPositionMethod creates the request of setStickerMaskPosition from the parameters it
+// requires, leaving every optional one unset.
+func NewSetStickerMaskPositionMethod(sticker string) SetStickerMaskPositionMethod {
+	return SetStickerMaskPositionMethod{Sticker: sticker}
+}
+
+// WithMaskPosition returns a copy of m with MaskPosition set to maskPosition.
+func (m SetStickerMaskPositionMethod) WithMaskPosition(maskPosition MaskPosition) SetStickerMaskPositionMethod {
+	m.MaskPosition = &maskPosition
+	return m
+}
+
 func (m SetStickerMaskPositionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12620,6 +16893,12 @@ type SetStickerSetTitleMethod struct {
 	Title string `json:"title"`
 }
 
+// NewSetStickerSetTitleMethod creates the request of setStickerSetTitle from the parameters it
+// requires, leaving every optional one unset.
+func NewSetStickerSetTitleMethod(name string, title string) SetStickerSetTitleMethod {
+	return SetStickerSetTitleMethod{Name: name, Title: title}
+}
+
 func (m SetStickerSetTitleMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12660,6 +16939,18 @@ type SetStickerSetThumbnailMethod struct {
 	Thumbnail InputFile `json:"thumbnail,omitempty"`
 }
 
+// NewSetStickerSetThumbnailMethod creates the request of setStickerSetThumbnail from the parameters it
+// requires, leaving every optional one unset.
+func NewSetStickerSetThumbnailMethod(name string, userID int64, format string) SetStickerSetThumbnailMethod {
+	return SetStickerSetThumbnailMethod{Name: name, UserID: userID, Format: format}
+}
+
+// WithThumbnail returns a copy of m with Thumbnail set to thumbnail.
+func (m SetStickerSetThumbnailMethod) WithThumbnail(thumbnail InputFile) SetStickerSetThumbnailMethod {
+	m.Thumbnail = thumbnail
+	return m
+}
+
 func (m SetStickerSetThumbnailMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12696,6 +16987,18 @@ type SetCustomEmojiStickerSetThumbnailMethod struct {
 	CustomEmojiID *string `json:"custom_emoji_id,omitempty"`
 }
 
+// NewSetCustomEmojiStickerSetThumbnailMethod creates the request of setCustomEmojiStickerSetThumbnail from the parameters it
+// requires, leaving every optional one unset.
+func NewSetCustomEmojiStickerSetThumbnailMethod(name string) SetCustomEmojiStickerSetThumbnailMethod {
+	return SetCustomEmojiStickerSetThumbnailMethod{Name: name}
+}
+
+// WithCustomEmojiID returns a copy of m with CustomEmojiID set to customEmojiID.
+func (m SetCustomEmojiStickerSetThumbnailMethod) WithCustomEmojiID(customEmojiID string) SetCustomEmojiStickerSetThumbnailMethod {
+	m.CustomEmojiID = &customEmojiID
+	return m
+}
+
 func (m SetCustomEmojiStickerSetThumbnailMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12717,6 +17020,12 @@ type DeleteStickerSetMethod struct {
 	Name string `json:"name"`
 }
 
+// NewDeleteStickerSetMethod creates the request of deleteStickerSet from the parameters it
+// requires, leaving every optional one unset.
+func NewDeleteStickerSetMethod(name string) DeleteStickerSetMethod {
+	return DeleteStickerSetMethod{Name: name}
+}
+
 func (m DeleteStickerSetMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -12745,7 +17054,7 @@ func (o *RichMessage) UnmarshalJSON(data []byte) error {
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichMessage(aux.alias)
@@ -12807,7 +17116,7 @@ func (o InputRichMessage) resolve(sink *fileSink) (json.RawMessage, error) {
 		media[i] = data
 	}
 	type alias InputRichMessage
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Blocks []json.RawMessage `json:"blocks,omitempty"`
 		Media []json.RawMessage `json:"media,omitempty"`
 		alias
@@ -12837,7 +17146,7 @@ func (o InputRichMessageMedia) resolve(sink *fileSink) (json.RawMessage, error)
 		return nil, err
 	}
 	type alias InputRichMessageMedia
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Media json.RawMessage `json:"media"`
 		alias
 	}{
@@ -12890,6 +17199,72 @@ type SendRichMessageMethod struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendRichMessageMethod creates the request of sendRichMessage from the parameters it
+// requires, leaving every optional one unset.
+func NewSendRichMessageMethod(chatID ChatID, richMessage InputRichMessage) SendRichMessageMethod {
+	return SendRichMessageMethod{ChatID: chatID, RichMessage: richMessage}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendRichMessageMethod) WithBusinessConnectionID(businessConnectionID string) SendRichMessageMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendRichMessageMethod) WithMessageThreadID(messageThreadID int64) SendRichMessageMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendRichMessageMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendRichMessageMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendRichMessageMethod) WithDisableNotification(disableNotification bool) SendRichMessageMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendRichMessageMethod) WithProtectContent(protectContent bool) SendRichMessageMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendRichMessageMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendRichMessageMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendRichMessageMethod) WithMessageEffectID(messageEffectID string) SendRichMessageMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendRichMessageMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendRichMessageMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendRichMessageMethod) WithReplyParameters(replyParameters ReplyParameters) SendRichMessageMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendRichMessageMethod) WithReplyMarkup(replyMarkup ReplyMarkup) SendRichMessageMethod {
+	m.ReplyMarkup = replyMarkup
+	return m
+}
+
 func (m SendRichMessageMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -12938,6 +17313,18 @@ type SendRichMessageDraftMethod struct {
 	MessageThreadID *int64 `json:"message_thread_id,omitempty"`
 }
 
+// NewSendRichMessageDraftMethod creates the request of sendRichMessageDraft from the parameters it
+// requires, leaving every optional one unset.
+func NewSendRichMessageDraftMethod(chatID int64, draftID int64, richMessage InputRichMessage) SendRichMessageDraftMethod {
+	return SendRichMessageDraftMethod{ChatID: chatID, DraftID: draftID, RichMessage: richMessage}
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendRichMessageDraftMethod) WithMessageThreadID(messageThreadID int64) SendRichMessageDraftMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
 func (m SendRichMessageDraftMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -13005,13 +17392,13 @@ func unmarshalRichText(data []byte) (RichText, error) {
 	switch trimmed[0] {
 	case '"':
 		var plain RichTextPlain
-		if err := json.Unmarshal(data, &plain); err != nil {
+		if err := codec.Unmarshal(data, &plain); err != nil {
 			return nil, err
 		}
 		return plain, nil
 	case '[':
 		var raws []json.RawMessage
-		if err := json.Unmarshal(data, &raws); err != nil {
+		if err := codec.Unmarshal(data, &raws); err != nil {
 			return nil, err
 		}
 		sequence := make(RichTextSequence, len(raws))
@@ -13027,157 +17414,157 @@ func unmarshalRichText(data []byte) (RichText, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "bold":
 		var variant RichTextBold
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "italic":
 		var variant RichTextItalic
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "underline":
 		var variant RichTextUnderline
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "strikethrough":
 		var variant RichTextStrikethrough
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "spoiler":
 		var variant RichTextSpoiler
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "date_time":
 		var variant RichTextDateTime
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "text_mention":
 		var variant RichTextTextMention
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "subscript":
 		var variant RichTextSubscript
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "superscript":
 		var variant RichTextSuperscript
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "marked":
 		var variant RichTextMarked
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "code":
 		var variant RichTextCode
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "custom_emoji":
 		var variant RichTextCustomEmoji
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "mathematical_expression":
 		var variant RichTextMathematicalExpression
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "url":
 		var variant RichTextURL
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "email_address":
 		var variant RichTextEmailAddress
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "phone_number":
 		var variant RichTextPhoneNumber
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "bank_card_number":
 		var variant RichTextBankCardNumber
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "mention":
 		var variant RichTextMention
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "hashtag":
 		var variant RichTextHashtag
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "cashtag":
 		var variant RichTextCashtag
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "bot_command":
 		var variant RichTextBotCommand
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "anchor":
 		var variant RichTextAnchor
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "anchor_link":
 		var variant RichTextAnchorLink
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "reference":
 		var variant RichTextReference
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "reference_link":
 		var variant RichTextReferenceLink
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -13196,7 +17583,7 @@ type RichTextBold struct {
 
 func (o RichTextBold) MarshalJSON() ([]byte, error) {
 	type alias RichTextBold
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13211,7 +17598,7 @@ func (o *RichTextBold) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextBold(aux.alias)
@@ -13235,7 +17622,7 @@ type RichTextItalic struct {
 
 func (o RichTextItalic) MarshalJSON() ([]byte, error) {
 	type alias RichTextItalic
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13250,7 +17637,7 @@ func (o *RichTextItalic) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextItalic(aux.alias)
@@ -13274,7 +17661,7 @@ type RichTextUnderline struct {
 
 func (o RichTextUnderline) MarshalJSON() ([]byte, error) {
 	type alias RichTextUnderline
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13289,7 +17676,7 @@ func (o *RichTextUnderline) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextUnderline(aux.alias)
@@ -13313,7 +17700,7 @@ type RichTextStrikethrough struct {
 
 func (o RichTextStrikethrough) MarshalJSON() ([]byte, error) {
 	type alias RichTextStrikethrough
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13328,7 +17715,7 @@ func (o *RichTextStrikethrough) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextStrikethrough(aux.alias)
@@ -13352,7 +17739,7 @@ type RichTextSpoiler struct {
 
 func (o RichTextSpoiler) MarshalJSON() ([]byte, error) {
 	type alias RichTextSpoiler
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13367,7 +17754,7 @@ func (o *RichTextSpoiler) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextSpoiler(aux.alias)
@@ -13396,7 +17783,7 @@ type RichTextDateTime struct {
 
 func (o RichTextDateTime) MarshalJSON() ([]byte, error) {
 	type alias RichTextDateTime
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13411,7 +17798,7 @@ func (o *RichTextDateTime) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextDateTime(aux.alias)
@@ -13437,7 +17824,7 @@ type RichTextTextMention struct {
 
 func (o RichTextTextMention) MarshalJSON() ([]byte, error) {
 	type alias RichTextTextMention
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13452,7 +17839,7 @@ func (o *RichTextTextMention) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextTextMention(aux.alias)
@@ -13476,7 +17863,7 @@ type RichTextSubscript struct {
 
 func (o RichTextSubscript) MarshalJSON() ([]byte, error) {
 	type alias RichTextSubscript
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13491,7 +17878,7 @@ func (o *RichTextSubscript) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextSubscript(aux.alias)
@@ -13515,7 +17902,7 @@ type RichTextSuperscript struct {
 
 func (o RichTextSuperscript) MarshalJSON() ([]byte, error) {
 	type alias RichTextSuperscript
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13530,7 +17917,7 @@ func (o *RichTextSuperscript) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextSuperscript(aux.alias)
@@ -13554,7 +17941,7 @@ type RichTextMarked struct {
 
 func (o RichTextMarked) MarshalJSON() ([]byte, error) {
 	type alias RichTextMarked
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13569,7 +17956,7 @@ func (o *RichTextMarked) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextMarked(aux.alias)
@@ -13593,7 +17980,7 @@ type RichTextCode struct {
 
 func (o RichTextCode) MarshalJSON() ([]byte, error) {
 	type alias RichTextCode
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13608,7 +17995,7 @@ func (o *RichTextCode) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextCode(aux.alias)
@@ -13635,7 +18022,7 @@ type RichTextCustomEmoji struct {
 
 func (o RichTextCustomEmoji) MarshalJSON() ([]byte, error) {
 	type alias RichTextCustomEmoji
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13654,7 +18041,7 @@ type RichTextMathematicalExpression struct {
 
 func (o RichTextMathematicalExpression) MarshalJSON() ([]byte, error) {
 	type alias RichTextMathematicalExpression
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13675,7 +18062,7 @@ type RichTextURL struct {
 
 func (o RichTextURL) MarshalJSON() ([]byte, error) {
 	type alias RichTextURL
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13690,7 +18077,7 @@ func (o *RichTextURL) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextURL(aux.alias)
@@ -13716,7 +18103,7 @@ type RichTextEmailAddress struct {
 
 func (o RichTextEmailAddress) MarshalJSON() ([]byte, error) {
 	type alias RichTextEmailAddress
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13731,7 +18118,7 @@ func (o *RichTextEmailAddress) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextEmailAddress(aux.alias)
@@ -13757,7 +18144,7 @@ type RichTextPhoneNumber struct {
 
 func (o RichTextPhoneNumber) MarshalJSON() ([]byte, error) {
 	type alias RichTextPhoneNumber
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13772,7 +18159,7 @@ func (o *RichTextPhoneNumber) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextPhoneNumber(aux.alias)
@@ -13798,7 +18185,7 @@ type RichTextBankCardNumber struct {
 
 func (o RichTextBankCardNumber) MarshalJSON() ([]byte, error) {
 	type alias RichTextBankCardNumber
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13813,7 +18200,7 @@ func (o *RichTextBankCardNumber) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextBankCardNumber(aux.alias)
@@ -13839,7 +18226,7 @@ type RichTextMention struct {
 
 func (o RichTextMention) MarshalJSON() ([]byte, error) {
 	type alias RichTextMention
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13854,7 +18241,7 @@ func (o *RichTextMention) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextMention(aux.alias)
@@ -13880,7 +18267,7 @@ type RichTextHashtag struct {
 
 func (o RichTextHashtag) MarshalJSON() ([]byte, error) {
 	type alias RichTextHashtag
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13895,7 +18282,7 @@ func (o *RichTextHashtag) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextHashtag(aux.alias)
@@ -13921,7 +18308,7 @@ type RichTextCashtag struct {
 
 func (o RichTextCashtag) MarshalJSON() ([]byte, error) {
 	type alias RichTextCashtag
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13936,7 +18323,7 @@ func (o *RichTextCashtag) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextCashtag(aux.alias)
@@ -13962,7 +18349,7 @@ type RichTextBotCommand struct {
 
 func (o RichTextBotCommand) MarshalJSON() ([]byte, error) {
 	type alias RichTextBotCommand
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -13977,7 +18364,7 @@ func (o *RichTextBotCommand) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextBotCommand(aux.alias)
@@ -14001,7 +18388,7 @@ type RichTextAnchor struct {
 
 func (o RichTextAnchor) MarshalJSON() ([]byte, error) {
 	type alias RichTextAnchor
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -14023,7 +18410,7 @@ type RichTextAnchorLink struct {
 
 func (o RichTextAnchorLink) MarshalJSON() ([]byte, error) {
 	type alias RichTextAnchorLink
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -14038,7 +18425,7 @@ func (o *RichTextAnchorLink) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextAnchorLink(aux.alias)
@@ -14064,7 +18451,7 @@ type RichTextReference struct {
 
 func (o RichTextReference) MarshalJSON() ([]byte, error) {
 	type alias RichTextReference
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -14079,7 +18466,7 @@ func (o *RichTextReference) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextReference(aux.alias)
@@ -14105,7 +18492,7 @@ type RichTextReferenceLink struct {
 
 func (o RichTextReferenceLink) MarshalJSON() ([]byte, error) {
 	type alias RichTextReferenceLink
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -14120,7 +18507,7 @@ func (o *RichTextReferenceLink) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichTextReferenceLink(aux.alias)
@@ -14151,7 +18538,7 @@ func (o *RichBlockCaption) UnmarshalJSON(data []byte) error {
 		Credit json.RawMessage `json:"credit"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockCaption(aux.alias)
@@ -14198,7 +18585,7 @@ func (o *RichBlockTableCell) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockTableCell(aux.alias)
@@ -14238,7 +18625,7 @@ func (o *RichBlockListItem) UnmarshalJSON(data []byte) error {
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockListItem(aux.alias)
@@ -14289,133 +18676,133 @@ func unmarshalRichBlock(data []byte) (RichBlock, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "paragraph":
 		var variant RichBlockParagraph
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "heading":
 		var variant RichBlockSectionHeading
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "pre":
 		var variant RichBlockPreformatted
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "footer":
 		var variant RichBlockFooter
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "divider":
 		var variant RichBlockDivider
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "mathematical_expression":
 		var variant RichBlockMathematicalExpression
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "anchor":
 		var variant RichBlockAnchor
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "list":
 		var variant RichBlockList
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "blockquote":
 		var variant RichBlockBlockQuotation
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "pullquote":
 		var variant RichBlockPullQuotation
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "collage":
 		var variant RichBlockCollage
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "slideshow":
 		var variant RichBlockSlideshow
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "table":
 		var variant RichBlockTable
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "details":
 		var variant RichBlockDetails
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "map":
 		var variant RichBlockMap
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "animation":
 		var variant RichBlockAnimation
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "audio":
 		var variant RichBlockAudio
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "photo":
 		var variant RichBlockPhoto
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "video":
 		var variant RichBlockVideo
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "voice_note":
 		var variant RichBlockVoiceNote
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "thinking":
 		var variant RichBlockThinking
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -14438,7 +18825,7 @@ func (o *RichBlockParagraph) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockParagraph(aux.alias)
@@ -14469,7 +18856,7 @@ func (o *RichBlockSectionHeading) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockSectionHeading(aux.alias)
@@ -14500,7 +18887,7 @@ func (o *RichBlockPreformatted) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockPreformatted(aux.alias)
@@ -14528,7 +18915,7 @@ func (o *RichBlockFooter) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockFooter(aux.alias)
@@ -14592,7 +18979,7 @@ func (o *RichBlockBlockQuotation) UnmarshalJSON(data []byte) error {
 		Credit json.RawMessage `json:"credit"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockBlockQuotation(aux.alias)
@@ -14635,7 +19022,7 @@ func (o *RichBlockPullQuotation) UnmarshalJSON(data []byte) error {
 		Credit json.RawMessage `json:"credit"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockPullQuotation(aux.alias)
@@ -14672,7 +19059,7 @@ func (o *RichBlockCollage) UnmarshalJSON(data []byte) error {
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockCollage(aux.alias)
@@ -14706,7 +19093,7 @@ func (o *RichBlockSlideshow) UnmarshalJSON(data []byte) error {
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockSlideshow(aux.alias)
@@ -14744,7 +19131,7 @@ func (o *RichBlockTable) UnmarshalJSON(data []byte) error {
 		Caption json.RawMessage `json:"caption"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockTable(aux.alias)
@@ -14778,7 +19165,7 @@ func (o *RichBlockDetails) UnmarshalJSON(data []byte) error {
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockDetails(aux.alias)
@@ -14893,7 +19280,7 @@ func (o *RichBlockThinking) UnmarshalJSON(data []byte) error {
 		Text json.RawMessage `json:"text"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = RichBlockThinking(aux.alias)
@@ -14935,7 +19322,7 @@ func (o InputRichBlockListItem) resolve(sink *fileSink) (json.RawMessage, error)
 		blocks[i] = data
 	}
 	type alias InputRichBlockListItem
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
 	}{
@@ -14986,7 +19373,7 @@ type InputRichBlockParagraph struct {
 
 func (o InputRichBlockParagraph) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockParagraph
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -14996,7 +19383,7 @@ func (o InputRichBlockParagraph) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockParagraph) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A section heading, corresponding to the HTML tags <h1>, <h2>, <h3>, <h4>,
@@ -15012,7 +19399,7 @@ type InputRichBlockSectionHeading struct {
 
 func (o InputRichBlockSectionHeading) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockSectionHeading
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15022,7 +19409,7 @@ func (o InputRichBlockSectionHeading) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockSectionHeading) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A preformatted text block, corresponding to the nested HTML tags <pre> and
@@ -15038,7 +19425,7 @@ type InputRichBlockPreformatted struct {
 
 func (o InputRichBlockPreformatted) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockPreformatted
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15048,7 +19435,7 @@ func (o InputRichBlockPreformatted) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockPreformatted) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A footer, corresponding to the HTML tag <footer>.
@@ -15061,7 +19448,7 @@ type InputRichBlockFooter struct {
 
 func (o InputRichBlockFooter) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockFooter
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15071,7 +19458,7 @@ func (o InputRichBlockFooter) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockFooter) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A divider, corresponding to the HTML tag <hr/>.
@@ -15082,7 +19469,7 @@ type InputRichBlockDivider struct {
 
 func (o InputRichBlockDivider) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockDivider
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15092,7 +19479,7 @@ func (o InputRichBlockDivider) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockDivider) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A block with a mathematical expression in LaTeX format, corresponding to the
@@ -15106,7 +19493,7 @@ type InputRichBlockMathematicalExpression struct {
 
 func (o InputRichBlockMathematicalExpression) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockMathematicalExpression
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15116,7 +19503,7 @@ func (o InputRichBlockMathematicalExpression) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockMathematicalExpression) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A block with an anchor, corresponding to the HTML tag <a> with the attribute
@@ -15130,7 +19517,7 @@ type InputRichBlockAnchor struct {
 
 func (o InputRichBlockAnchor) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockAnchor
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15140,7 +19527,7 @@ func (o InputRichBlockAnchor) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockAnchor) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A list of blocks, corresponding to the HTML tag <ul> or <ol> with multiple
@@ -15154,7 +19541,7 @@ type InputRichBlockList struct {
 
 func (o InputRichBlockList) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockList
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15173,7 +19560,7 @@ func (o InputRichBlockList) resolve(sink *fileSink) (json.RawMessage, error) {
 		items[i] = data
 	}
 	type alias InputRichBlockList
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Items []json.RawMessage `json:"items"`
 		alias
@@ -15196,7 +19583,7 @@ type InputRichBlockBlockQuotation struct {
 
 func (o InputRichBlockBlockQuotation) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockBlockQuotation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15215,7 +19602,7 @@ func (o InputRichBlockBlockQuotation) resolve(sink *fileSink) (json.RawMessage,
 		blocks[i] = data
 	}
 	type alias InputRichBlockBlockQuotation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
@@ -15239,7 +19626,7 @@ type InputRichBlockPullQuotation struct {
 
 func (o InputRichBlockPullQuotation) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockPullQuotation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15249,7 +19636,7 @@ func (o InputRichBlockPullQuotation) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockPullQuotation) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A collage, corresponding to the custom HTML tag <tg-collage>.
@@ -15264,7 +19651,7 @@ type InputRichBlockCollage struct {
 
 func (o InputRichBlockCollage) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockCollage
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15283,7 +19670,7 @@ func (o InputRichBlockCollage) resolve(sink *fileSink) (json.RawMessage, error)
 		blocks[i] = data
 	}
 	type alias InputRichBlockCollage
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
@@ -15306,7 +19693,7 @@ type InputRichBlockSlideshow struct {
 
 func (o InputRichBlockSlideshow) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockSlideshow
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15325,7 +19712,7 @@ func (o InputRichBlockSlideshow) resolve(sink *fileSink) (json.RawMessage, error
 		blocks[i] = data
 	}
 	type alias InputRichBlockSlideshow
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
@@ -15352,7 +19739,7 @@ type InputRichBlockTable struct {
 
 func (o InputRichBlockTable) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockTable
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15362,7 +19749,7 @@ func (o InputRichBlockTable) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockTable) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // An expandable block for details disclosure, corresponding to the HTML tag
@@ -15380,7 +19767,7 @@ type InputRichBlockDetails struct {
 
 func (o InputRichBlockDetails) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockDetails
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15399,7 +19786,7 @@ func (o InputRichBlockDetails) resolve(sink *fileSink) (json.RawMessage, error)
 		blocks[i] = data
 	}
 	type alias InputRichBlockDetails
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Blocks []json.RawMessage `json:"blocks"`
 		alias
@@ -15430,7 +19817,7 @@ type InputRichBlockMap struct {
 
 func (o InputRichBlockMap) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockMap
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15440,7 +19827,7 @@ func (o InputRichBlockMap) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockMap) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // A block with an animation, corresponding to the HTML tag <video>.
@@ -15455,7 +19842,7 @@ type InputRichBlockAnimation struct {
 
 func (o InputRichBlockAnimation) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockAnimation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15470,7 +19857,7 @@ func (o InputRichBlockAnimation) resolve(sink *fileSink) (json.RawMessage, error
 		return nil, err
 	}
 	type alias InputRichBlockAnimation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Animation json.RawMessage `json:"animation"`
 		alias
@@ -15493,7 +19880,7 @@ type InputRichBlockAudio struct {
 
 func (o InputRichBlockAudio) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15508,7 +19895,7 @@ func (o InputRichBlockAudio) resolve(sink *fileSink) (json.RawMessage, error) {
 		return nil, err
 	}
 	type alias InputRichBlockAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Audio json.RawMessage `json:"audio"`
 		alias
@@ -15531,7 +19918,7 @@ type InputRichBlockPhoto struct {
 
 func (o InputRichBlockPhoto) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15546,7 +19933,7 @@ func (o InputRichBlockPhoto) resolve(sink *fileSink) (json.RawMessage, error) {
 		return nil, err
 	}
 	type alias InputRichBlockPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Photo json.RawMessage `json:"photo"`
 		alias
@@ -15569,7 +19956,7 @@ type InputRichBlockVideo struct {
 
 func (o InputRichBlockVideo) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15584,7 +19971,7 @@ func (o InputRichBlockVideo) resolve(sink *fileSink) (json.RawMessage, error) {
 		return nil, err
 	}
 	type alias InputRichBlockVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		Video json.RawMessage `json:"video"`
 		alias
@@ -15607,7 +19994,7 @@ type InputRichBlockVoiceNote struct {
 
 func (o InputRichBlockVoiceNote) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockVoiceNote
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15622,7 +20009,7 @@ func (o InputRichBlockVoiceNote) resolve(sink *fileSink) (json.RawMessage, error
 		return nil, err
 	}
 	type alias InputRichBlockVoiceNote
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		VoiceNote json.RawMessage `json:"voice_note"`
 		alias
@@ -15647,7 +20034,7 @@ type InputRichBlockThinking struct {
 
 func (o InputRichBlockThinking) MarshalJSON() ([]byte, error) {
 	type alias InputRichBlockThinking
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15657,7 +20044,7 @@ func (o InputRichBlockThinking) MarshalJSON() ([]byte, error) {
 }
 
 func (o InputRichBlockThinking) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // This object represents an incoming inline query. When the user sends an empty
@@ -15710,6 +20097,36 @@ type AnswerInlineQueryMethod struct {
 	Button *InlineQueryResultsButton `json:"button,omitempty"`
 }
 
+// NewAnswerInlineQueryMethod creates the request of answerInlineQuery from the parameters it
+// requires, leaving every optional one unset.
+func NewAnswerInlineQueryMethod(inlineQueryID string, results []InlineQueryResult) AnswerInlineQueryMethod {
+	return AnswerInlineQueryMethod{InlineQueryID: inlineQueryID, Results: results}
+}
+
+// WithCacheTime returns a copy of m with CacheTime set to cacheTime.
+func (m AnswerInlineQueryMethod) WithCacheTime(cacheTime int64) AnswerInlineQueryMethod {
+	m.CacheTime = &cacheTime
+	return m
+}
+
+// WithIsPersonal returns a copy of m with IsPersonal set to isPersonal.
+func (m AnswerInlineQueryMethod) WithIsPersonal(isPersonal bool) AnswerInlineQueryMethod {
+	m.IsPersonal = &isPersonal
+	return m
+}
+
+// WithNextOffset returns a copy of m with NextOffset set to nextOffset.
+func (m AnswerInlineQueryMethod) WithNextOffset(nextOffset string) AnswerInlineQueryMethod {
+	m.NextOffset = &nextOffset
+	return m
+}
+
+// WithButton returns a copy of m with Button set to button.
+func (m AnswerInlineQueryMethod) WithButton(button InlineQueryResultsButton) AnswerInlineQueryMethod {
+	m.Button = &button
+	return m
+}
+
 func (m AnswerInlineQueryMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -15823,7 +20240,7 @@ type InlineQueryResultArticle struct {
 
 func (o InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultArticle
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15838,7 +20255,7 @@ func (o InlineQueryResultArticle) resolve(sink *fileSink) (json.RawMessage, erro
 		return nil, err
 	}
 	type alias InlineQueryResultArticle
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content"`
 		alias
@@ -15888,7 +20305,7 @@ type InlineQueryResultPhoto struct {
 
 func (o InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15907,7 +20324,7 @@ func (o InlineQueryResultPhoto) resolve(sink *fileSink) (json.RawMessage, error)
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -15960,7 +20377,7 @@ type InlineQueryResultGif struct {
 
 func (o InlineQueryResultGif) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultGif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -15979,7 +20396,7 @@ func (o InlineQueryResultGif) resolve(sink *fileSink) (json.RawMessage, error) {
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultGif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16033,7 +20450,7 @@ type InlineQueryResultMpeg4Gif struct {
 
 func (o InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultMpeg4Gif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16052,7 +20469,7 @@ func (o InlineQueryResultMpeg4Gif) resolve(sink *fileSink) (json.RawMessage, err
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultMpeg4Gif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16111,7 +20528,7 @@ type InlineQueryResultVideo struct {
 
 func (o InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16130,7 +20547,7 @@ func (o InlineQueryResultVideo) resolve(sink *fileSink) (json.RawMessage, error)
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16173,7 +20590,7 @@ type InlineQueryResultAudio struct {
 
 func (o InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16192,7 +20609,7 @@ func (o InlineQueryResultAudio) resolve(sink *fileSink) (json.RawMessage, error)
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16234,7 +20651,7 @@ type InlineQueryResultVoice struct {
 
 func (o InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultVoice
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16253,7 +20670,7 @@ func (o InlineQueryResultVoice) resolve(sink *fileSink) (json.RawMessage, error)
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultVoice
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16304,7 +20721,7 @@ type InlineQueryResultDocument struct {
 
 func (o InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultDocument
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16323,7 +20740,7 @@ func (o InlineQueryResultDocument) resolve(sink *fileSink) (json.RawMessage, err
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultDocument
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16374,7 +20791,7 @@ type InlineQueryResultLocation struct {
 
 func (o InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultLocation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16393,7 +20810,7 @@ func (o InlineQueryResultLocation) resolve(sink *fileSink) (json.RawMessage, err
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultLocation
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16444,7 +20861,7 @@ type InlineQueryResultVenue struct {
 
 func (o InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultVenue
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16463,7 +20880,7 @@ func (o InlineQueryResultVenue) resolve(sink *fileSink) (json.RawMessage, error)
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultVenue
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16504,7 +20921,7 @@ type InlineQueryResultContact struct {
 
 func (o InlineQueryResultContact) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultContact
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16523,7 +20940,7 @@ func (o InlineQueryResultContact) resolve(sink *fileSink) (json.RawMessage, erro
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultContact
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16548,7 +20965,7 @@ type InlineQueryResultGame struct {
 
 func (o InlineQueryResultGame) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultGame
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16558,7 +20975,7 @@ func (o InlineQueryResultGame) MarshalJSON() ([]byte, error) {
 }
 
 func (o InlineQueryResultGame) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents a link to a photo stored on the Telegram servers. By default, this
@@ -16594,7 +21011,7 @@ type InlineQueryResultCachedPhoto struct {
 
 func (o InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16613,7 +21030,7 @@ func (o InlineQueryResultCachedPhoto) resolve(sink *fileSink) (json.RawMessage,
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedPhoto
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16655,7 +21072,7 @@ type InlineQueryResultCachedGif struct {
 
 func (o InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedGif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16674,7 +21091,7 @@ func (o InlineQueryResultCachedGif) resolve(sink *fileSink) (json.RawMessage, er
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedGif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16718,7 +21135,7 @@ type InlineQueryResultCachedMpeg4Gif struct {
 
 func (o InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedMpeg4Gif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16737,7 +21154,7 @@ func (o InlineQueryResultCachedMpeg4Gif) resolve(sink *fileSink) (json.RawMessag
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedMpeg4Gif
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16767,7 +21184,7 @@ type InlineQueryResultCachedSticker struct {
 
 func (o InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedSticker
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16786,7 +21203,7 @@ func (o InlineQueryResultCachedSticker) resolve(sink *fileSink) (json.RawMessage
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedSticker
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16828,7 +21245,7 @@ type InlineQueryResultCachedDocument struct {
 
 func (o InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedDocument
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16847,7 +21264,7 @@ func (o InlineQueryResultCachedDocument) resolve(sink *fileSink) (json.RawMessag
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedDocument
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16891,7 +21308,7 @@ type InlineQueryResultCachedVideo struct {
 
 func (o InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16910,7 +21327,7 @@ func (o InlineQueryResultCachedVideo) resolve(sink *fileSink) (json.RawMessage,
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedVideo
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -16950,7 +21367,7 @@ type InlineQueryResultCachedVoice struct {
 
 func (o InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedVoice
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -16969,7 +21386,7 @@ func (o InlineQueryResultCachedVoice) resolve(sink *fileSink) (json.RawMessage,
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedVoice
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -17007,7 +21424,7 @@ type InlineQueryResultCachedAudio struct {
 
 func (o InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
 	type alias InlineQueryResultCachedAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		alias
 	}{
@@ -17026,7 +21443,7 @@ func (o InlineQueryResultCachedAudio) resolve(sink *fileSink) (json.RawMessage,
 		inputMessageContent = data
 	}
 	type alias InlineQueryResultCachedAudio
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Type string `json:"type"`
 		InputMessageContent json.RawMessage `json:"input_message_content,omitempty"`
 		alias
@@ -17072,7 +21489,7 @@ type InputTextMessageContent struct {
 }
 
 func (o InputTextMessageContent) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents the content of a rich message to be sent as the result of an
@@ -17090,7 +21507,7 @@ func (o InputRichMessageContent) resolve(sink *fileSink) (json.RawMessage, error
 		return nil, err
 	}
 	type alias InputRichMessageContent
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		RichMessage json.RawMessage `json:"rich_message"`
 		alias
 	}{
@@ -17123,7 +21540,7 @@ type InputLocationMessageContent struct {
 }
 
 func (o InputLocationMessageContent) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents the content of a venue message to be sent as the result of an
@@ -17152,7 +21569,7 @@ type InputVenueMessageContent struct {
 }
 
 func (o InputVenueMessageContent) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents the content of a contact message to be sent as the result of an
@@ -17171,7 +21588,7 @@ type InputContactMessageContent struct {
 }
 
 func (o InputContactMessageContent) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents the content of an invoice message to be sent as the result of an
@@ -17244,7 +21661,7 @@ type InputInvoiceMessageContent struct {
 }
 
 func (o InputInvoiceMessageContent) resolve(_ *fileSink) (json.RawMessage, error) {
-	return json.Marshal(o)
+	return codec.Marshal(o)
 }
 
 // Represents a result of an inline query that was chosen by the user and sent
@@ -17374,6 +21791,162 @@ type SendInvoiceMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendInvoiceMethod creates the request of sendInvoice from the parameters it
+// requires, leaving every optional one unset.
+func NewSendInvoiceMethod(chatID ChatID, title string, description string, payload string, currency string, prices []LabeledPrice) SendInvoiceMethod {
+	return SendInvoiceMethod{ChatID: chatID, Title: title, Description: description, Payload: payload, Currency: currency, Prices: prices}
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendInvoiceMethod) WithMessageThreadID(messageThreadID int64) SendInvoiceMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDirectMessagesTopicID returns a copy of m with DirectMessagesTopicID set to directMessagesTopicID.
+func (m SendInvoiceMethod) WithDirectMessagesTopicID(directMessagesTopicID int64) SendInvoiceMethod {
+	m.DirectMessagesTopicID = &directMessagesTopicID
+	return m
+}
+
+// WithProviderToken returns a copy of m with ProviderToken set to providerToken.
+func (m SendInvoiceMethod) WithProviderToken(providerToken string) SendInvoiceMethod {
+	m.ProviderToken = &providerToken
+	return m
+}
+
+// WithMaxTipAmount returns a copy of m with MaxTipAmount set to maxTipAmount.
+func (m SendInvoiceMethod) WithMaxTipAmount(maxTipAmount int64) SendInvoiceMethod {
+	m.MaxTipAmount = &maxTipAmount
+	return m
+}
+
+// WithSuggestedTipAmounts returns a copy of m with SuggestedTipAmounts set to suggestedTipAmounts.
+func (m SendInvoiceMethod) WithSuggestedTipAmounts(suggestedTipAmounts []int64) SendInvoiceMethod {
+	m.SuggestedTipAmounts = suggestedTipAmounts
+	return m
+}
+
+// WithStartParameter returns a copy of m with StartParameter set to startParameter.
+func (m SendInvoiceMethod) WithStartParameter(startParameter string) SendInvoiceMethod {
+	m.StartParameter = &startParameter
+	return m
+}
+
+// WithProviderData returns a copy of m with ProviderData set to providerData.
+func (m SendInvoiceMethod) WithProviderData(providerData string) SendInvoiceMethod {
+	m.ProviderData = &providerData
+	return m
+}
+
+// WithPhotoURL returns a copy of m with PhotoURL set to photoURL.
+func (m SendInvoiceMethod) WithPhotoURL(photoURL string) SendInvoiceMethod {
+	m.PhotoURL = &photoURL
+	return m
+}
+
+// WithPhotoSize returns a copy of m with PhotoSize set to photoSize.
+func (m SendInvoiceMethod) WithPhotoSize(photoSize int64) SendInvoiceMethod {
+	m.PhotoSize = &photoSize
+	return m
+}
+
+// WithPhotoWidth returns a copy of m with PhotoWidth set to photoWidth.
+func (m SendInvoiceMethod) WithPhotoWidth(photoWidth int64) SendInvoiceMethod {
+	m.PhotoWidth = &photoWidth
+	return m
+}
+
+// WithPhotoHeight returns a copy of m with PhotoHeight set to photoHeight.
+func (m SendInvoiceMethod) WithPhotoHeight(photoHeight int64) SendInvoiceMethod {
+	m.PhotoHeight = &photoHeight
+	return m
+}
+
+// WithNeedName returns a copy of m with NeedName set to needName.
+func (m SendInvoiceMethod) WithNeedName(needName bool) SendInvoiceMethod {
+	m.NeedName = &needName
+	return m
+}
+
+// WithNeedPhoneNumber returns a copy of m with NeedPhoneNumber set to needPhoneNumber.
+func (m SendInvoiceMethod) WithNeedPhoneNumber(needPhoneNumber bool) SendInvoiceMethod {
+	m.NeedPhoneNumber = &needPhoneNumber
+	return m
+}
+
+// WithNeedEmail returns a copy of m with NeedEmail set to needEmail.
+func (m SendInvoiceMethod) WithNeedEmail(needEmail bool) SendInvoiceMethod {
+	m.NeedEmail = &needEmail
+	return m
+}
+
+// WithNeedShippingAddress returns a copy of m with NeedShippingAddress set to needShippingAddress.
+func (m SendInvoiceMethod) WithNeedShippingAddress(needShippingAddress bool) SendInvoiceMethod {
+	m.NeedShippingAddress = &needShippingAddress
+	return m
+}
+
+// WithSendPhoneNumberToProvider returns a copy of m with SendPhoneNumberToProvider set to sendPhoneNumberToProvider.
+func (m SendInvoiceMethod) WithSendPhoneNumberToProvider(sendPhoneNumberToProvider bool) SendInvoiceMethod {
+	m.SendPhoneNumberToProvider = &sendPhoneNumberToProvider
+	return m
+}
+
+// WithSendEmailToProvider returns a copy of m with SendEmailToProvider set to sendEmailToProvider.
+func (m SendInvoiceMethod) WithSendEmailToProvider(sendEmailToProvider bool) SendInvoiceMethod {
+	m.SendEmailToProvider = &sendEmailToProvider
+	return m
+}
+
+// WithIsFlexible returns a copy of m with IsFlexible set to isFlexible.
+func (m SendInvoiceMethod) WithIsFlexible(isFlexible bool) SendInvoiceMethod {
+	m.IsFlexible = &isFlexible
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendInvoiceMethod) WithDisableNotification(disableNotification bool) SendInvoiceMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendInvoiceMethod) WithProtectContent(protectContent bool) SendInvoiceMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendInvoiceMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendInvoiceMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendInvoiceMethod) WithMessageEffectID(messageEffectID string) SendInvoiceMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithSuggestedPostParameters returns a copy of m with SuggestedPostParameters set to suggestedPostParameters.
+func (m SendInvoiceMethod) WithSuggestedPostParameters(suggestedPostParameters SuggestedPostParameters) SendInvoiceMethod {
+	m.SuggestedPostParameters = &suggestedPostParameters
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendInvoiceMethod) WithReplyParameters(replyParameters ReplyParameters) SendInvoiceMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendInvoiceMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) SendInvoiceMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m SendInvoiceMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -17469,6 +22042,114 @@ type CreateInvoiceLinkMethod struct {
 	IsFlexible *bool `json:"is_flexible,omitempty"`
 }
 
+// NewCreateInvoiceLinkMethod creates the request of createInvoiceLink from the parameters it
+// requires, leaving every optional one unset.
+func NewCreateInvoiceLinkMethod(title string, description string, payload string, currency string, prices []LabeledPrice) CreateInvoiceLinkMethod {
+	return CreateInvoiceLinkMethod{Title: title, Description: description, Payload: payload, Currency: currency, Prices: prices}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m CreateInvoiceLinkMethod) WithBusinessConnectionID(businessConnectionID string) CreateInvoiceLinkMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithProviderToken returns a copy of m with ProviderToken set to providerToken.
+func (m CreateInvoiceLinkMethod) WithProviderToken(providerToken string) CreateInvoiceLinkMethod {
+	m.ProviderToken = &providerToken
+	return m
+}
+
+// WithSubscriptionPeriod returns a copy of m with SubscriptionPeriod set to subscriptionPeriod.
+func (m CreateInvoiceLinkMethod) WithSubscriptionPeriod(subscriptionPeriod int64) CreateInvoiceLinkMethod {
+	m.SubscriptionPeriod = &subscriptionPeriod
+	return m
+}
+
+// WithMaxTipAmount returns a copy of m with MaxTipAmount set to maxTipAmount.
+func (m CreateInvoiceLinkMethod) WithMaxTipAmount(maxTipAmount int64) CreateInvoiceLinkMethod {
+	m.MaxTipAmount = &maxTipAmount
+	return m
+}
+
+// WithSuggestedTipAmounts returns a copy of m with SuggestedTipAmounts set to suggestedTipAmounts.
+func (m CreateInvoiceLinkMethod) WithSuggestedTipAmounts(suggestedTipAmounts []int64) CreateInvoiceLinkMethod {
+	m.SuggestedTipAmounts = suggestedTipAmounts
+	return m
+}
+
+// WithProviderData returns a copy of m with ProviderData set to providerData.
+func (m CreateInvoiceLinkMethod) WithProviderData(providerData string) CreateInvoiceLinkMethod {
+	m.ProviderData = &providerData
+	return m
+}
+
+// WithPhotoURL returns a copy of m with PhotoURL set to photoURL.
+func (m CreateInvoiceLinkMethod) WithPhotoURL(photoURL string) CreateInvoiceLinkMethod {
+	m.PhotoURL = &photoURL
+	return m
+}
+
+// WithPhotoSize returns a copy of m with PhotoSize set to photoSize.
+func (m CreateInvoiceLinkMethod) WithPhotoSize(photoSize int64) CreateInvoiceLinkMethod {
+	m.PhotoSize = &photoSize
+	return m
+}
+
+// WithPhotoWidth returns a copy of m with PhotoWidth set to photoWidth.
+func (m CreateInvoiceLinkMethod) WithPhotoWidth(photoWidth int64) CreateInvoiceLinkMethod {
+	m.PhotoWidth = &photoWidth
+	return m
+}
+
+// WithPhotoHeight returns a copy of m with PhotoHeight set to photoHeight.
+func (m CreateInvoiceLinkMethod) WithPhotoHeight(photoHeight int64) CreateInvoiceLinkMethod {
+	m.PhotoHeight = &photoHeight
+	return m
+}
+
+// WithNeedName returns a copy of m with NeedName set to needName.
+func (m CreateInvoiceLinkMethod) WithNeedName(needName bool) CreateInvoiceLinkMethod {
+	m.NeedName = &needName
+	return m
+}
+
+// WithNeedPhoneNumber returns a copy of m with NeedPhoneNumber set to needPhoneNumber.
+func (m CreateInvoiceLinkMethod) WithNeedPhoneNumber(needPhoneNumber bool) CreateInvoiceLinkMethod {
+	m.NeedPhoneNumber = &needPhoneNumber
+	return m
+}
+
+// WithNeedEmail returns a copy of m with NeedEmail set to needEmail.
+func (m CreateInvoiceLinkMethod) WithNeedEmail(needEmail bool) CreateInvoiceLinkMethod {
+	m.NeedEmail = &needEmail
+	return m
+}
+
+// WithNeedShippingAddress returns a copy of m with NeedShippingAddress set to needShippingAddress.
+func (m CreateInvoiceLinkMethod) WithNeedShippingAddress(needShippingAddress bool) CreateInvoiceLinkMethod {
+	m.NeedShippingAddress = &needShippingAddress
+	return m
+}
+
+// WithSendPhoneNumberToProvider returns a copy of m with SendPhoneNumberToProvider set to sendPhoneNumberToProvider.
+func (m CreateInvoiceLinkMethod) WithSendPhoneNumberToProvider(sendPhoneNumberToProvider bool) CreateInvoiceLinkMethod {
+	m.SendPhoneNumberToProvider = &sendPhoneNumberToProvider
+	return m
+}
+
+// WithSendEmailToProvider returns a copy of m with SendEmailToProvider set to sendEmailToProvider.
+func (m CreateInvoiceLinkMethod) WithSendEmailToProvider(sendEmailToProvider bool) CreateInvoiceLinkMethod {
+	m.SendEmailToProvider = &sendEmailToProvider
+	return m
+}
+
+// WithIsFlexible returns a copy of m with IsFlexible set to isFlexible.
+func (m CreateInvoiceLinkMethod) WithIsFlexible(isFlexible bool) CreateInvoiceLinkMethod {
+	m.IsFlexible = &isFlexible
+	return m
+}
+
 func (m CreateInvoiceLinkMethod) Call(ctx context.Context, conn Connection) (string, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -17508,6 +22189,24 @@ type AnswerShippingQueryMethod struct {
 	ErrorMessage *string `json:"error_message,omitempty"`
 }
 
+// NewAnswerShippingQueryMethod creates the request of answerShippingQuery from the parameters it
+// requires, leaving every optional one unset.
+func NewAnswerShippingQueryMethod(shippingQueryID string, ok bool) AnswerShippingQueryMethod {
+	return AnswerShippingQueryMethod{ShippingQueryID: shippingQueryID, Ok: ok}
+}
+
+// WithShippingOptions returns a copy of m with ShippingOptions set to shippingOptions.
+func (m AnswerShippingQueryMethod) WithShippingOptions(shippingOptions []ShippingOption) AnswerShippingQueryMethod {
+	m.ShippingOptions = shippingOptions
+	return m
+}
+
+// WithErrorMessage returns a copy of m with ErrorMessage set to errorMessage.
+func (m AnswerShippingQueryMethod) WithErrorMessage(errorMessage string) AnswerShippingQueryMethod {
+	m.ErrorMessage = &errorMessage
+	return m
+}
+
 func (m AnswerShippingQueryMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -17541,6 +22240,18 @@ type AnswerPreCheckoutQueryMethod struct {
 	ErrorMessage *string `json:"error_message,omitempty"`
 }
 
+// NewAnswerPreCheckoutQueryMethod creates the request of answerPreCheckoutQuery from the parameters it
+// requires, leaving every optional one unset.
+func NewAnswerPreCheckoutQueryMethod(preCheckoutQueryID string, ok bool) AnswerPreCheckoutQueryMethod {
+	return AnswerPreCheckoutQueryMethod{PreCheckoutQueryID: preCheckoutQueryID, Ok: ok}
+}
+
+// WithErrorMessage returns a copy of m with ErrorMessage set to errorMessage.
+func (m AnswerPreCheckoutQueryMethod) WithErrorMessage(errorMessage string) AnswerPreCheckoutQueryMethod {
+	m.ErrorMessage = &errorMessage
+	return m
+}
+
 func (m AnswerPreCheckoutQueryMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -17560,6 +22271,12 @@ func (m AnswerPreCheckoutQueryMethod) payload() (jsonPayload, error) {
 type GetMyStarBalanceMethod struct {
 }
 
+// NewGetMyStarBalanceMethod creates the request of getMyStarBalance from the parameters it
+// requires, leaving every optional one unset.
+func NewGetMyStarBalanceMethod() GetMyStarBalanceMethod {
+	return GetMyStarBalanceMethod{}
+}
+
 func (m GetMyStarBalanceMethod) Call(ctx context.Context, conn Connection) (StarAmount, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -17588,6 +22305,24 @@ type GetStarTransactionsMethod struct {
 	Limit *int64 `json:"limit,omitempty"`
 }
 
+// NewGetStarTransactionsMethod creates the request of getStarTransactions from the parameters it
+// requires, leaving every optional one unset.
+func NewGetStarTransactionsMethod() GetStarTransactionsMethod {
+	return GetStarTransactionsMethod{}
+}
+
+// WithOffset returns a copy of m with Offset set to offset.
+func (m GetStarTransactionsMethod) WithOffset(offset int64) GetStarTransactionsMethod {
+	m.Offset = &offset
+	return m
+}
+
+// WithLimit returns a copy of m with Limit set to limit.
+func (m GetStarTransactionsMethod) WithLimit(limit int64) GetStarTransactionsMethod {
+	m.Limit = &limit
+	return m
+}
+
 func (m GetStarTransactionsMethod) Call(ctx context.Context, conn Connection) (StarTransactions, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -17614,6 +22349,12 @@ type RefundStarPaymentMethod struct {
 	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
 }
 
+// NewRefundStarPaymentMethod creates the request of refundStarPayment from the parameters it
+// requires, leaving every optional one unset.
+func NewRefundStarPaymentMethod(userID int64, telegramPaymentChargeID string) RefundStarPaymentMethod {
+	return RefundStarPaymentMethod{UserID: userID, TelegramPaymentChargeID: telegramPaymentChargeID}
+}
+
 func (m RefundStarPaymentMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -17642,6 +22383,12 @@ type EditUserStarSubscriptionMethod struct {
 	IsCanceled bool `json:"is_canceled"`
 }
 
+// NewEditUserStarSubscriptionMethod creates the request of editUserStarSubscription from the parameters it
+// requires, leaving every optional one unset.
+func NewEditUserStarSubscriptionMethod(userID int64, telegramPaymentChargeID string, isCanceled bool) EditUserStarSubscriptionMethod {
+	return EditUserStarSubscriptionMethod{UserID: userID, TelegramPaymentChargeID: telegramPaymentChargeID, IsCanceled: isCanceled}
+}
+
 func (m EditUserStarSubscriptionMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -17845,25 +22592,25 @@ func unmarshalRevenueWithdrawalState(data []byte) (RevenueWithdrawalState, error
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "pending":
 		var variant RevenueWithdrawalStatePending
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "succeeded":
 		var variant RevenueWithdrawalStateSucceeded
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "failed":
 		var variant RevenueWithdrawalStateFailed
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -17934,49 +22681,49 @@ func unmarshalTransactionPartner(data []byte) (TransactionPartner, error) {
 	var mark struct {
 		Key string `json:"type"`
 	}
-	if err := json.Unmarshal(data, &mark); err != nil {
+	if err := codec.Unmarshal(data, &mark); err != nil {
 		return nil, err
 	}
 	switch mark.Key {
 	case "user":
 		var variant TransactionPartnerUser
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "chat":
 		var variant TransactionPartnerChat
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "affiliate_program":
 		var variant TransactionPartnerAffiliateProgram
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "fragment":
 		var variant TransactionPartnerFragment
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "telegram_ads":
 		var variant TransactionPartnerTelegramAds
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "telegram_api":
 		var variant TransactionPartnerTelegramAPI
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
 	case "other":
 		var variant TransactionPartnerOther
-		if err := json.Unmarshal(data, &variant); err != nil {
+		if err := codec.Unmarshal(data, &variant); err != nil {
 			return nil, err
 		}
 		return variant, nil
@@ -18026,7 +22773,7 @@ func (o *TransactionPartnerUser) UnmarshalJSON(data []byte) error {
 		PaidMedia []json.RawMessage `json:"paid_media"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = TransactionPartnerUser(aux.alias)
@@ -18080,7 +22827,7 @@ func (o *TransactionPartnerFragment) UnmarshalJSON(data []byte) error {
 		WithdrawalState json.RawMessage `json:"withdrawal_state"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = TransactionPartnerFragment(aux.alias)
@@ -18149,7 +22896,7 @@ func (o *StarTransaction) UnmarshalJSON(data []byte) error {
 		Receiver json.RawMessage `json:"receiver"`
 		alias
 	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := codec.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 	*o = StarTransaction(aux.alias)
@@ -18291,6 +23038,12 @@ type SetPassportDataErrorsMethod struct {
 	Errors []PassportElementError `json:"errors"`
 }
 
+// NewSetPassportDataErrorsMethod creates the request of setPassportDataErrors from the parameters it
+// requires, leaving every optional one unset.
+func NewSetPassportDataErrorsMethod(userID int64, errors []PassportElementError) SetPassportDataErrorsMethod {
+	return SetPassportDataErrorsMethod{UserID: userID, Errors: errors}
+}
+
 func (m SetPassportDataErrorsMethod) Call(ctx context.Context, conn Connection) error {
 	payload, err := m.payload()
 	if err != nil {
@@ -18339,7 +23092,7 @@ type PassportElementErrorDataField struct {
 
 func (o PassportElementErrorDataField) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorDataField
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18365,7 +23118,7 @@ type PassportElementErrorFrontSide struct {
 
 func (o PassportElementErrorFrontSide) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorFrontSide
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18390,7 +23143,7 @@ type PassportElementErrorReverseSide struct {
 
 func (o PassportElementErrorReverseSide) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorReverseSide
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18415,7 +23168,7 @@ type PassportElementErrorSelfie struct {
 
 func (o PassportElementErrorSelfie) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorSelfie
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18441,7 +23194,7 @@ type PassportElementErrorFile struct {
 
 func (o PassportElementErrorFile) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorFile
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18467,7 +23220,7 @@ type PassportElementErrorFiles struct {
 
 func (o PassportElementErrorFiles) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorFiles
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18494,7 +23247,7 @@ type PassportElementErrorTranslationFile struct {
 
 func (o PassportElementErrorTranslationFile) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorTranslationFile
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18521,7 +23274,7 @@ type PassportElementErrorTranslationFiles struct {
 
 func (o PassportElementErrorTranslationFiles) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorTranslationFiles
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18545,7 +23298,7 @@ type PassportElementErrorUnspecified struct {
 
 func (o PassportElementErrorUnspecified) MarshalJSON() ([]byte, error) {
 	type alias PassportElementErrorUnspecified
-	return json.Marshal(struct {
+	return codec.Marshal(struct {
 		Source string `json:"source"`
 		alias
 	}{
@@ -18590,6 +23343,60 @@ type SendGameMethod struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewSendGameMethod creates the request of sendGame from the parameters it
+// requires, leaving every optional one unset.
+func NewSendGameMethod(chatID ChatID, gameShortName string) SendGameMethod {
+	return SendGameMethod{ChatID: chatID, GameShortName: gameShortName}
+}
+
+// WithBusinessConnectionID returns a copy of m with BusinessConnectionID set to businessConnectionID.
+func (m SendGameMethod) WithBusinessConnectionID(businessConnectionID string) SendGameMethod {
+	m.BusinessConnectionID = &businessConnectionID
+	return m
+}
+
+// WithMessageThreadID returns a copy of m with MessageThreadID set to messageThreadID.
+func (m SendGameMethod) WithMessageThreadID(messageThreadID int64) SendGameMethod {
+	m.MessageThreadID = &messageThreadID
+	return m
+}
+
+// WithDisableNotification returns a copy of m with DisableNotification set to disableNotification.
+func (m SendGameMethod) WithDisableNotification(disableNotification bool) SendGameMethod {
+	m.DisableNotification = &disableNotification
+	return m
+}
+
+// WithProtectContent returns a copy of m with ProtectContent set to protectContent.
+func (m SendGameMethod) WithProtectContent(protectContent bool) SendGameMethod {
+	m.ProtectContent = &protectContent
+	return m
+}
+
+// WithAllowPaidBroadcast returns a copy of m with AllowPaidBroadcast set to allowPaidBroadcast.
+func (m SendGameMethod) WithAllowPaidBroadcast(allowPaidBroadcast bool) SendGameMethod {
+	m.AllowPaidBroadcast = &allowPaidBroadcast
+	return m
+}
+
+// WithMessageEffectID returns a copy of m with MessageEffectID set to messageEffectID.
+func (m SendGameMethod) WithMessageEffectID(messageEffectID string) SendGameMethod {
+	m.MessageEffectID = &messageEffectID
+	return m
+}
+
+// WithReplyParameters returns a copy of m with ReplyParameters set to replyParameters.
+func (m SendGameMethod) WithReplyParameters(replyParameters ReplyParameters) SendGameMethod {
+	m.ReplyParameters = &replyParameters
+	return m
+}
+
+// WithReplyMarkup returns a copy of m with ReplyMarkup set to replyMarkup.
+func (m SendGameMethod) WithReplyMarkup(replyMarkup InlineKeyboardMarkup) SendGameMethod {
+	m.ReplyMarkup = &replyMarkup
+	return m
+}
+
 func (m SendGameMethod) Call(ctx context.Context, conn Connection) (Message, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -18665,6 +23472,42 @@ type SetGameScoreMethod struct {
 	InlineMessageID *string `json:"inline_message_id,omitempty"`
 }
 
+// NewSetGameScoreMethod creates the request of setGameScore from the parameters it
+// requires, leaving every optional one unset.
+func NewSetGameScoreMethod(userID int64, score int64) SetGameScoreMethod {
+	return SetGameScoreMethod{UserID: userID, Score: score}
+}
+
+// WithForce returns a copy of m with Force set to force.
+func (m SetGameScoreMethod) WithForce(force bool) SetGameScoreMethod {
+	m.Force = &force
+	return m
+}
+
+// WithDisableEditMessage returns a copy of m with DisableEditMessage set to disableEditMessage.
+func (m SetGameScoreMethod) WithDisableEditMessage(disableEditMessage bool) SetGameScoreMethod {
+	m.DisableEditMessage = &disableEditMessage
+	return m
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m SetGameScoreMethod) WithChatID(chatID int64) SetGameScoreMethod {
+	m.ChatID = &chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m SetGameScoreMethod) WithMessageID(messageID int64) SetGameScoreMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m SetGameScoreMethod) WithInlineMessageID(inlineMessageID string) SetGameScoreMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
 func (m SetGameScoreMethod) Call(ctx context.Context, conn Connection) (MaybeMessage, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -18705,6 +23548,30 @@ type GetGameHighScoresMethod struct {
 	InlineMessageID *string `json:"inline_message_id,omitempty"`
 }
 
+// NewGetGameHighScoresMethod creates the request of getGameHighScores from the parameters it
+// requires, leaving every optional one unset.
+func NewGetGameHighScoresMethod(userID int64) GetGameHighScoresMethod {
+	return GetGameHighScoresMethod{UserID: userID}
+}
+
+// WithChatID returns a copy of m with ChatID set to chatID.
+func (m GetGameHighScoresMethod) WithChatID(chatID int64) GetGameHighScoresMethod {
+	m.ChatID = &chatID
+	return m
+}
+
+// WithMessageID returns a copy of m with MessageID set to messageID.
+func (m GetGameHighScoresMethod) WithMessageID(messageID int64) GetGameHighScoresMethod {
+	m.MessageID = &messageID
+	return m
+}
+
+// WithInlineMessageID returns a copy of m with InlineMessageID set to inlineMessageID.
+func (m GetGameHighScoresMethod) WithInlineMessageID(inlineMessageID string) GetGameHighScoresMethod {
+	m.InlineMessageID = &inlineMessageID
+	return m
+}
+
 func (m GetGameHighScoresMethod) Call(ctx context.Context, conn Connection) ([]GameHighScore, error) {
 	payload, err := m.payload()
 	if err != nil {
@@ -18837,11 +23704,11 @@ func (True) sealedMaybeMessage() {}
 
 func unmarshalMaybeMessage(data []byte) (MaybeMessage, error) {
 	var message Message
-	if json.Unmarshal(data, &message) == nil {
+	if codec.Unmarshal(data, &message) == nil {
 		return message, nil
 	}
 	var marker True
-	if json.Unmarshal(data, &marker) == nil {
+	if codec.Unmarshal(data, &marker) == nil {
 		return marker, nil
 	}
 	return nil, fmt.Errorf("cannot unmarshal %s into MaybeMessage", data)
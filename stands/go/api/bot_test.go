@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+// greeter is a service depending on the API through BotAPI alone.
+type greeter struct {
+	bot api.BotAPI
+}
+
+func (g greeter) greet(ctx context.Context, chat int64) error {
+	me, err := g.bot.GetMe(ctx, api.GetMeMethod{})
+	if err != nil {
+		return err
+	}
+	_, err = g.bot.SendMessage(ctx, api.SendMessageMethod{ChatID: api.ID(chat), Text: "I am " + me.FirstName})
+	return err
+}
+
+func TestBot_callsTheMethodOverItsConnection(t *testing.T) {
+	bot := api.NewBot(api.NewFakeConnection(
+		api.NewCall("getMe", api.Ok(api.User{ID: 7, FirstName: "Tester"})),
+		api.NewCall("sendMessage", api.Ok(api.Message{MessageID: 1})),
+	))
+
+	err := greeter{bot: bot}.greet(context.Background(), 42)
+
+	require.NoError(t, err, "a bot must call every method through the connection it holds")
+}
+
+func TestBot_returnsTheErrorTheMethodFailedWith(t *testing.T) {
+	bot := api.NewBot(api.NewFakeConnection(
+		api.NewCall("getMe", api.Err(&api.Error{Code: 401, Description: "Unauthorized"})),
+	))
+
+	_, err := bot.GetMe(context.Background(), api.GetMeMethod{})
+
+	var refusal *api.Error
+	require.ErrorAs(t, err, &refusal, "a bot must hand back the error the API answered with")
+	assert.Equal(t, int64(401), refusal.Code, "a bot must hand back the error unchanged")
+}
+
+func TestMockBot(t *testing.T) {
+	mock := &api.MockBot{
+		GetMeFunc: func(context.Context, api.GetMeMethod) (api.User, error) {
+			return api.User{ID: 7, FirstName: "Tester"}, nil
+		},
+		SendMessageFunc: func(context.Context, api.SendMessageMethod) (api.Message, error) {
+			return api.Message{MessageID: 1}, nil
+		},
+	}
+
+	err := greeter{bot: mock}.greet(context.Background(), 42)
+
+	require.NoError(t, err, "a mock must answer through the functions it was given")
+	calls := mock.Calls()
+	require.Len(t, calls, 2, "a mock must record every call it received")
+	assert.Equal(t, api.Method("getMe"), calls[0].Method, "a mock must record calls in the order it received them")
+	assert.Equal(t, api.Method("sendMessage"), calls[1].Method, "a mock must record calls in the order it received them")
+	assert.Equal(t, "I am Tester", calls[1].Params.(api.SendMessageMethod).Text, "a mock must record the struct a method was called with")
+}
+
+func TestMockBot_panicsOnACallItWasNotGivenAFunctionFor(t *testing.T) {
+	mock := &api.MockBot{}
+
+	assert.PanicsWithValue(t, `MockBot: unexpected call to "getMe"`, func() {
+		_, _ = mock.GetMe(context.Background(), api.GetMeMethod{})
+	}, "a mock must fail loudly on a call the test did not script")
+	assert.Len(t, mock.Calls(), 1, "a mock must record a call even when it cannot answer it")
+}
+
+func TestMockBot_Calls_isSafeForConcurrentUse(t *testing.T) {
+	mock := &api.MockBot{
+		DeleteMessageFunc: func(context.Context, api.DeleteMessageMethod) error { return nil },
+	}
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = mock.DeleteMessage(context.Background(), api.DeleteMessageMethod{})
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, mock.Calls(), 16, "a mock called from several goroutines must record every call")
+}
@@ -10,10 +10,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // Method is the name an endpoint is called by.
@@ -30,6 +39,92 @@ var (
 	_ Payload = formPayload{}
 )
 
+// migratable is a Payload that can be sent again to another chat, the way
+// WithChatMigration repeats a call to a group that became a supergroup.
+type migratable interface {
+	// migrate returns the chat the payload was sent to and the payload sending
+	// the same body to chat to instead, or false when it names no chat by its
+	// identifier or cannot be sent a second time.
+	migrate(to int64) (int64, Payload, bool)
+}
+
+var (
+	_ migratable = jsonPayload{}
+	_ migratable = formPayload{}
+)
+
+// Codec turns the values a bot exchanges with the API into JSON and back: the
+// body of every request, the envelope of every response, and the variant of
+// every union decoded by hand. StandardCodec, the default, is encoding/json,
+// and SetCodec swaps another in — encoding/json/v2, or a faster library — with
+// no edit to the generated code. A codec must call the MarshalJSON and
+// UnmarshalJSON a type declares, for that is how a union reaches its variant.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// StandardCodec is the Codec of encoding/json.
+type StandardCodec struct{}
+
+var _ Codec = StandardCodec{}
+
+// Marshal implements [Codec].
+func (StandardCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal implements [Codec].
+func (StandardCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// codec is the Codec in use. It belongs to the package and not to a connection,
+// because a union is decoded from inside UnmarshalJSON and resolved from inside
+// MarshalJSON, which are handed the bytes or the value and nothing else to
+// encode them with.
+var codec activeCodec
+
+// activeCodec is the Codec in use, held behind an atomic pointer so that a codec
+// swapped while a call is in flight is no data race: every value is encoded
+// through whichever codec is in use when it is reached. Its zero value is
+// StandardCodec.
+type activeCodec struct {
+	current atomic.Pointer[Codec]
+}
+
+var _ Codec = (*activeCodec)(nil)
+
+// Marshal implements [Codec].
+func (a *activeCodec) Marshal(v any) ([]byte, error) {
+	return a.load().Marshal(v)
+}
+
+// Unmarshal implements [Codec].
+func (a *activeCodec) Unmarshal(data []byte, v any) error {
+	return a.load().Unmarshal(data, v)
+}
+
+// load returns the Codec in use.
+func (a *activeCodec) load() Codec {
+	c := a.current.Load()
+	if c == nil {
+		return StandardCodec{}
+	}
+	return *c
+}
+
+// SetCodec makes c the Codec in use, or restores StandardCodec when c is nil.
+// It is safe to call while requests are in flight; a call already decoding may
+// finish through either codec.
+func SetCodec(c Codec) {
+	if c == nil {
+		codec.current.Store(nil)
+		return
+	}
+	codec.current.Store(&c)
+}
+
 // Connection is where a method sends its payload and where the decoded result
 // comes back from.
 type Connection interface {
@@ -38,7 +133,7 @@ type Connection interface {
 
 // HTTPConnection is the production Connection: it builds the request from the
 // payload, posts it to the Telegram endpoint, and splits the JSON envelope into
-// either a decoded result or an Error.
+// either a decoded result or an Error, decoding both through the Codec in use.
 type HTTPConnection struct {
 	client      *http.Client
 	destination Destination
@@ -58,7 +153,8 @@ func NewHTTPConnectionTo(client *http.Client, destination Destination) HTTPConne
 
 // Do posts the payload to the method endpoint and decodes the result into
 // response. It returns an *Error when the API reports a failure, or a wrapped
-// error when the request, transport, or decoding fails.
+// error when the request, transport, or decoding fails. A transport failure
+// names the URL it failed on with the token redacted from it.
 func (c HTTPConnection) Do(
 	ctx context.Context,
 	method Method,
@@ -71,11 +167,15 @@ func (c HTTPConnection) Do(
 	}
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("sending request: %w", err)
+		return fmt.Errorf("sending request: %w", c.destination.redact(err))
 	}
 	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading envelope: %w", err)
+	}
 	var env envelope
-	err = json.NewDecoder(resp.Body).Decode(&env)
+	err = codec.Unmarshal(data, &env)
 	if err != nil {
 		return fmt.Errorf("decoding envelope: %w", err)
 	}
@@ -83,7 +183,7 @@ func (c HTTPConnection) Do(
 	if err != nil {
 		return err
 	}
-	err = json.Unmarshal(raw, response)
+	err = codec.Unmarshal(raw, response)
 	if err != nil {
 		return fmt.Errorf("decoding result: %w", err)
 	}
@@ -118,6 +218,87 @@ func (d Destination) url(method Method) string {
 	return fmt.Sprintf("%s/bot%s/%s", d.base, d.token, method)
 }
 
+// redact returns err with the token taken out of the URL it names. The client
+// reports a request it could not send as a *url.Error naming the URL, and the
+// token is part of every URL the destination makes, so an error handed back
+// unredacted gives the bot away to whatever logs it.
+func (d Destination) redact(err error) error {
+	var failure *url.Error
+	if d.token == "" || !errors.As(err, &failure) {
+		return err
+	}
+	return &url.Error{Op: failure.Op, URL: strings.ReplaceAll(failure.URL, d.token, "<token>"), Err: failure.Err}
+}
+
+// LogValue implements [slog.LogValuer], logging a Destination as where it
+// points without the token it holds.
+func (d Destination) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("base", d.base), slog.Bool("test", d.test))
+}
+
+// fileURL returns the URL the file stored at path is downloaded from. Files are
+// served beside the methods rather than under them, so the test segment follows
+// the token here as it does there.
+func (d Destination) fileURL(path string) string {
+	if d.test {
+		return fmt.Sprintf("%s/file/bot%s/test/%s", d.base, d.token, path)
+	}
+	return fmt.Sprintf("%s/file/bot%s/%s", d.base, d.token, path)
+}
+
+// maxDownloadSize is the largest file the Bot API serves for download: 20 MB.
+// A server running in local mode serves none at all and lifts the limit, since
+// it hands out a path on the disk it shares with the bot instead.
+const maxDownloadSize = 20 << 20
+
+// fetch streams the file stored at path into w. An absolute path is one a
+// server in local mode wrote to its own disk, so the file is read from there,
+// whatever its size. Any other path is relative to the file endpoint of the
+// destination; a file declared larger than maxDownloadSize is refused before
+// it is requested, and one turning out larger is refused once the limit is
+// read, rather than streamed whole.
+func (c HTTPConnection) fetch(ctx context.Context, path string, size *int64, w io.Writer) error {
+	if filepath.IsAbs(path) {
+		file, err := os.Open(filepath.Clean(path))
+		if err != nil {
+			return fmt.Errorf("opening local file: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		_, err = io.Copy(w, file)
+		if err != nil {
+			return fmt.Errorf("copying local file: %w", err)
+		}
+		return nil
+	}
+	if size != nil && *size > maxDownloadSize {
+		return fmt.Errorf("file of %d bytes exceeds the download limit of %d bytes", *size, maxDownloadSize)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.destination.fileURL(path), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", c.destination.redact(err))
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading file: %s", resp.Status)
+	}
+	_, err = io.Copy(w, io.LimitReader(resp.Body, maxDownloadSize))
+	if err != nil {
+		return fmt.Errorf("reading file: %w", err)
+	}
+	extra, err := io.CopyN(io.Discard, resp.Body, 1)
+	if extra > 0 {
+		return fmt.Errorf("file exceeds the download limit of %d bytes", maxDownloadSize)
+	}
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("reading file: %w", err)
+	}
+	return nil
+}
+
 // envelope is the Telegram Bot API JSON response wrapper: exactly one side is
 // meaningful — Result when Ok, the error fields otherwise.
 type envelope struct {
@@ -145,18 +326,6 @@ func (e envelope) result() (json.RawMessage, error) {
 	return nil, &Error{Code: code, Description: description, Parameters: e.Parameters}
 }
 
-// Error is a failure reported by the Telegram Bot API.
-type Error struct {
-	Code        int64
-	Description string
-	Parameters  *ResponseParameters
-}
-
-// Error returns the code and description as a single message.
-func (e *Error) Error() string {
-	return fmt.Sprintf("telegram %d: %s", e.Code, e.Description)
-}
-
 // emptyPayload is the body of a method with no parameter: no body, no header.
 type emptyPayload struct{}
 
@@ -177,7 +346,7 @@ func newJSONPayload(value any) jsonPayload {
 
 // Request implements [Payload].
 func (p jsonPayload) Request(ctx context.Context, method, url string) (*http.Request, error) {
-	data, err := json.Marshal(p.value)
+	data, err := codec.Marshal(p.value)
 	if err != nil {
 		return nil, fmt.Errorf("marshaling payload: %w", err)
 	}
@@ -223,6 +392,27 @@ func (s *fileSink) reserve(name string, reader io.Reader) string {
 	return key
 }
 
+// migrate implements [migratable]. The body is rewritten as the fields it
+// marshals to, since the chat can sit in any method and only its key is known.
+func (p jsonPayload) migrate(to int64) (int64, Payload, bool) {
+	data, err := codec.Marshal(p.value)
+	if err != nil {
+		return 0, nil, false
+	}
+	var fields map[string]json.RawMessage
+	err = codec.Unmarshal(data, &fields)
+	if err != nil {
+		return 0, nil, false
+	}
+	var from int64
+	err = codec.Unmarshal(fields["chat_id"], &from)
+	if err != nil {
+		return 0, nil, false
+	}
+	fields["chat_id"] = json.RawMessage(strconv.FormatInt(to, 10))
+	return from, newJSONPayload(fields), true
+}
+
 // formPayload is the body of a method reaching a file: the body every parameter
 // that is not a file rides in, plus the parts the files were handed over as.
 type formPayload struct {
@@ -236,51 +426,233 @@ func newFormPayload(value any, files map[string]filePart) formPayload {
 
 // Request implements [Payload]. A method that could have carried a file but
 // carried none sends plain JSON, since a multipart body buys nothing then.
+//
+// The body is streamed rather than assembled: a goroutine writes the parts into
+// one end of a pipe as the transport reads the other, so a file is held in
+// memory no more than a buffer at a time however large it is. The goroutine
+// starts when the transport first reads the body, so a request built and never
+// sent leaves nothing running. A file failing to read fails the request with
+// the error it failed with, and a request cancelled midway stops the goroutine
+// at its next read. When the size of every file is known the request declares
+// its length, which some proxies require and which spares the transport
+// chunked encoding.
 func (p formPayload) Request(ctx context.Context, method, url string) (*http.Request, error) {
 	if len(p.files) == 0 {
 		return newJSONPayload(p.value).Request(ctx, method, url)
 	}
-	data, err := json.Marshal(p.value)
+	fields, err := p.fields()
+	if err != nil {
+		return nil, err
+	}
+	boundary := multipart.NewWriter(io.Discard)
+	body := newFormBody(func(w io.Writer) error {
+		form := multipart.NewWriter(w)
+		err := form.SetBoundary(boundary.Boundary())
+		if err != nil {
+			return err
+		}
+		return p.write(ctx, form, fields)
+	})
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", boundary.FormDataContentType())
+	length, ok, err := p.length(boundary.Boundary(), fields)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		req.ContentLength = length
+	}
+	return req, nil
+}
+
+// fields returns the body split into the form fields it travels as, each
+// top-level JSON value rendered into the text of one field. It fails when the
+// body cannot be marshaled or a value cannot be rendered.
+func (p formPayload) fields() (map[string]string, error) {
+	data, err := codec.Marshal(p.value)
 	if err != nil {
 		return nil, fmt.Errorf("marshaling payload: %w", err)
 	}
-	var fields map[string]json.RawMessage
-	err = json.Unmarshal(data, &fields)
+	var raws map[string]json.RawMessage
+	err = codec.Unmarshal(data, &raws)
 	if err != nil {
 		return nil, fmt.Errorf("splitting body: %w", err)
 	}
-	buf := &bytes.Buffer{}
-	writer := multipart.NewWriter(buf)
-	for key, raw := range fields {
+	fields := make(map[string]string, len(raws))
+	for key, raw := range raws {
 		value, err := formField(raw).value()
 		if err != nil {
 			return nil, err
 		}
-		err = writer.WriteField(key, value)
+		fields[key] = value
+	}
+	return fields, nil
+}
+
+// write writes every field and then every file into form, and closes it. A
+// file is read through ctx, so that a cancelled request is not read to its end.
+func (p formPayload) write(ctx context.Context, form *multipart.Writer, fields map[string]string) error {
+	for key, value := range fields {
+		err := form.WriteField(key, value)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 	for key, part := range p.files {
-		into, err := writer.CreateFormFile(key, part.name)
+		into, err := form.CreateFormFile(key, part.name)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		_, err = io.Copy(into, part.reader)
+		_, err = io.Copy(into, contextReader{ctx: ctx, reader: part.reader})
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("reading file %q: %w", part.name, err)
 		}
 	}
-	err = writer.Close()
+	return form.Close()
+}
+
+// length returns the length of the body write would stream under boundary, and
+// false when a file does not tell its size. Every part other than the bytes of
+// a file is written for real into a counter, which costs no more than the
+// fields themselves and spells the framing exactly as write does; the files
+// add their sizes.
+func (p formPayload) length(boundary string, fields map[string]string) (int64, bool, error) {
+	var files int64
+	for _, part := range p.files {
+		size, ok := sizeOf(part.reader)
+		if !ok {
+			return 0, false, nil
+		}
+		files += size
+	}
+	counter := &countingWriter{}
+	form := multipart.NewWriter(counter)
+	err := form.SetBoundary(boundary)
 	if err != nil {
-		return nil, err
+		return 0, false, err
+	}
+	for key, value := range fields {
+		err = form.WriteField(key, value)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+	for key, part := range p.files {
+		_, err = form.CreateFormFile(key, part.name)
+		if err != nil {
+			return 0, false, err
+		}
 	}
-	req, err := http.NewRequestWithContext(ctx, method, url, buf)
+	err = form.Close()
 	if err != nil {
-		return nil, err
+		return 0, false, err
 	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	return req, nil
+	return counter.n + files, true, nil
+}
+
+// sizeOf returns how many bytes are left to read from r, and false when r does
+// not tell. The readers of package bytes and strings tell through Len, and a
+// regular file through its size less the offset it is read from.
+func sizeOf(r io.Reader) (int64, bool) {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case *os.File:
+		info, err := r.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0, false
+		}
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+		return info.Size() - offset, true
+	default:
+		return 0, false
+	}
+}
+
+// countingWriter counts the bytes written into it and keeps none of them.
+type countingWriter struct {
+	n int64
+}
+
+// Write implements [io.Writer].
+func (w *countingWriter) Write(data []byte) (int, error) {
+	w.n += int64(len(data))
+	return len(data), nil
+}
+
+// formBody is the body of a multipart request: a pipe whose writing end a
+// goroutine fills by write. The goroutine is started by the first read rather
+// than when the request is built, since a request may be built and never sent —
+// dropped by a middleware, or captured by a test — and a goroutine nobody reads
+// from would block on its first write for good. Closing the body stops a
+// goroutine already writing at its next write.
+type formBody struct {
+	once   sync.Once
+	write  func(io.Writer) error
+	reader *io.PipeReader
+}
+
+func newFormBody(write func(io.Writer) error) *formBody {
+	return &formBody{once: sync.Once{}, write: write, reader: nil}
+}
+
+// Read implements [io.Reader].
+func (b *formBody) Read(data []byte) (int, error) {
+	b.once.Do(b.start)
+	return b.reader.Read(data)
+}
+
+// Close implements [io.Closer]. A body closed before it was read is never
+// written at all.
+func (b *formBody) Close() error {
+	b.once.Do(b.discard)
+	return b.reader.Close()
+}
+
+// start opens the pipe and starts writing into it.
+func (b *formBody) start() {
+	reader, writer := io.Pipe()
+	b.reader = reader
+	go func() {
+		_ = writer.CloseWithError(b.write(writer))
+	}()
+}
+
+// discard opens a pipe nothing writes into, for a body closed unread.
+func (b *formBody) discard() {
+	b.reader, _ = io.Pipe()
+}
+
+// contextReader reads from reader until ctx is done, and fails with the error
+// of ctx after.
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+// Read implements [io.Reader].
+func (r contextReader) Read(data []byte) (int, error) {
+	err := r.ctx.Err()
+	if err != nil {
+		return 0, err
+	}
+	return r.reader.Read(data)
+}
+
+// migrate implements [migratable]. A payload that carried a file was read to
+// the end of each stream on its first sending, so only one carrying none can be
+// sent again.
+func (p formPayload) migrate(to int64) (int64, Payload, bool) {
+	if len(p.files) > 0 {
+		return 0, nil, false
+	}
+	return newJSONPayload(p.value).migrate(to)
 }
 
 // formField is one top-level JSON value of a body rendered into a form field.
@@ -292,7 +664,7 @@ type formField json.RawMessage
 func (f formField) value() (string, error) {
 	if len(f) > 0 && f[0] == '"' {
 		var unquoted string
-		err := json.Unmarshal(f, &unquoted)
+		err := codec.Unmarshal(f, &unquoted)
 		if err != nil {
 			return "", fmt.Errorf("unquoting form field: %w", err)
 		}
@@ -384,11 +756,11 @@ func (c FakeConnection) Do(_ context.Context, method Method, _ Payload, response
 	case errResponse:
 		return r.err
 	case okResponse:
-		data, err := json.Marshal(r.value)
+		data, err := codec.Marshal(r.value)
 		if err != nil {
 			panic(fmt.Sprintf("FakeConnection: marshaling %q response: %v", method, err))
 		}
-		return json.Unmarshal(data, response)
+		return codec.Unmarshal(data, response)
 	default:
 		panic(fmt.Sprintf("FakeConnection: unknown response %T", call.response))
 	}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+// spy is a Codec handing every call on to encoding/json and keeping the
+// type of each value it was handed, or failing every decoding with refusal.
+type spy struct {
+	marshaled   []string
+	unmarshaled []string
+	refusal     error
+}
+
+func (s *spy) Marshal(v any) ([]byte, error) {
+	s.marshaled = append(s.marshaled, fmt.Sprintf("%T", v))
+	return api.StandardCodec{}.Marshal(v)
+}
+
+func (s *spy) Unmarshal(data []byte, v any) error {
+	s.unmarshaled = append(s.unmarshaled, fmt.Sprintf("%T", v))
+	if s.refusal != nil {
+		return s.refusal
+	}
+	return api.StandardCodec{}.Unmarshal(data, v)
+}
+
+// pinned is a response carrying a message whose pinned message is a union
+// decoded by hand.
+const pinned = `{"ok":true,"result":{
+	"message_id": 44,
+	"chat": {"id": -100123, "type": "supergroup"},
+	"date": 1760000002,
+	"pinned_message": {"chat": {"id": -100123, "type": "supergroup"}, "message_id": 12, "date": 0}
+}}`
+
+// send sends a message to a server answering body, through whatever codec is
+// in use.
+func send(t *testing.T, body string) (api.Message, error) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+	conn := api.NewHTTPConnectionTo(server.Client(), api.NewDestination(server.URL, "42:XYZ"))
+	return api.NewSendMessageMethod(api.ID(-100123), "Hello").Call(context.Background(), conn)
+}
+
+func TestSetCodec_carriesEveryValueThroughTheCodec(t *testing.T) {
+	codec := &spy{}
+	api.SetCodec(codec)
+	t.Cleanup(func() { api.SetCodec(nil) })
+
+	message, err := send(t, pinned)
+
+	require.NoError(t, err, "a codec handing its calls on to encoding/json must decode what encoding/json decodes")
+	assert.IsType(t, api.InaccessibleMessage{}, message.PinnedMessage, "a union decoded by hand must still reach its variant")
+	assert.Contains(t, codec.marshaled, "api.SendMessageMethod", "the body of a request must be marshaled by the codec in use")
+	assert.Contains(t, codec.unmarshaled, "*api.envelope", "the envelope of a response must be decoded by the codec in use")
+	assert.Contains(t, codec.unmarshaled, "*api.InaccessibleMessage", "a union decoded by hand must be decoded by the codec in use")
+}
+
+func TestSetCodec_failsWithTheCodec(t *testing.T) {
+	refusal := errors.New("no JSON today")
+	api.SetCodec(&spy{refusal: refusal})
+	t.Cleanup(func() { api.SetCodec(nil) })
+
+	_, err := send(t, pinned)
+
+	require.ErrorIs(t, err, refusal, "a failure of the codec must come back as it was")
+	assert.ErrorContains(t, err, "decoding envelope", "a failure of the codec must name what it failed to decode")
+}
+
+func TestSetCodec_restoresTheStandardCodecOnNil(t *testing.T) {
+	codec := &spy{}
+	api.SetCodec(codec)
+	api.SetCodec(nil)
+
+	_, err := send(t, pinned)
+
+	require.NoError(t, err)
+	assert.Empty(t, codec.unmarshaled, "a codec swapped out must not be called again")
+}
+
+func TestSetCodec_swapsWhileRequestsAreInFlight(t *testing.T) {
+	t.Cleanup(func() { api.SetCodec(nil) })
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := send(t, pinned)
+			assert.NoError(t, err, "a call must decode through whichever codec is in use")
+		}()
+		go func() {
+			defer wg.Done()
+			api.SetCodec(api.StandardCodec{})
+			api.SetCodec(nil)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+func TestNewSendMessageMethod(t *testing.T) {
+	cases := []struct {
+		name   string
+		method api.SendMessageMethod
+		want   string
+	}{
+		{
+			name:   "sends the required parameters alone",
+			method: api.NewSendMessageMethod(api.ID(42), "Hello"),
+			want:   `{"chat_id":42,"text":"Hello"}`,
+		},
+		{
+			name: "sends an optional scalar a setter filled in",
+			method: api.NewSendMessageMethod(api.ID(42), "*Hello*").
+				WithParseMode("MarkdownV2").
+				WithDisableNotification(true),
+			want: `{"chat_id":42,"text":"*Hello*","parse_mode":"MarkdownV2","disable_notification":true}`,
+		},
+		{
+			name: "sends a zero value a setter filled in",
+			method: api.NewSendMessageMethod(api.ID(42), "Hello").
+				WithProtectContent(false),
+			want: `{"chat_id":42,"text":"Hello","protect_content":false}`,
+		},
+		{
+			name: "sends an optional slice a setter filled in",
+			method: api.NewSendMessageMethod(api.ID(42), "Hello").
+				WithEntities([]api.MessageEntity{{Type: "bold", Offset: 0, Length: 5}}),
+			want: `{"chat_id":42,"text":"Hello","entities":[{"type":"bold","offset":0,"length":5}]}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn := NewCapturingConnection()
+			_, err := tc.method.Call(context.Background(), conn)
+			require.NoError(t, err)
+			body, err := io.ReadAll(conn.Request().Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(body), "a method built by its constructor must send what it was given")
+		})
+	}
+}
+
+func TestSendMessageMethod_With_leavesTheReceiverUntouched(t *testing.T) {
+	base := api.NewSendMessageMethod(api.ID(42), "Hello")
+
+	quiet := base.WithDisableNotification(true)
+
+	assert.Nil(t, base.DisableNotification, "a setter must not change the struct it was called on")
+	require.NotNil(t, quiet.DisableNotification, "a setter must set the field on the copy it returns")
+	assert.True(t, *quiet.DisableNotification, "a setter must set the field to the value it was given")
+}
+
+func TestSendPollMethod_WithType(t *testing.T) {
+	method := api.NewSendPollMethod(api.ID(42), "Pick one", nil).WithType("quiz")
+
+	require.NotNil(t, method.Type, "a setter whose parameter is a Go keyword must still set the field")
+	assert.Equal(t, "quiz", *method.Type, "a setter whose parameter is a Go keyword must still set the field")
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+func TestHTTPConnection_Download(t *testing.T) {
+	cases := []struct {
+		name        string
+		destination func(base, token string) api.Destination
+		file        func(t *testing.T) string
+		content     string
+		check       func(*testing.T, []string, string, error)
+	}{
+		{
+			name:        "fetches the path getFile gave from the file endpoint",
+			destination: api.NewDestination,
+			file: func(*testing.T) string {
+				return `{"file_id":"AgAD","file_unique_id":"u","file_size":5,"file_path":"photos/file_0.jpg"}`
+			},
+			content: "байт",
+			check: func(t *testing.T, paths []string, got string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []string{"/bot42:XYZ/getFile", "/file/bot42:XYZ/photos/file_0.jpg"}, paths,
+					"a download must look the file up and then fetch it from the file endpoint")
+				assert.Equal(t, "байт", got, "a download must stream the content it fetched")
+			},
+		},
+		{
+			name:        "fetches under a segment of its own in the test environment",
+			destination: api.NewTestDestination,
+			file: func(*testing.T) string {
+				return `{"file_id":"AgAD","file_unique_id":"u","file_path":"photos/file_0.jpg"}`
+			},
+			content: "байт",
+			check: func(t *testing.T, paths []string, _ string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "/file/bot42:XYZ/test/photos/file_0.jpg", paths[1],
+					"the test environment must be addressed by an extra segment after the token")
+			},
+		},
+		{
+			name:        "refuses a file declared over the limit before fetching it",
+			destination: api.NewDestination,
+			file: func(*testing.T) string {
+				return fmt.Sprintf(`{"file_id":"AgAD","file_unique_id":"u","file_size":%d,"file_path":"video.mp4"}`, 21<<20)
+			},
+			check: func(t *testing.T, paths []string, _ string, err error) {
+				t.Helper()
+				assert.ErrorContains(t, err, "exceeds the download limit", "a file over 20 MB must be refused")
+				assert.Len(t, paths, 1, "a file declared over the limit must not be fetched at all")
+			},
+		},
+		{
+			name:        "refuses a file turning out over the limit",
+			destination: api.NewDestination,
+			file: func(*testing.T) string {
+				return `{"file_id":"AgAD","file_unique_id":"u","file_path":"video.mp4"}`
+			},
+			content: strings.Repeat("x", 20<<20+1),
+			check: func(t *testing.T, _ []string, got string, err error) {
+				t.Helper()
+				assert.ErrorContains(t, err, "exceeds the download limit", "a file over 20 MB must be refused")
+				assert.Len(t, got, 20<<20, "a download must stop reading at the limit")
+			},
+		},
+		{
+			name:        "reads a path a server in local mode gave from the disk",
+			destination: api.NewDestination,
+			file: func(t *testing.T) string {
+				t.Helper()
+				path := filepath.Join(t.TempDir(), "file_0.jpg")
+				require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("x", 21<<20)), 0o600))
+				return fmt.Sprintf(`{"file_id":"AgAD","file_unique_id":"u","file_size":%d,"file_path":%q}`, 21<<20, path)
+			},
+			check: func(t *testing.T, paths []string, got string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Len(t, paths, 1, "a local path must not be fetched over HTTP")
+				assert.Len(t, got, 21<<20, "a local path must be read whole, whatever its size")
+			},
+		},
+		{
+			name:        "refuses a file getFile gave no path for",
+			destination: api.NewDestination,
+			file: func(*testing.T) string {
+				return `{"file_id":"AgAD","file_unique_id":"u"}`
+			},
+			check: func(t *testing.T, _ []string, _ string, err error) {
+				t.Helper()
+				assert.EqualError(t, err, `file "AgAD" has no path to download from`,
+					"a file with no path must fail naming the file")
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			paths := make([]string, 0)
+			file := tc.file(t)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				if strings.HasSuffix(r.URL.Path, "/getFile") {
+					_, _ = w.Write([]byte(`{"ok":true,"result":` + file + `}`))
+					return
+				}
+				_, _ = w.Write([]byte(tc.content))
+			}))
+			defer server.Close()
+			conn := api.NewHTTPConnectionTo(server.Client(), tc.destination(server.URL, "42:XYZ"))
+			var got bytes.Buffer
+			_, err := conn.Download(context.Background(), "AgAD", &got)
+			tc.check(t, paths, got.String(), err)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+func TestError_Is(t *testing.T) {
+	migrated := int64(-1009876543210)
+	wait := int64(5)
+	cases := []struct {
+		name  string
+		err   *api.Error
+		is    []error
+		isNot []error
+	}{
+		{
+			name:  "tells a blocked bot as forbidden",
+			err:   &api.Error{Code: 403, Description: "Forbidden: bot was blocked by the user"},
+			is:    []error{api.ErrForbidden},
+			isNot: []error{api.ErrBadRequest, api.ErrNotFound},
+		},
+		{
+			name:  "tells a missing chat as a bad request and as not found",
+			err:   &api.Error{Code: 400, Description: "Bad Request: chat not found"},
+			is:    []error{api.ErrBadRequest, api.ErrNotFound},
+			isNot: []error{api.ErrForbidden, api.ErrMessageNotModified},
+		},
+		{
+			name:  "tells an unknown method as not found",
+			err:   &api.Error{Code: 404, Description: "Not Found"},
+			is:    []error{api.ErrNotFound},
+			isNot: []error{api.ErrBadRequest},
+		},
+		{
+			name: "tells flood control as a flood wait",
+			err: &api.Error{
+				Code:        429,
+				Description: "Too Many Requests: retry after 5",
+				Parameters:  &api.ResponseParameters{RetryAfter: &wait},
+			},
+			is:    []error{api.ErrFloodWait},
+			isNot: []error{api.ErrBadRequest},
+		},
+		{
+			name: "tells an upgraded group as migrated",
+			err: &api.Error{
+				Code:        400,
+				Description: "Bad Request: group chat was upgraded to a supergroup chat",
+				Parameters:  &api.ResponseParameters{MigrateToChatID: &migrated},
+			},
+			is:    []error{api.ErrChatMigrated, api.ErrBadRequest},
+			isNot: []error{api.ErrNotFound},
+		},
+		{
+			name: "tells an edit changing nothing as not modified",
+			err: &api.Error{
+				Code:        400,
+				Description: "Bad Request: message is not modified: specified new message content and reply markup are exactly the same",
+			},
+			is:    []error{api.ErrMessageNotModified, api.ErrBadRequest},
+			isNot: []error{api.ErrChatMigrated},
+		},
+		{
+			name:  "tells a rejected token as unauthorized",
+			err:   &api.Error{Code: 401, Description: "Unauthorized"},
+			is:    []error{api.ErrUnauthorized},
+			isNot: []error{api.ErrForbidden},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrapped := fmt.Errorf("greeting: %w", tc.err)
+			for _, kind := range tc.is {
+				assert.ErrorIs(t, wrapped, kind, "a failure must be of every kind it is")
+			}
+			for _, kind := range tc.isNot {
+				assert.NotErrorIs(t, wrapped, kind, "a failure must not be of a kind it is not")
+			}
+		})
+	}
+}
+
+func TestError_RetryAfter(t *testing.T) {
+	seconds := int64(5)
+	wait, ok := (&api.Error{
+		Code:       429,
+		Parameters: &api.ResponseParameters{RetryAfter: &seconds},
+	}).RetryAfter()
+
+	require.True(t, ok, "a flood wait must tell how long to wait")
+	assert.Equal(t, 5*time.Second, wait, "a flood wait must be read in seconds")
+
+	_, ok = (&api.Error{Code: 400}).RetryAfter()
+	assert.False(t, ok, "a failure naming no wait must not make one up")
+}
+
+// script is a Connection answering calls in turn and keeping the body of each.
+type script struct {
+	answers []error
+	bodies  []string
+}
+
+func (s *script) Do(ctx context.Context, _ api.Method, payload api.Payload, _ any) error {
+	req, err := payload.Request(ctx, http.MethodPost, "http://script")
+	if err != nil {
+		return err
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	s.bodies = append(s.bodies, string(body))
+	answer := s.answers[0]
+	s.answers = s.answers[1:]
+	return answer
+}
+
+func TestWithChatMigration(t *testing.T) {
+	to := int64(-1009876543210)
+	migratedErr := &api.Error{
+		Code:        400,
+		Description: "Bad Request: group chat was upgraded to a supergroup chat",
+		Parameters:  &api.ResponseParameters{MigrateToChatID: &to},
+	}
+	cases := []struct {
+		name   string
+		method func(context.Context, api.Connection) error
+		check  func(*testing.T, *script, error, [][2]int64)
+	}{
+		{
+			name: "repeats the call against the supergroup",
+			method: func(ctx context.Context, conn api.Connection) error {
+				_, err := api.NewSendMessageMethod(api.ID(-123), "Hello").Call(ctx, conn)
+				return err
+			},
+			check: func(t *testing.T, s *script, err error, moves [][2]int64) {
+				t.Helper()
+				require.NoError(t, err, "a call repeated against the supergroup must succeed when the repeat does")
+				require.Len(t, s.bodies, 2, "a migrated call must be repeated once")
+				assert.JSONEq(t, `{"chat_id":-1009876543210,"text":"Hello"}`, s.bodies[1],
+					"a repeated call must send the same body to the supergroup")
+				assert.Equal(t, [][2]int64{{-123, -1009876543210}}, moves, "a migration must be told to the bot")
+			},
+		},
+		{
+			name: "leaves a call carrying a file alone",
+			method: func(ctx context.Context, conn api.Connection) error {
+				_, err := api.NewSendPhotoMethod(api.ID(-123), api.Upload{Name: "a.png", Reader: strings.NewReader("png")}).
+					Call(ctx, conn)
+				return err
+			},
+			check: func(t *testing.T, s *script, err error, moves [][2]int64) {
+				t.Helper()
+				assert.ErrorIs(t, err, api.ErrChatMigrated, "a call that cannot be repeated must return the migration")
+				assert.Len(t, s.bodies, 1, "a call whose file was already read must not be repeated")
+				assert.Empty(t, moves, "a call not repeated must not be told as a migration")
+			},
+		},
+		{
+			name: "leaves a call naming its chat by username alone",
+			method: func(ctx context.Context, conn api.Connection) error {
+				_, err := api.NewSendMessageMethod(api.Username("@group"), "Hello").Call(ctx, conn)
+				return err
+			},
+			check: func(t *testing.T, s *script, err error, _ [][2]int64) {
+				t.Helper()
+				assert.ErrorIs(t, err, api.ErrChatMigrated, "a call that cannot be repeated must return the migration")
+				assert.Len(t, s.bodies, 1, "a call naming no chat by identifier must not be repeated")
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &script{answers: []error{migratedErr, nil}}
+			var moves [][2]int64
+			conn := api.Chain(s, api.WithChatMigration(func(from, to int64) {
+				moves = append(moves, [2]int64{from, to})
+			}))
+
+			err := tc.method(context.Background(), conn)
+
+			tc.check(t, s, err, moves)
+		})
+	}
+}
+
+func TestWithChatMigration_returnsOtherFailuresUnchanged(t *testing.T) {
+	refusal := &api.Error{Code: 403, Description: "Forbidden: bot was kicked from the group chat"}
+	s := &script{answers: []error{refusal}}
+	conn := api.Chain(s, api.WithChatMigration(nil))
+
+	_, err := api.NewSendMessageMethod(api.ID(-123), "Hello").Call(context.Background(), conn)
+
+	assert.ErrorIs(t, err, api.ErrForbidden, "a failure other than a migration must come back as it was")
+	assert.Len(t, s.bodies, 1, "a failure other than a migration must not be repeated")
+}
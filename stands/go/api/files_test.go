@@ -4,8 +4,11 @@ package api_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -136,6 +139,71 @@ func TestSendDocumentMethod_Call(t *testing.T) {
 			assert.JSONEq(t, tc.want, value, "a parameter that is no string must ride in the body verbatim, never quoted as one")
 		})
 	}
+	t.Run("streams a file rather than holding it in memory", func(t *testing.T) {
+		const size = 32 << 20
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		s, err := sendDocument(context.Background(), t, &zeros{left: size})
+		runtime.ReadMemStats(&after)
+		require.NoError(t, err)
+		assert.Equal(t, s.declared, s.received, "a streamed body must be as long as it declared")
+		assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(size/8),
+			"a file must be streamed a buffer at a time, never allocated whole")
+	})
+	t.Run("starts writing nothing for a request never sent", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		conn := NewCapturingConnection()
+		_, err := api.NewSendDocumentMethod(
+			api.ID(-1001234567890),
+			api.Upload{Name: "big.bin", Reader: &zeros{left: 32 << 20}},
+		).Call(context.Background(), conn)
+		require.NoError(t, err)
+		require.NoError(t, conn.Request().Body.Close())
+		assert.LessOrEqual(t, runtime.NumGoroutine(), before,
+			"a request built and never sent must leave no goroutine writing its body")
+	})
+	lengths := []struct {
+		name   string
+		reader func() io.Reader
+		check  func(*testing.T, *sink)
+	}{
+		{
+			name:   "declares the length of a body whose files tell their size",
+			reader: func() io.Reader { return strings.NewReader("%PDF-1.7") },
+			check: func(t *testing.T, s *sink) {
+				t.Helper()
+				assert.Equal(t, s.received, s.declared, "a body whose every file is sized must declare its exact length")
+			},
+		},
+		{
+			name:   "declares no length for a body with a file of unknown size",
+			reader: func() io.Reader { return unsized{reader: strings.NewReader("%PDF-1.7")} },
+			check: func(t *testing.T, s *sink) {
+				t.Helper()
+				assert.Equal(t, int64(-1), s.declared, "a body with a file of unknown size must not guess its length")
+				assert.Positive(t, s.received, "a body of unknown length must still arrive whole")
+			},
+		},
+	}
+	for _, tc := range lengths {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := sendDocument(context.Background(), t, tc.reader())
+			require.NoError(t, err)
+			tc.check(t, s)
+		})
+	}
+	t.Run("fails with the error the file failed to read with", func(t *testing.T) {
+		broken := errors.New("disk on fire")
+		_, err := sendDocument(context.Background(), t, &failing{err: broken})
+		assert.ErrorIs(t, err, broken, "a file failing to read must fail the call with the error it failed with")
+	})
+	t.Run("stops once the call is cancelled while the file streams", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := sendDocument(ctx, t, endless{at: cancel})
+		assert.ErrorIs(t, err, context.Canceled, "a call cancelled while its file streams must stop with the cancellation")
+	})
 }
 
 func TestSendMediaGroupMethod_Call(t *testing.T) {
@@ -470,3 +538,83 @@ func TestSendAudioMethod_Call(t *testing.T) {
 		})
 	}
 }
+
+// sink is a server reading every request to its end and keeping what it was
+// told and what it got, answering as sendDocument does. It keeps as well the
+// body the client handed its transport, before anything read from it.
+type sink struct {
+	declared int64
+	received int64
+}
+
+func (s *sink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.declared = r.ContentLength
+	s.received, _ = io.Copy(io.Discard, r.Body)
+	_, _ = io.WriteString(w, `{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"}}}`)
+}
+
+// zeros is a file of left zero bytes that tells its size and holds none of
+// them, so that streaming it allocates only what the transport does.
+type zeros struct {
+	left int
+}
+
+func (z *zeros) Read(data []byte) (int, error) {
+	if z.left == 0 {
+		return 0, io.EOF
+	}
+	n := min(len(data), z.left)
+	clear(data[:n])
+	z.left -= n
+	return n, nil
+}
+
+func (z *zeros) Len() int {
+	return z.left
+}
+
+// unsized hides the size of the reader it wraps.
+type unsized struct {
+	reader io.Reader
+}
+
+func (u unsized) Read(data []byte) (int, error) {
+	return u.reader.Read(data)
+}
+
+// failing is a reader failing with err once it has handed over a little.
+type failing struct {
+	err  error
+	read bool
+}
+
+func (f *failing) Read(data []byte) (int, error) {
+	if f.read {
+		return 0, f.err
+	}
+	f.read = true
+	return copy(data, "partial"), nil
+}
+
+// endless is a reader that never runs out, calling at once it is first read.
+type endless struct {
+	at func()
+}
+
+func (e endless) Read(data []byte) (int, error) {
+	if e.at != nil {
+		e.at()
+	}
+	return len(data), nil
+}
+
+func sendDocument(ctx context.Context, t *testing.T, reader io.Reader) (*sink, error) {
+	t.Helper()
+	s := &sink{}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	conn := api.NewHTTPConnectionTo(server.Client(), api.NewDestination(server.URL, "42:TOKEN"))
+	_, err := api.NewSendDocumentMethod(api.ID(-1001234567890), api.Upload{Name: "big.bin", Reader: reader}).
+		Call(ctx, conn)
+	return s, err
+}
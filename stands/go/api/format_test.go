@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"stand/api"
+)
+
+func TestFormattedText(t *testing.T) {
+	url := "https://core.telegram.org/bots/api"
+	language := "go"
+	cases := []struct {
+		name     string
+		text     api.FormattedText
+		want     string
+		entities []api.MessageEntity
+	}{
+		{
+			name:     "counts offsets in UTF-16 code units",
+			text:     api.NewFormattedText("Привет 👋 ").Bold(api.NewFormattedText("мир")),
+			want:     "Привет 👋 мир",
+			entities: []api.MessageEntity{{Type: "bold", Offset: 10, Length: 3}},
+		},
+		{
+			name: "counts a rune past the Basic Multilingual Plane as two units",
+			text: api.NewFormattedText("").
+				Italic(api.NewFormattedText("😀😀")).
+				Plain(" and ").
+				Underline(api.NewFormattedText("é")),
+			want: "😀😀 and é",
+			entities: []api.MessageEntity{
+				{Type: "italic", Offset: 0, Length: 4},
+				{Type: "underline", Offset: 9, Length: 1},
+			},
+		},
+		{
+			name: "puts an enclosing entity before the entities it encloses",
+			text: api.NewFormattedText("> ").
+				Blockquote(api.NewFormattedText("a ").Bold(api.NewFormattedText("bold")).Plain(" quote")),
+			want: "> a bold quote",
+			entities: []api.MessageEntity{
+				{Type: "blockquote", Offset: 2, Length: 12},
+				{Type: "bold", Offset: 4, Length: 4},
+			},
+		},
+		{
+			name: "carries the fields reserved to a kind",
+			text: api.NewFormattedText("See ").
+				TextLink(api.NewFormattedText("the docs"), url).
+				Plain(": ").
+				Pre(api.NewFormattedText("fmt.Println()"), language),
+			want: "See the docs: fmt.Println()",
+			entities: []api.MessageEntity{
+				{Type: "text_link", Offset: 4, Length: 8, URL: &url},
+				{Type: "pre", Offset: 14, Length: 13, Language: &language},
+			},
+		},
+		{
+			name: "moves the entities of an appended text along with it",
+			text: api.NewFormattedText("ab").Append(api.NewFormattedText("🙂").Code(api.NewFormattedText("c"))),
+			want: "ab🙂c",
+			entities: []api.MessageEntity{
+				{Type: "code", Offset: 4, Length: 1},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.text.Text(), "a formatted text must hold its parts in the order they were added")
+			assert.Equal(t, tc.entities, tc.text.Entities(), "a formatted text must mark what the API reads at the offsets it counts")
+		})
+	}
+}
+
+func TestFormattedText_isAValue(t *testing.T) {
+	prefix := api.NewFormattedText("Dear ").Bold(api.NewFormattedText("Ann"))
+
+	first := prefix.Plain(", hello").Italic(api.NewFormattedText("!"))
+	second := prefix.Plain(", bye").Spoiler(api.NewFormattedText("?"))
+
+	assert.Len(t, prefix.Entities(), 1, "continuing a text must leave it as it was")
+	assert.Equal(t, "italic", first.Entities()[1].Type, "two texts continued from one prefix must not share their entities")
+	assert.Equal(t, "spoiler", second.Entities()[1].Type, "two texts continued from one prefix must not share their entities")
+}
+
+func TestEscape(t *testing.T) {
+	cases := []struct {
+		name   string
+		escape func(string) string
+		text   string
+		want   string
+	}{
+		{
+			name:   "MarkdownV2 escapes every character it reserves",
+			escape: api.EscapeMarkdownV2,
+			text:   `1.5 * (a_b) [c] ~d~ ` + "`e`" + ` > #f +g -h =i |j| {k} !l \m`,
+			want:   `1\.5 \* \(a\_b\) \[c\] \~d\~ \` + "`e\\`" + ` \> \#f \+g \-h \=i \|j\| \{k\} \!l \\m`,
+		},
+		{
+			name:   "MarkdownV2 leaves letters, digits and spaces of any script alone",
+			escape: api.EscapeMarkdownV2,
+			text:   "Привет 42 мир 👋",
+			want:   "Привет 42 мир 👋",
+		},
+		{
+			name:   "MarkdownV2 code escapes only the backquote and the backslash",
+			escape: api.EscapeMarkdownV2Code,
+			text:   "a_b * `c` \\d",
+			want:   "a_b * \\`c\\` \\\\d",
+		},
+		{
+			name:   "MarkdownV2 link escapes only the closing parenthesis and the backslash",
+			escape: api.EscapeMarkdownV2Link,
+			text:   `https://example.com/a_(b)\c`,
+			want:   `https://example.com/a_(b\)\\c`,
+		},
+		{
+			name:   "HTML escapes the characters a tag or an attribute is read from",
+			escape: api.EscapeHTML,
+			text:   `<b>"Tom" & 'Jerry'</b>`,
+			want:   `&lt;b&gt;&quot;Tom&quot; &amp; 'Jerry'&lt;/b&gt;`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.escape(tc.text), "an escaper must leave text reading as itself under its parse mode")
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+func TestNewInlineKeyboardMarkupGrid(t *testing.T) {
+	buttons := []api.InlineKeyboardButton{
+		api.NewInlineKeyboardButtonCallbackData("1", "one"),
+		api.NewInlineKeyboardButtonCallbackData("2", "two"),
+		api.NewInlineKeyboardButtonCallbackData("3", "three"),
+		api.NewInlineKeyboardButtonCallbackData("4", "four"),
+		api.NewInlineKeyboardButtonCallbackData("5", "five"),
+	}
+	cases := []struct {
+		name    string
+		columns int
+		want    [][]string
+	}{
+		{name: "leaves what is left to the last row", columns: 2, want: [][]string{{"1", "2"}, {"3", "4"}, {"5"}}},
+		{name: "fills every row when the width divides the buttons", columns: 5, want: [][]string{{"1", "2", "3", "4", "5"}}},
+		{name: "lays every button out in one row for a width below one", columns: 0, want: [][]string{{"1", "2", "3", "4", "5"}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			markup := api.NewInlineKeyboardMarkupGrid(tc.columns, buttons...)
+
+			got := make([][]string, 0, len(markup.InlineKeyboard))
+			for _, row := range markup.InlineKeyboard {
+				var labels []string
+				for _, button := range row {
+					labels = append(labels, button.Text)
+				}
+				got = append(got, labels)
+			}
+			assert.Equal(t, tc.want, got, "a grid must lay buttons out left to right in rows of its width")
+		})
+	}
+}
+
+func TestNewInlineKeyboardMarkupGrid_keepsRowsApart(t *testing.T) {
+	markup := api.NewInlineKeyboardMarkupGrid(1,
+		api.NewInlineKeyboardButtonCallbackData("a", "a"),
+		api.NewInlineKeyboardButtonCallbackData("b", "b"),
+	)
+
+	markup.InlineKeyboard[0] = append(markup.InlineKeyboard[0], api.NewInlineKeyboardButtonCallbackData("c", "c"))
+
+	assert.Equal(t, "b", markup.InlineKeyboard[1][0].Text, "growing one row of a grid must leave the next one as it was")
+}
+
+func TestNewInlineKeyboardButton_setsExactlyOneKind(t *testing.T) {
+	cases := []struct {
+		name   string
+		button api.InlineKeyboardButton
+		want   string
+	}{
+		{
+			name:   "a URL button",
+			button: api.NewInlineKeyboardButtonURL("Docs", "https://core.telegram.org/bots/api"),
+			want:   `{"text":"Docs","url":"https://core.telegram.org/bots/api"}`,
+		},
+		{
+			name:   "a Web App button",
+			button: api.NewInlineKeyboardButtonWebApp("Open", api.WebAppInfo{URL: "https://example.com/app"}),
+			want:   `{"text":"Open","web_app":{"url":"https://example.com/app"}}`,
+		},
+		{
+			name:   "an empty inline query",
+			button: api.NewInlineKeyboardButtonSwitchInlineQueryCurrentChat("Search", ""),
+			want:   `{"text":"Search","switch_inline_query_current_chat":""}`,
+		},
+		{
+			name:   "a game button",
+			button: api.NewInlineKeyboardButtonCallbackGame("Play", api.CallbackGame{}),
+			want:   `{"text":"Play","callback_game":{}}`,
+		},
+		{
+			name:   "a Pay button, which takes no value",
+			button: api.NewInlineKeyboardButtonPay("Pay 5 XTR"),
+			want:   `{"text":"Pay 5 XTR","pay":true}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.button)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(data), "a button constructor must set the field choosing its kind and no other")
+		})
+	}
+}
+
+func TestNewReplyKeyboardMarkup(t *testing.T) {
+	markup := api.NewReplyKeyboardMarkup(
+		api.NewKeyboardButtonRow(api.KeyboardButton{Text: "Yes"}, api.KeyboardButton{Text: "No"}),
+		api.NewKeyboardButtonRow(api.KeyboardButton{Text: "Cancel"}),
+	)
+	oneTime := true
+	markup.OneTimeKeyboard = &oneTime
+	method := api.NewSendMessageMethod(api.ID(42), "Sure?").WithReplyMarkup(markup)
+
+	data, err := json.Marshal(method)
+
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"chat_id":42,"text":"Sure?","reply_markup":{"keyboard":[[{"text":"Yes"},{"text":"No"}],[{"text":"Cancel"}]],"one_time_keyboard":true}}`,
+		string(data),
+		"a keyboard built from rows must be sent as the ReplyMarkup of a message",
+	)
+}
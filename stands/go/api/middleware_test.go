@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+// tally is a Counter remembering every call it counted.
+type tally struct {
+	counted []string
+}
+
+func (t *tally) Inc(method api.Method, outcome api.Outcome) {
+	t.counted = append(t.counted, string(method)+" "+string(outcome))
+}
+
+// journal is a Tracer remembering every span it opened and how each ended.
+type journal struct {
+	events []string
+}
+
+type journalKey struct{}
+
+func (j *journal) Start(ctx context.Context, method api.Method) (context.Context, api.Span) {
+	j.events = append(j.events, "start "+string(method))
+	return context.WithValue(ctx, journalKey{}, string(method)), entry{journal: j, method: method}
+}
+
+type entry struct {
+	journal *journal
+	method  api.Method
+}
+
+func (e entry) End(err error) {
+	if err != nil {
+		e.journal.events = append(e.journal.events, "end "+string(e.method)+" with "+err.Error())
+		return
+	}
+	e.journal.events = append(e.journal.events, "end "+string(e.method))
+}
+
+// recorder is a Connection recording the order the wrappers around it ran in.
+type recorder struct {
+	order *[]string
+	name  string
+	next  api.Connection
+}
+
+func (r recorder) Do(ctx context.Context, method api.Method, payload api.Payload, response any) error {
+	*r.order = append(*r.order, r.name)
+	return r.next.Do(ctx, method, payload, response)
+}
+
+func TestChain(t *testing.T) {
+	var order []string
+	wrap := func(name string) api.Middleware {
+		return func(next api.Connection) api.Connection {
+			return recorder{order: &order, name: name, next: next}
+		}
+	}
+	conn := api.Chain(
+		api.NewFakeConnection(api.NewCall("getMe", api.Ok(api.User{ID: 7}))),
+		wrap("outer"),
+		wrap("inner"),
+	)
+
+	_, err := api.GetMeMethod{}.Call(context.Background(), conn)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"outer", "inner"}, order, "the first middleware given must be the first to see a call")
+}
+
+func TestWithLogging(t *testing.T) {
+	cases := []struct {
+		name     string
+		response api.Response
+		check    func(*testing.T, string)
+	}{
+		{
+			name:     "logs a call that succeeded by its method",
+			response: api.Ok(api.User{ID: 7}),
+			check: func(t *testing.T, logged string) {
+				t.Helper()
+				assert.Contains(t, logged, "level=INFO", "a call that succeeded must be logged as information")
+				assert.Contains(t, logged, "method=getMe", "a call must be logged by the method it called")
+				assert.Contains(t, logged, "duration=", "a call must be logged with how long it took")
+			},
+		},
+		{
+			name:     "logs a refusal with the error and its outcome",
+			response: api.Err(&api.Error{Code: 403, Description: "Forbidden: bot was blocked by the user"}),
+			check: func(t *testing.T, logged string) {
+				t.Helper()
+				assert.Contains(t, logged, "level=ERROR", "a call that failed must be logged as an error")
+				assert.Contains(t, logged, "outcome=refused", "a refusal must be told apart from a call that got no answer")
+				assert.Contains(t, logged, "bot was blocked by the user", "a failure must be logged with what the API said")
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, nil))
+			conn := api.Chain(
+				api.NewFakeConnection(api.NewCall("getMe", tc.response)),
+				api.WithLogging(logger),
+			)
+
+			_, _ = api.GetMeMethod{}.Call(context.Background(), conn)
+
+			tc.check(t, buf.String())
+		})
+	}
+}
+
+func TestWithMetrics(t *testing.T) {
+	counter := &tally{}
+	conn := api.Chain(
+		api.NewFakeConnection(
+			api.NewCall("getMe", api.Ok(api.User{ID: 7})),
+			api.NewCall("getMe", api.Err(&api.Error{Code: 429, Description: "Too Many Requests"})),
+			api.NewCall("getMe", api.Err(errors.New("connection reset"))),
+		),
+		api.WithMetrics(counter),
+	)
+
+	for range 3 {
+		_, _ = api.GetMeMethod{}.Call(context.Background(), conn)
+	}
+
+	assert.Equal(t, []string{"getMe ok", "getMe refused", "getMe failed"}, counter.counted,
+		"every call must be counted under its method and the way it ended")
+}
+
+func TestWithTracing(t *testing.T) {
+	tracer := &journal{}
+	var seen string
+	conn := api.Chain(
+		api.NewFakeConnection(
+			api.NewCall("getMe", api.Ok(api.User{ID: 7})),
+			api.NewCall("getMe", api.Err(&api.Error{Code: 401, Description: "Unauthorized"})),
+		),
+		api.WithTracing(tracer),
+		func(next api.Connection) api.Connection {
+			return inspector{next: next, seen: &seen}
+		},
+	)
+
+	_, _ = api.GetMeMethod{}.Call(context.Background(), conn)
+	_, _ = api.GetMeMethod{}.Call(context.Background(), conn)
+
+	assert.Equal(t, []string{"start getMe", "end getMe", "start getMe", "end getMe with telegram 401: Unauthorized"}, tracer.events,
+		"every call must be traced by a span closed with the error it failed with")
+	assert.Equal(t, "getMe", seen, "the context a span was started in must be handed down to the call")
+}
+
+// inspector is a Connection reading the span the tracer left in the context.
+type inspector struct {
+	next api.Connection
+	seen *string
+}
+
+func (i inspector) Do(ctx context.Context, method api.Method, payload api.Payload, response any) error {
+	*i.seen, _ = ctx.Value(journalKey{}).(string)
+	return i.next.Do(ctx, method, payload, response)
+}
+
+func TestHTTPConnection_Do_redactsToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	server.Close()
+	conn := api.NewHTTPConnectionTo(server.Client(), api.NewDestination(server.URL, "42:SECRET"))
+
+	_, err := api.GetMeMethod{}.Call(context.Background(), conn)
+
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "SECRET", "a request that could not be sent must not give the token away")
+	assert.Contains(t, err.Error(), "/bot<token>/getMe", "a request that could not be sent must still name where it went")
+}
+
+func TestDestination_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("sending", "destination", api.NewDestination("https://api.telegram.org", "42:SECRET"))
+
+	assert.NotContains(t, buf.String(), "SECRET", "a logged destination must not give the token away")
+	assert.Contains(t, buf.String(), "destination.base=https://api.telegram.org", "a logged destination must name where it points")
+}
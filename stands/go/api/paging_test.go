@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"stand/api"
+)
+
+// paginator implements [api.Connection] by keeping the offset every request
+// asks for and answering it through the fake connection it wraps.
+type paginator struct {
+	fake    api.FakeConnection
+	offsets []int64
+}
+
+func (p *paginator) Do(ctx context.Context, method api.Method, payload api.Payload, response any) error {
+	req, err := payload.Request(ctx, http.MethodPost, "http://paginator")
+	if err != nil {
+		return fmt.Errorf("building request: %w", err)
+	}
+	var body struct {
+		Offset int64 `json:"offset"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return fmt.Errorf("reading request: %w", err)
+	}
+	p.offsets = append(p.offsets, body.Offset)
+	return p.fake.Do(ctx, method, payload, response)
+}
+
+// audios builds a page of audios identified by ids, out of total.
+func audios(total int64, ids ...string) api.UserProfileAudios {
+	page := api.UserProfileAudios{TotalCount: total, Audios: nil}
+	for _, id := range ids {
+		page.Audios = append(page.Audios, api.Audio{FileID: id})
+	}
+	return page
+}
+
+func TestAll_walksEveryPageUpToTheCount(t *testing.T) {
+	conn := &paginator{fake: api.NewFakeConnection(
+		api.NewCall("getUserProfileAudios", api.Ok(audios(5, "a", "b"))),
+		api.NewCall("getUserProfileAudios", api.Ok(audios(5, "c", "d"))),
+		api.NewCall("getUserProfileAudios", api.Ok(audios(5, "e"))),
+	)}
+
+	var got []string
+	for audio, err := range api.NewGetUserProfileAudiosMethod(7).WithLimit(2).All(context.Background(), conn) {
+		require.NoError(t, err)
+		got = append(got, audio.FileID)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, got, "a walk must yield every item of every page in order")
+	assert.Equal(t, []int64{0, 2, 4}, conn.offsets, "a walk must ask for each page past the items it has yielded, and stop at the count")
+}
+
+func TestAll_startsFromTheOffsetAsked(t *testing.T) {
+	conn := &paginator{fake: api.NewFakeConnection(
+		api.NewCall("getStarTransactions", api.Ok(api.StarTransactions{Transactions: []api.StarTransaction{{ID: "t11"}}})),
+		api.NewCall("getStarTransactions", api.Ok(api.StarTransactions{Transactions: nil})),
+	)}
+
+	var got []string
+	for transaction, err := range api.NewGetStarTransactionsMethod().WithOffset(10).All(context.Background(), conn) {
+		require.NoError(t, err)
+		got = append(got, transaction.ID)
+	}
+
+	assert.Equal(t, []string{"t11"}, got)
+	assert.Equal(t, []int64{10, 11}, conn.offsets, "a page counting nothing must be walked until one comes back empty")
+}
+
+func TestAll_fetchesNoPageItDoesNotReach(t *testing.T) {
+	conn := &paginator{fake: api.NewFakeConnection(
+		api.NewCall("getUserProfileAudios", api.Ok(audios(9, "a", "b", "c"))),
+	)}
+
+	for audio, err := range api.NewGetUserProfileAudiosMethod(7).All(context.Background(), conn) {
+		require.NoError(t, err)
+		if audio.FileID == "b" {
+			break
+		}
+	}
+
+	assert.Equal(t, []int64{0}, conn.offsets, "a walk stopped early must ask for no page past the one it stopped in")
+}
+
+func TestAll_endsWithTheErrorARequestFailsWith(t *testing.T) {
+	refusal := &api.Error{Code: 400, Description: "Bad Request: user not found"}
+	conn := &paginator{fake: api.NewFakeConnection(
+		api.NewCall("getUserProfileAudios", api.Ok(audios(4, "a", "b"))),
+		api.NewCall("getUserProfileAudios", api.Err(refusal)),
+	)}
+
+	var got []string
+	var failure error
+	for audio, err := range api.NewGetUserProfileAudiosMethod(7).All(context.Background(), conn) {
+		if err != nil {
+			failure = err
+			continue
+		}
+		got = append(got, audio.FileID)
+	}
+
+	assert.Equal(t, []string{"a", "b"}, got, "a walk must yield what it fetched before a request failed")
+	assert.ErrorIs(t, failure, refusal, "a walk must end with the error its request failed with")
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	reflection "stand/api"
+	api "stand/explicit"
+)
+
+// batch returns the result of a getUpdates holding every update of updates,
+// repeated until the batch holds a hundred, the most one call returns.
+func batch() []byte {
+	payloads := make([]string, 0, 100)
+	for len(payloads) < 100 {
+		for _, payload := range updates {
+			payloads = append(payloads, payload)
+		}
+	}
+	return []byte("[" + strings.Join(payloads[:100], ",") + "]")
+}
+
+// BenchmarkUnmarshalUpdates compares the two codecs reading a full batch of
+// updates, the way a bot polling for them reads every response.
+func BenchmarkUnmarshalUpdates(b *testing.B) {
+	data := batch()
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for range b.N {
+			var read []reflection.Update
+			if err := json.Unmarshal(data, &read); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("explicit", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for range b.N {
+			var read []api.Update
+			if err := json.Unmarshal(data, &read); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkUnmarshalUpdate compares the two codecs reading one update through
+// its own UnmarshalJSON, leaving out the pass encoding/json makes to check the
+// value before handing it over.
+func BenchmarkUnmarshalUpdate(b *testing.B) {
+	data := []byte(updates["a text message with entities and a keyboard"])
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for range b.N {
+			var read reflection.Update
+			if err := json.Unmarshal(data, &read); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("explicit", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for range b.N {
+			var read api.Update
+			if err := read.UnmarshalJSON(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkMarshalKeyboard compares the two codecs writing the reply markup a
+// bot sends with most of its messages.
+func BenchmarkMarshalKeyboard(b *testing.B) {
+	row := func(n int) ([]reflection.InlineKeyboardButton, []api.InlineKeyboardButton) {
+		want := make([]reflection.InlineKeyboardButton, n)
+		got := make([]api.InlineKeyboardButton, n)
+		for i := range n {
+			want[i] = reflection.InlineKeyboardButton{Text: "Option", CallbackData: ptr("pick:option")}
+			got[i] = api.InlineKeyboardButton{Text: "Option", CallbackData: ptr("pick:option")}
+		}
+		return want, got
+	}
+	var reflected reflection.InlineKeyboardMarkup
+	var explicit api.InlineKeyboardMarkup
+	for range 4 {
+		want, got := row(3)
+		reflected.InlineKeyboard = append(reflected.InlineKeyboard, want)
+		explicit.InlineKeyboard = append(explicit.InlineKeyboard, got)
+	}
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			if _, err := json.Marshal(reflected); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("explicit", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			if _, err := explicit.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reflection "stand/api"
+	api "stand/explicit"
+)
+
+// updates are payloads the API sends, each reaching a part of the codec the
+// others do not.
+var updates = map[string]string{
+	"a text message with entities and a keyboard": `{
+		"update_id": 10001,
+		"message": {
+			"message_id": 42,
+			"from": {"id": 7, "is_bot": false, "first_name": "Ann", "language_code": "en"},
+			"chat": {"id": 7, "type": "private", "first_name": "Ann"},
+			"date": 1760000000,
+			"text": "Hello, *world*",
+			"entities": [{"type": "bold", "offset": 7, "length": 7}],
+			"reply_markup": {"inline_keyboard": [[{"text": "Open", "url": "https://t.me"}], []]}
+		}
+	}`,
+	"a reply to a photo forwarded from a channel": `{
+		"update_id": 10002,
+		"message": {
+			"message_id": 43,
+			"chat": {"id": -100123, "type": "supergroup", "title": "Group"},
+			"date": 1760000001,
+			"forward_origin": {"type": "channel", "date": 1759999999, "chat": {"id": -100456, "type": "channel"}, "message_id": 9},
+			"photo": [
+				{"file_id": "AgAD", "file_unique_id": "u1", "width": 90, "height": 90, "file_size": 1024},
+				{"file_id": "AgAE", "file_unique_id": "u2", "width": 800, "height": 800}
+			],
+			"reply_to_message": {"message_id": 41, "chat": {"id": -100123, "type": "supergroup"}, "date": 1759999000, "text": "earlier"}
+		}
+	}`,
+	"a pinned message that is no longer accessible": `{
+		"update_id": 10003,
+		"message": {
+			"message_id": 44,
+			"chat": {"id": -100123, "type": "supergroup"},
+			"date": 1760000002,
+			"pinned_message": {"chat": {"id": -100123, "type": "supergroup"}, "message_id": 12, "date": 0}
+		}
+	}`,
+	"a callback query": `{
+		"update_id": 10004,
+		"callback_query": {
+			"id": "4382bfdwdsb323b2d9",
+			"from": {"id": 7, "is_bot": false, "first_name": "Ann"},
+			"message": {"message_id": 45, "chat": {"id": 7, "type": "private"}, "date": 1760000003, "text": "Pick"},
+			"chat_instance": "-3413545",
+			"data": "pick:1"
+		}
+	}`,
+	"a change of reactions": `{
+		"update_id": 10005,
+		"message_reaction": {
+			"chat": {"id": -100123, "type": "supergroup"},
+			"message_id": 46,
+			"date": 1760000004,
+			"old_reaction": [],
+			"new_reaction": [{"type": "emoji", "emoji": "👍"}, {"type": "custom_emoji", "custom_emoji_id": "5368324170671202286"}, {"type": "paid"}]
+		}
+	}`,
+	"a change of a chat member": `{
+		"update_id": 10006,
+		"chat_member": {
+			"chat": {"id": -100123, "type": "supergroup"},
+			"from": {"id": 1, "is_bot": false, "first_name": "Admin"},
+			"date": 1760000005,
+			"old_chat_member": {"status": "left", "user": {"id": 8, "is_bot": false, "first_name": "Bob"}},
+			"new_chat_member": {"status": "member", "user": {"id": 8, "is_bot": false, "first_name": "Bob"}, "until_date": 1770000000}
+		}
+	}`,
+	"text escaped every way JSON allows": `{
+		"update_id": 10007,
+		"message": {
+			"message_id": 47,
+			"chat": {"id": 7, "type": "private"},
+			"date": 1760000006,
+			"text": "quote \" slash \\ \/ tab \t line \n unicode é pair 😀 lone \ud800 end"
+		}
+	}`,
+	"nulls, unknown keys and whitespace": `  {
+		"update_id" : 10008 ,
+		"unknown": {"nested": [1, 2.5e3, true, null, "x"]},
+		"message": {
+			"message_id": 48,
+			"chat": {"id": 7, "type": "private", "title": null},
+			"date": 1760000007,
+			"entities": null,
+			"text": "still read"
+		},
+		"edited_message": null
+	}  `,
+}
+
+func TestUnmarshalJSON_readsWhatReflectionReads(t *testing.T) {
+	for name, payload := range updates {
+		t.Run(name, func(t *testing.T) {
+			var want reflection.Update
+			require.NoError(t, json.Unmarshal([]byte(payload), &want), "the reflection codec must read the payload")
+			var got api.Update
+			require.NoError(t, json.Unmarshal([]byte(payload), &got), "an explicit codec must read what the reflection codec reads")
+
+			wantJSON, err := json.Marshal(want)
+			require.NoError(t, err)
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(wantJSON), string(gotJSON), "an explicit codec must read every field the reflection codec reads")
+		})
+	}
+}
+
+func TestUnmarshalJSON_readsAVariantTheKeyNames(t *testing.T) {
+	var update api.Update
+	require.NoError(t, json.Unmarshal([]byte(updates["a change of reactions"]), &update))
+
+	reactions := update.MessageReaction.NewReaction
+	require.Len(t, reactions, 3, "a union must be read once per element of its array")
+	assert.Equal(t, api.ReactionTypeEmoji{Emoji: "👍"}, reactions[0], "a union must be read into the variant its key names")
+	assert.IsType(t, api.ReactionTypeCustomEmoji{}, reactions[1], "a union must be read into the variant its key names")
+	assert.IsType(t, api.ReactionTypePaid{}, reactions[2], "a union must be read into the variant its key names")
+	assert.NotNil(t, update.MessageReaction.OldReaction, "an empty array must be read as an empty slice, as encoding/json reads it")
+}
+
+func TestUnmarshalJSON_readsAUnionDecodedByHand(t *testing.T) {
+	var update api.Update
+	require.NoError(t, json.Unmarshal([]byte(updates["a pinned message that is no longer accessible"]), &update))
+
+	assert.IsType(t, api.InaccessibleMessage{}, update.Message.PinnedMessage,
+		"a union decoded by hand must be read through the decoder written for it")
+}
+
+func TestUnmarshalJSON_fails(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		want    string
+	}{
+		{
+			name:    "on a string where a number is due",
+			payload: `{"update_id": "10001"}`,
+			want:    `unexpected '"'`,
+		},
+		{
+			name:    "on a number too large for an integer",
+			payload: `{"update_id": 1e3}`,
+			want:    "cannot read 1e3 as an integer",
+		},
+		{
+			name:    "on a variant no key names",
+			payload: `{"update_id": 1, "message_reaction": {"new_reaction": [{"type": "sparkles"}]}}`,
+			want:    `unknown ReactionType "sparkles"`,
+		},
+		{
+			name:    "on a value cut short",
+			payload: `{"update_id": 1, "message": {"text": "Hel`,
+			want:    "unexpected end of input",
+		},
+		{
+			name:    "on anything after the value",
+			payload: `{"update_id": 1} {}`,
+			want:    "unexpected '{' at offset 17",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var update api.Update
+			err := update.UnmarshalJSON([]byte(tc.payload))
+			assert.ErrorContains(t, err, tc.want, "an explicit codec must refuse what it cannot read")
+		})
+	}
+}
+
+func TestMarshalJSON_writesWhatReflectionWrites(t *testing.T) {
+	text := "quote \" slash \\ <b>&</b> tab \t nul \x00 separator \u2028 invalid \xff é"
+	cases := []struct {
+		name string
+		want any
+		got  any
+	}{
+		{
+			name: "a keyboard of buttons",
+			want: reflection.InlineKeyboardMarkup{InlineKeyboard: [][]reflection.InlineKeyboardButton{
+				{{Text: "Open", URL: ptr("https://t.me")}, {Text: "Pick", CallbackData: ptr("pick:1")}},
+				{},
+			}},
+			got: api.InlineKeyboardMarkup{InlineKeyboard: [][]api.InlineKeyboardButton{
+				{{Text: "Open", URL: ptr("https://t.me")}, {Text: "Pick", CallbackData: ptr("pick:1")}},
+				{},
+			}},
+		},
+		{
+			name: "a discriminated object",
+			want: reflection.ReactionTypeCustomEmoji{CustomEmojiID: "5368324170671202286"},
+			got:  api.ReactionTypeCustomEmoji{CustomEmojiID: "5368324170671202286"},
+		},
+		{
+			name: "a discriminated object holding a union",
+			want: reflection.BotCommandScopeChat{ChatID: reflection.Username("@group")},
+			got:  api.BotCommandScopeChat{ChatID: api.Username("@group")},
+		},
+		{
+			name: "an object holding a union decoded by nothing",
+			want: reflection.InlineQueryResultArticle{
+				ID:                  "1",
+				Title:               "Article",
+				InputMessageContent: reflection.InputTextMessageContent{MessageText: text},
+			},
+			got: api.InlineQueryResultArticle{
+				ID:                  "1",
+				Title:               "Article",
+				InputMessageContent: api.InputTextMessageContent{MessageText: text},
+			},
+		},
+		{
+			name: "numbers of every size",
+			want: reflection.InputLocationMessageContent{Latitude: 1e-7, Longitude: -179.99999, HorizontalAccuracy: ptr(1e21)},
+			got:  api.InputLocationMessageContent{Latitude: 1e-7, Longitude: -179.99999, HorizontalAccuracy: ptr(1e21)},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			want, err := json.Marshal(tc.want)
+			require.NoError(t, err)
+			got, err := json.Marshal(tc.got)
+			require.NoError(t, err, "an explicit codec must write what the reflection codec writes")
+			assert.JSONEq(t, string(want), string(got), "an explicit codec must write what the reflection codec writes")
+		})
+	}
+}
+
+func TestMarshalJSON_roundTrips(t *testing.T) {
+	entities := []api.MessageEntity{
+		{Type: "text_link", Offset: 0, Length: 4, URL: ptr("https://t.me")},
+		{Type: "text_mention", Offset: 5, Length: 3, User: &api.User{ID: 7, FirstName: "Ann"}},
+	}
+
+	data, err := json.Marshal(entities)
+	require.NoError(t, err)
+	var read []api.MessageEntity
+	require.NoError(t, json.Unmarshal(data, &read))
+
+	assert.Equal(t, entities, read, "what an explicit codec writes it must read back unchanged")
+}
+
+// ptr returns a pointer to v, for the optional fields of a literal.
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,4 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+rootProject.name = "stand"
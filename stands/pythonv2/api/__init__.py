@@ -600,6 +600,13 @@ from .api import (
     RichTextPlain,
     RichTextSequence,
 )
+from .formatting import (
+    FormattedText,
+    escape_html,
+    escape_markdown_v2,
+    escape_markdown_v2_code,
+    escape_markdown_v2_link,
+)
 
 __all__ = [
     "TELEGRAM_API",
@@ -1196,4 +1203,9 @@ __all__ = [
     "True_",
     "RichTextPlain",
     "RichTextSequence",
+    "FormattedText",
+    "escape_html",
+    "escape_markdown_v2",
+    "escape_markdown_v2_code",
+    "escape_markdown_v2_link",
 ]
@@ -7,6 +7,7 @@
 from __future__ import annotations
 
 import json
+from collections.abc import Iterator
 from dataclasses import dataclass
 from typing import IO, Annotated, Any, Literal, Protocol, TypeVar
 
@@ -114,7 +115,8 @@ class HTTPConnection:
         """Executes method and returns what it answered with, raising Error when
         the API reports a failure."""
         request = payload.request("POST", self._destination.url(method))
-        envelope = _Envelope.model_validate(self._client.send(request).json())
+        response = self._client.send(request)
+        envelope = TypeAdapter(_Envelope).validate_python(response.json())
         return adapter.validate_python(envelope.result())
 
 
@@ -9897,6 +9899,24 @@ class GetUserProfilePhotosMethod(BaseModel):
             TypeAdapter(UserProfilePhotos),
         )
 
+    def all(self, conn: Connection) -> Iterator[list[PhotoSize]]:
+        """Yields the items getUserProfilePhotos hands out, starting from the offset
+        the model asks for and fetching the next page only once the last one is
+        used up. A failed request raises the error it failed with.
+        """
+        body = _dump(self)
+        offset = self.offset or 0
+        while True:
+            page = conn.do(
+                "getUserProfilePhotos",
+                _JSONPayload({**body, "offset": offset}),
+                TypeAdapter(UserProfilePhotos),
+            )
+            yield from page.photos
+            offset += len(page.photos)
+            if not page.photos or offset >= page.total_count:
+                return
+
 
 class GetUserProfileAudiosMethod(BaseModel):
     """Use this method to get a list of profile audios for a user. Returns
@@ -9928,6 +9948,24 @@ class GetUserProfileAudiosMethod(BaseModel):
             TypeAdapter(UserProfileAudios),
         )
 
+    def all(self, conn: Connection) -> Iterator[Audio]:
+        """Yields the items getUserProfileAudios hands out, starting from the offset
+        the model asks for and fetching the next page only once the last one is
+        used up. A failed request raises the error it failed with.
+        """
+        body = _dump(self)
+        offset = self.offset or 0
+        while True:
+            page = conn.do(
+                "getUserProfileAudios",
+                _JSONPayload({**body, "offset": offset}),
+                TypeAdapter(UserProfileAudios),
+            )
+            yield from page.audios
+            offset += len(page.audios)
+            if not page.audios or offset >= page.total_count:
+                return
+
 
 class SetUserEmojiStatusMethod(BaseModel):
     """Changes the emoji status for a given user that previously allowed
@@ -18229,6 +18267,24 @@ class GetStarTransactionsMethod(BaseModel):
             TypeAdapter(StarTransactions),
         )
 
+    def all(self, conn: Connection) -> Iterator[StarTransaction]:
+        """Yields the items getStarTransactions hands out, starting from the offset
+        the model asks for and fetching the next page only once the last one is
+        used up. A failed request raises the error it failed with.
+        """
+        body = _dump(self)
+        offset = self.offset or 0
+        while True:
+            page = conn.do(
+                "getStarTransactions",
+                _JSONPayload({**body, "offset": offset}),
+                TypeAdapter(StarTransactions),
+            )
+            yield from page.transactions
+            offset += len(page.transactions)
+            if not page.transactions:
+                return
+
 
 class RefundStarPaymentMethod(BaseModel):
     """Refunds a successful payment in Telegram Stars. Returns True on
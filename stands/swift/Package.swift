@@ -0,0 +1,13 @@
+// swift-tools-version:5.9
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+import PackageDescription
+
+let package = Package(
+    name: "Stand",
+    platforms: [.macOS(.v12), .iOS(.v15)],
+    targets: [
+        .target(name: "Telegram", path: "Sources/Telegram"),
+    ]
+)
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Alias represents the C# declaration of a name tgen gives a type the
+// documentation leaves unnamed: a positional record wrapping the type, and the
+// converter encoding it as the type alone. A using alias would be shorter, but
+// it names nothing an interface can be implemented by, and every alias tgen
+// introduces today exists to be a variant of a union.
+type Alias struct {
+	inner   ir.Alias
+	lineage Lineage
+}
+
+// NewAlias creates an Alias from the record of an alias and the lineage of the
+// sequence it stands in.
+func NewAlias(a ir.Alias, lineage Lineage) Alias {
+	return Alias{inner: a, lineage: lineage}
+}
+
+// Doc returns the documentation comment of the declaration. An alias carries
+// no link back to the documentation: tgen introduces it, so no section
+// documents it.
+func (a Alias) Doc() string {
+	return NewTypeXMLDoc(a.inner.Description, "").Value()
+}
+
+// Ref implements [Declaration].
+func (a Alias) Ref() string {
+	return string(a.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (a Alias) Template() string {
+	return "alias"
+}
+
+// Name returns the C# name the alias declares.
+func (a Alias) Name() string {
+	return NewName(a.inner.Name).Value()
+}
+
+// Supertypes returns the base list naming the unions admitting the alias.
+func (a Alias) Supertypes() string {
+	return a.lineage.Supertypes(a.inner.Name)
+}
+
+// Type returns the C# type expression the record wraps.
+func (a Alias) Type() string {
+	return NewRequiredType(a.inner.Type).Value()
+}
+
+// Direction returns which way the alias travels. The declaration itself is the
+// same either way; what a block written by hand puts beside it is not.
+func (a Alias) Direction() Direction {
+	return NewDirection(a.inner.Direction)
+}
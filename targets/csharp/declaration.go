@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"fmt"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Declaration represents one declaration of the generated namespace. The file
+// walking the sequence knows only two things about it: the template rendering
+// its shape and the reference a block written by hand claims it by. What that
+// template reads is the business of the view behind it.
+type Declaration interface {
+	// Ref returns the reference the declaration is addressed by. A block written
+	// by hand claims the declaration by that reference, which no target respells.
+	Ref() string
+	// Template returns the name of the template rendering the declaration.
+	Template() string
+}
+
+// NewDeclaration creates the declaration one record of the pipeline's exit is
+// rendered as, knowing the lineage of the sequence it stands in.
+func NewDeclaration(record ir.Definition, lineage Lineage) Declaration {
+	switch record := record.(type) {
+	case ir.Object:
+		return NewObject(record, lineage)
+	case ir.DiscriminatedObject:
+		return NewDiscriminatedObject(record, lineage)
+	case ir.Union:
+		return NewUnion(record, lineage)
+	case ir.DiscriminatedUnion:
+		return NewDiscriminatedUnion(record, lineage)
+	case ir.Alias:
+		return NewAlias(record, lineage)
+	case ir.Method:
+		return NewMethod(record)
+	default:
+		panic(fmt.Sprintf("csharp: unknown definition %T", record))
+	}
+}
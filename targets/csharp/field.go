@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Field represents the C# declaration of a field an object owns or a
+// parameter a method takes: an init-only property of a record, which knows the
+// name of the record declaring it.
+type Field struct {
+	inner ir.Field
+	owner string
+}
+
+// NewField creates a Field from the record of a field declared by the record
+// named owner.
+func NewField(f ir.Field, owner string) Field {
+	return Field{inner: f, owner: owner}
+}
+
+// Doc returns the documentation comment of the property.
+func (f Field) Doc() string {
+	return NewPropertyXMLDoc(f.inner.Description).Value()
+}
+
+// Name returns the C# name the property declares. C# forbids a member spelled
+// as the type declaring it, which a field named after its object would be —
+// force_reply of ForceReply — so such a property is suffixed with Value. The
+// key it is encoded under stays what it was.
+func (f Field) Name() string {
+	name := NewPropertyName(f.inner.Key).Value()
+	if name == f.owner {
+		return name + "Value"
+	}
+	return name
+}
+
+// Key returns the key the property is encoded under. Every property names it,
+// since no key the documentation writes is spelled the way a property is.
+func (f Field) Key() string {
+	return string(f.inner.Key)
+}
+
+// Type returns the C# type expression of the property.
+func (f Field) Type() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Value()
+}
+
+// Modifier returns what the property is marked with ahead of its type.
+func (f Field) Modifier() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Modifier()
+}
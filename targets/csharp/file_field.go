@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets"
+)
+
+// filed represents a file field as the C# declaration holding it reads it:
+// the shared [targets.FileField], with the property named the C# way.
+type filed struct {
+	targets.FileField
+}
+
+// Name returns the C# name of the property.
+func (f filed) Name() string {
+	return NewPropertyName(model.Key(f.Key())).Value()
+}
+
+// Placed represents a parameter of a method that reaches a file.
+type Placed struct {
+	filed
+}
+
+// NewPlaced creates a Placed from the record of a parameter reaching a file.
+func NewPlaced(f ir.FileField) Placed {
+	return Placed{filed: filed{FileField: targets.NewFileField(f)}}
+}
+
+// Template returns the name of the template handing the file over.
+func (p Placed) Template() string {
+	return p.Placement()
+}
+
+// Attached represents a field of an object that reaches a file.
+type Attached struct {
+	filed
+}
+
+// NewAttached creates an Attached from the record of a field reaching a file.
+func NewAttached(f ir.FileField) Attached {
+	return Attached{filed: filed{FileField: targets.NewFileField(f)}}
+}
+
+// Template returns the name of the template handing the file over.
+func (a Attached) Template() string {
+	return a.Attachment()
+}
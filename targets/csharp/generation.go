@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"github.com/andreychh/tgen/targets"
+)
+
+// Generation is one run that writes a C# namespace: the specification the
+// files are rendered from, the namespace they declare, and the tgen that wrote
+// them. It is the root every template renders against, and reaches the
+// specification through Spec.
+type Generation struct {
+	spec      Specification
+	namespace string
+	snapshot  targets.Snapshot
+}
+
+// NewGeneration creates a Generation rendering spec into the namespace named
+// namespace, stamped with snapshot.
+func NewGeneration(spec Specification, namespace string, snapshot targets.Snapshot) Generation {
+	return Generation{spec: spec, namespace: namespace, snapshot: snapshot}
+}
+
+// Spec returns the specification the files are rendered from.
+func (g Generation) Spec() Specification {
+	return g.spec
+}
+
+// Namespace returns the fully qualified name of the namespace the generated
+// files declare.
+func (g Generation) Namespace() string {
+	return g.namespace
+}
+
+// Snapshot returns the metadata of the run: when it happened and which tgen
+// performed it.
+func (g Generation) Snapshot() targets.Snapshot {
+	return g.snapshot
+}
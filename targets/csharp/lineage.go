@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets"
+)
+
+// Lineage represents what the declarations of the namespace know about one
+// another and C# needs said at the declaration itself: the unions admitting
+// each type, and the value each object a union tells apart is told apart by. A
+// union is an interface here, and C# implements an interface by naming it in
+// the base list of the implementing type, so every record has to know the
+// unions listing it.
+type Lineage struct {
+	inner targets.Lineage
+}
+
+// NewLineage creates a Lineage from every record of the pipeline's exit.
+func NewLineage(records []ir.Definition) Lineage {
+	return Lineage{inner: targets.NewLineage(records)}
+}
+
+// Supertypes returns the base list naming the interfaces of the unions
+// admitting the type, in the order their records came, empty when no union
+// admits it.
+func (l Lineage) Supertypes(name model.Name) string {
+	unions := l.inner.Unions(name)
+	if len(unions) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(unions))
+	for _, union := range unions {
+		names = append(names, NewName(union).Value())
+	}
+	return " : " + strings.Join(names, ", ")
+}
+
+// Discriminator returns the field the type is told apart by, and false when it
+// is not an object a union tells apart.
+func (l Lineage) Discriminator(name model.Name) (Discriminator, bool) {
+	d, ok := l.inner.Discriminator(name)
+	if !ok {
+		return Discriminator{}, false
+	}
+	return NewDiscriminator(d.Key, d.Value), true
+}
+
+// Discriminator represents the key an object is told apart under and the fixed
+// value it holds there.
+type Discriminator struct {
+	key   model.Key
+	value model.DiscriminatorValue
+}
+
+// NewDiscriminator creates a Discriminator from a key and the value held under
+// it.
+func NewDiscriminator(key model.Key, value model.DiscriminatorValue) Discriminator {
+	return Discriminator{key: key, value: value}
+}
+
+// Key returns the JSON key the value is written under.
+func (d Discriminator) Key() string {
+	return string(d.key)
+}
+
+// Value returns the value the object is told apart by.
+func (d Discriminator) Value() string {
+	return string(d.value)
+}
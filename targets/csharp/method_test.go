@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets/csharp"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+func TestMethod_Name(t *testing.T) {
+	method := csharp.NewMethod(targettest.Record[ir.Method](t, "getme"))
+	assert.Equal(t, "GetMeMethod", method.Name(), "Method.Name must keep a request apart from the object it answers with")
+	assert.Equal(t, "getMe", method.Wire(), "Method.Wire must call the endpoint by its documented name")
+}
+
+func TestMethod_Payload(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  model.Reference
+		want string
+	}{
+		{name: "sends no body for a method taking nothing", ref: "getme", want: "payload_empty"},
+		{name: "encodes a method taking parameters as JSON", ref: "sendmessage", want: "payload_json"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, csharp.NewMethod(targettest.Record[ir.Method](t, tc.ref)).Payload().Template(),
+				"Method.Payload must send the parameters the way the method takes them")
+		})
+	}
+}
+
+func TestMethod_Return(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  model.Reference
+		want string
+	}{
+		{name: "decodes the value a method answers with", ref: "getme", want: "return_value"},
+		{name: "returns nothing from a method answering with a confirmation", ref: "logout", want: "return_command"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, csharp.NewMethod(targettest.Record[ir.Method](t, tc.ref)).Return().Template(),
+				"Method.Return must decode what the method answers with")
+		})
+	}
+}
+
+func TestMethod_Fields(t *testing.T) {
+	method := csharp.NewMethod(targettest.Record[ir.Method](t, "sendmessage"))
+	assert.Equal(
+		t,
+		[]property{
+			{name: "ChatId", key: "chat_id", typ: "long", modifier: "required "},
+			{name: "Text", key: "text", typ: "string", modifier: "required "},
+			{name: "ReplyMarkup", key: "reply_markup", typ: "ReplyMarkup?", modifier: ""},
+		},
+		properties(method.Fields()),
+		"Method.Fields must declare the parameters the method takes",
+	)
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/iancoleman/strcase"
+)
+
+// acronyms holds the initialisms a capitalization leaves half-spelled, each
+// mapped to the spelling the other targets give it. The .NET guidelines would
+// write the longer ones as words — Url, Api — but a type is what a reader of
+// one target looks the same type up by in another, so the spelling of a type
+// follows theirs rather than the guidelines.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var acronyms = map[string]string{
+	"Id":  "ID",
+	"Url": "URL",
+	"Api": "API",
+	"Ip":  "IP",
+}
+
+// Name represents a documentation name rendered as the name of a C# type.
+type Name struct {
+	inner model.Name
+}
+
+// NewName creates a Name from a documentation name.
+func NewName(n model.Name) Name {
+	return Name{inner: n}
+}
+
+// Value returns the name in the capitalized words a type is declared by, with
+// the acronyms spelled in capitals.
+func (n Name) Value() string {
+	camel := strcase.ToCamel(string(n.inner))
+	for wrong, right := range acronyms {
+		camel = strings.ReplaceAll(camel, wrong, right)
+	}
+	return camel
+}
+
+// PropertyName represents a field key rendered as the name of a C# property.
+// It stands apart from [Name] because the two are spelled by different rules:
+// a property writes an initialism as a word, ChatId rather than ChatID, which
+// is what every .NET API a reader calls beside this one does. A property opens
+// in a capital, so unlike a Kotlin one it never collides with a keyword.
+type PropertyName struct {
+	key model.Key
+}
+
+// NewPropertyName creates a PropertyName from a field key.
+func NewPropertyName(k model.Key) PropertyName {
+	return PropertyName{key: k}
+}
+
+// Value returns the key in Pascal case.
+func (n PropertyName) Value() string {
+	return strcase.ToCamel(string(n.key))
+}
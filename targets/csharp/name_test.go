@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/targets/csharp"
+)
+
+func TestName_Value(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Name
+		want  string
+	}{
+		{name: "capitalizes a method name", input: "getMe", want: "GetMe"},
+		{name: "keeps an object name as documented", input: "ReplyKeyboardRemove", want: "ReplyKeyboardRemove"},
+		{name: "spells an initialism in capitals", input: "WebhookInfoUrl", want: "WebhookInfoURL"},
+		{name: "spells an identifier in capitals", input: "ChatId", want: "ChatID"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, csharp.NewName(tc.input).Value(),
+				"Name.Value must spell a type the way the other targets spell it")
+		})
+	}
+}
+
+func TestPropertyName_Value(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Key
+		want  string
+	}{
+		{name: "capitalizes a single word key", input: "text", want: "Text"},
+		{name: "writes a snake case key in Pascal case", input: "first_name", want: "FirstName"},
+		{name: "writes an identifier as a word", input: "chat_id", want: "ChatId"},
+		{name: "clears a word C# reserves by its capital", input: "params", want: "Params"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, csharp.NewPropertyName(tc.input).Value(),
+				"PropertyName.Value must spell a property the way .NET spells one")
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+	"github.com/andreychh/tgen/targets/csharp"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+// property is what a record says of one of its properties.
+type property struct {
+	name     string
+	key      string
+	typ      string
+	modifier string
+}
+
+// properties returns what the record says of each of fields.
+func properties(fields []csharp.Field) []property {
+	return slices.NewMapped(fields, func(f csharp.Field) property {
+		return property{name: f.Name(), key: f.Key(), typ: f.Type(), modifier: f.Modifier()}
+	})
+}
+
+func TestObject_Fields(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  model.Reference
+		want []property
+	}{
+		{
+			name: "requires a required field and leaves an optional one nullable",
+			ref:  "user",
+			want: []property{
+				{name: "Id", key: "id", typ: "long", modifier: "required "},
+				{name: "FirstName", key: "first_name", typ: "string", modifier: "required "},
+				{name: "Username", key: "username", typ: "string?", modifier: ""},
+			},
+		},
+		{
+			name: "renames a property that would share the name of its record",
+			ref:  "forcereply",
+			want: []property{
+				{name: "ForceReplyValue", key: "force_reply", typ: "bool", modifier: "required "},
+			},
+		},
+	}
+	lineage := csharp.NewLineage(targettest.Records(t))
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			object := csharp.NewObject(targettest.Record[ir.Object](t, tc.ref), lineage)
+			assert.Equal(t, tc.want, properties(object.Fields()),
+				"Object.Fields must declare the properties of the record as C# lets it")
+		})
+	}
+}
+
+func TestObject_Supertypes(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  model.Reference
+		want string
+	}{
+		{name: "names nothing for an object no union admits", ref: "user", want: ""},
+		{name: "names the union admitting a variant", ref: "replykeyboardremove", want: " : ReplyMarkup"},
+	}
+	lineage := csharp.NewLineage(targettest.Records(t))
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			object := csharp.NewObject(targettest.Record[ir.Object](t, tc.ref), lineage)
+			assert.Equal(t, tc.want, object.Supertypes(),
+				"Object.Supertypes must tie an object to the unions admitting it")
+		})
+	}
+}
+
+func TestObject_Direction(t *testing.T) {
+	object := csharp.NewObject(
+		targettest.Record[ir.Object](t, "user"),
+		csharp.NewLineage(targettest.Records(t)),
+	)
+	assert.True(t, object.Direction().Received(), "an object a response carries must read itself")
+	assert.False(t, object.Direction().Sent(), "an object no request carries must not write itself")
+}
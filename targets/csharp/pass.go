@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"embed"
+	"fmt"
+	"text/template"
+
+	"github.com/andreychh/tgen/output"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// Pass is the C# generation stage: it renders the records of the pipeline's
+// exit into the files of a C# namespace.
+type Pass struct {
+	gen Generation
+}
+
+// NewPass creates a Pass rendering the given generation.
+func NewPass(gen Generation) Pass {
+	return Pass{gen: gen}
+}
+
+// Artifacts returns the files the target writes, each bound to the template
+// rendering it. C# ties no file to the types it declares, so the two are named
+// after what they hold, the way the Kotlin target names its own. It fails when
+// a template is malformed.
+func (p Pass) Artifacts() (output.Artifacts, error) {
+	tmpl, err := output.NewMold(templates, template.FuncMap{}).Template()
+	if err != nil {
+		return nil, fmt.Errorf("preparing template: %w", err)
+	}
+	return output.Artifacts{
+		"Api.cs":    output.NewTemplateView(tmpl, "api", p.gen),
+		"Client.cs": output.NewTemplateView(tmpl, "client", p.gen),
+	}, nil
+}
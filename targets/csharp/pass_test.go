@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets/csharp"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+func TestPass_Artifacts(t *testing.T) {
+	artifacts, err := csharp.NewPass(
+		csharp.NewGeneration(
+			csharp.NewSpecification(ir.NewSpecification(targettest.Specification())),
+			"Example.Bot",
+			targettest.Snapshot(),
+		),
+	).Artifacts()
+	require.NoError(t, err, "Pass must write the test bot")
+	files := targettest.Render(t, artifacts)
+	assert.ElementsMatch(
+		t,
+		[]string{"Api.cs", "Client.cs"},
+		slices.Collect(maps.Keys(files)),
+		"Pass must write the declarations and the transport as two files",
+	)
+	cases := []struct {
+		name string
+		file string
+		want string
+	}{
+		{name: "declares the namespace it is given", file: "Client.cs", want: "namespace Example.Bot;\n"},
+		{name: "stamps the release the specification was read from", file: "Api.cs", want: "//     Bot API 10.2\n"},
+		{name: "declares every record", file: "Api.cs", want: "public sealed record LogOutMethod"},
+		{
+			name: "refuses to read a union only a request carries",
+			file: "Api.cs",
+			want: "throw new JsonException(\"ReplyMarkup is only ever sent, never read\");",
+		},
+		{
+			name: "writes the transport every method is called through",
+			file: "Client.cs",
+			want: "public sealed class HttpConnection(HttpClient client, Destination destination) : IConnection",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, files[tc.file], tc.want, "Pass must write %s as C# reads it", tc.file)
+		})
+	}
+}
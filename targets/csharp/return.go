@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"fmt"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Return represents the response slot of a generated method: the template that
+// renders its call. Every response decodes through the contract of its type,
+// whatever that type is — a union reads itself through its converter, which
+// spares this target the dispatching returns the Go one needs.
+//
+//sumtype:decl
+type Return interface {
+	// Template returns the name of the template rendering the call.
+	Template() string
+
+	isReturn()
+}
+
+// Command represents a return that only signals success: the call returns
+// nothing, and the true the API answers with is read and dropped.
+type Command struct{}
+
+// NewCommand creates a Command.
+func NewCommand() Command {
+	return Command{}
+}
+
+// Template implements [Return].
+func (Command) Template() string {
+	return "return_command"
+}
+
+func (Command) isReturn() {}
+
+// Value represents a return carrying a type, which the call hands back.
+type Value struct {
+	typ Type
+}
+
+// NewValue creates a Value from a response type.
+func NewValue(typ Type) Value {
+	return Value{typ: typ}
+}
+
+// Template implements [Return].
+func (Value) Template() string {
+	return "return_value"
+}
+
+// Type returns the rendered response type.
+func (v Value) Type() string {
+	return v.typ.Value()
+}
+
+func (Value) isReturn() {}
+
+// Result represents the C# view of what a method returns.
+type Result struct {
+	inner ir.Result
+}
+
+// NewResult creates a Result from a resolved method result.
+func NewResult(r ir.Result) Result {
+	return Result{inner: r}
+}
+
+// Return returns the [Return] variant rendering the result.
+func (r Result) Return() Return {
+	switch inner := r.inner.(type) {
+	case ir.Confirmation:
+		return NewCommand()
+	case ir.Value:
+		return NewValue(NewRequiredType(inner.Type()))
+	default:
+		panic(fmt.Sprintf("csharp: unknown result %T", inner))
+	}
+}
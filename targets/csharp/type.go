@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Type represents a C# type expression rendered from a resolved type and the
+// optionality of whatever carries it.
+type Type struct {
+	typ typebound.Type
+	opt model.Optionality
+}
+
+// NewType creates a Type from a resolved type and its optionality.
+func NewType(typ typebound.Type, opt model.Optionality) Type {
+	return Type{typ: typ, opt: opt}
+}
+
+// NewRequiredType creates a Type from a resolved type no optionality applies
+// to, such as the return of a method.
+func NewRequiredType(typ typebound.Type) Type {
+	return NewType(typ, false)
+}
+
+// Value returns the C# type expression: the name of the atom, enclosed in one
+// IReadOnlyList per dimension, and nullable when the caller may leave it unset.
+// The annotation means the same to the reader whatever it annotates — a
+// nullable reference type and a nullable value type are spelled alike — so a
+// single value and a list are made optional the same way.
+func (t Type) Value() string {
+	value := t.Name()
+	for range t.typ.Dimensionality() {
+		value = "IReadOnlyList<" + value + ">"
+	}
+	if t.opt {
+		return value + "?"
+	}
+	return value
+}
+
+// Modifier returns what the property declaring the type is marked with, empty
+// when the caller may leave it unset. A property the caller has to name is
+// required, which the compiler holds an initializer to and System.Text.Json
+// holds a response to.
+func (t Type) Modifier() string {
+	if t.opt {
+		return ""
+	}
+	return "required "
+}
+
+// Name returns the C# name of the atom the type holds, without the lists
+// enclosing it.
+func (t Type) Name() string {
+	switch atom := t.typ.Atom().(type) {
+	case typebound.Primitive:
+		return builtin(atom.Kind())
+	case typebound.Object:
+		return NewName(atom.Name()).Value()
+	case typebound.Union:
+		return NewName(atom.Name()).Value()
+	case typebound.Alias:
+		return NewName(atom.Name()).Value()
+	default:
+		panic(fmt.Sprintf("csharp: unknown atom %T", atom))
+	}
+}
+
+// builtin returns the C# type a built-in of the documentation renders as. An
+// integer is a long, since the identifiers the Bot API hands out outgrow 32
+// bits.
+func builtin(kind primitive.Kind) string {
+	switch kind {
+	case primitive.Integer:
+		return "long"
+	case primitive.Float:
+		return "double"
+	case primitive.String:
+		return "string"
+	case primitive.Boolean, primitive.True:
+		return "bool"
+	default:
+		panic(fmt.Sprintf("csharp: unknown primitive %q", kind))
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Union represents the C# declaration of a union nothing tells the variants of
+// apart: the interface, and the converter it is read and written with. The
+// converter writes whichever variant it holds as that variant, but it reads
+// nothing on its own. Which variant a payload holds is not written anywhere
+// the specification can be read from, so a union any response carries is
+// decoded by a method written by hand, in the block claiming this reference,
+// and one none carries refuses to be read at all.
+type Union struct {
+	inner   ir.Union
+	lineage Lineage
+}
+
+// NewUnion creates a Union from the record of a union and the lineage of the
+// sequence it stands in.
+func NewUnion(u ir.Union, lineage Lineage) Union {
+	return Union{inner: u, lineage: lineage}
+}
+
+// Doc returns the documentation comment of the declaration, closing with a
+// link back to the section the union was read from.
+func (u Union) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u Union) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u Union) Template() string {
+	return "union"
+}
+
+// Name returns the C# name the union declares.
+func (u Union) Name() string {
+	return NewName(u.inner.Name).Value()
+}
+
+// Supertypes returns the base list naming the unions admitting this one.
+func (u Union) Supertypes() string {
+	return u.lineage.Supertypes(u.inner.Name)
+}
+
+// Carrier reports whether a file is reached through the union, which obliges
+// every variant to answer for the rewrite the union dispatches.
+func (u Union) Carrier() bool {
+	return u.inner.Carrier
+}
+
+// Variants returns the types the union stands for, in the order the
+// documentation listed them. A variant some other union tells apart keeps the
+// value it is told apart by, since writing it as itself would lose the value.
+func (u Union) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.Variant) Variant {
+		d, ok := u.lineage.Discriminator(v.Name)
+		if !ok {
+			return NewVariant(v.Name, nil)
+		}
+		return NewVariant(v.Name, &d)
+	})
+}
+
+// Direction returns which way the union travels, which is what decides whether
+// its converter reads anything at all.
+func (u Union) Direction() Direction {
+	return NewDirection(u.inner.Direction)
+}
+
+// Variant represents one variant of a union: the type it names, and the value
+// it is told apart by where something tells it apart.
+type Variant struct {
+	name          model.Name
+	discriminator *Discriminator
+}
+
+// NewVariant creates a Variant naming a type, told apart by discriminator
+// unless that is nil.
+func NewVariant(name model.Name, discriminator *Discriminator) Variant {
+	return Variant{name: name, discriminator: discriminator}
+}
+
+// Name returns the C# name of the type the variant names.
+func (v Variant) Name() string {
+	return NewName(v.name).Value()
+}
+
+// Discriminator returns the field the type is told apart by, nil when nothing
+// tells it apart.
+func (v Variant) Discriminator() *Discriminator {
+	return v.discriminator
+}
+
+// DiscriminatedUnion represents the C# declaration of a union one key tells
+// every variant of apart: an interface System.Text.Json reads and writes on
+// its own, told the key and the value every variant is registered under.
+type DiscriminatedUnion struct {
+	inner   ir.DiscriminatedUnion
+	lineage Lineage
+}
+
+// NewDiscriminatedUnion creates a DiscriminatedUnion from the record of a
+// discriminated union and the lineage of the sequence it stands in.
+func NewDiscriminatedUnion(u ir.DiscriminatedUnion, lineage Lineage) DiscriminatedUnion {
+	return DiscriminatedUnion{inner: u, lineage: lineage}
+}
+
+// Doc returns the documentation comment of the declaration, closing with a
+// link back to the section the union was read from.
+func (u DiscriminatedUnion) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u DiscriminatedUnion) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u DiscriminatedUnion) Template() string {
+	return "discriminated_union"
+}
+
+// Name returns the C# name the union declares.
+func (u DiscriminatedUnion) Name() string {
+	return NewName(u.inner.Name).Value()
+}
+
+// Supertypes returns the base list naming the unions admitting this one.
+func (u DiscriminatedUnion) Supertypes() string {
+	return u.lineage.Supertypes(u.inner.Name)
+}
+
+// Key returns the JSON key the variants are told apart under.
+func (u DiscriminatedUnion) Key() string {
+	return string(u.inner.Key)
+}
+
+// Carrier reports whether a file is reached through the union, which obliges
+// every variant to answer for the rewrite the union dispatches.
+func (u DiscriminatedUnion) Carrier() bool {
+	return u.inner.Carrier
+}
+
+// Variants returns the types the union stands for, in the order the
+// documentation listed them, each told apart by the value the discriminators
+// the pipeline decoded hold for it under the key of the union.
+func (u DiscriminatedUnion) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.DiscriminatedVariant) Variant {
+		d := NewDiscriminator(u.inner.Key, v.Value)
+		return NewVariant(v.Name, &d)
+	})
+}
+
+// Direction returns which way the union travels. The declaration is the same
+// either way, since System.Text.Json derives both halves of the contract from
+// the registrations.
+func (u DiscriminatedUnion) Direction() Direction {
+	return NewDirection(u.inner.Direction)
+}
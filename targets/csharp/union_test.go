@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+	"github.com/andreychh/tgen/targets/csharp"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+func TestUnion_Variants(t *testing.T) {
+	union := csharp.NewUnion(
+		targettest.Record[ir.Union](t, "replymarkup"),
+		csharp.NewLineage(targettest.Records(t)),
+	)
+	variants := union.Variants()
+	assert.Equal(
+		t,
+		[]string{"ForceReply", "ReplyKeyboardRemove"},
+		slices.NewMapped(variants, csharp.Variant.Name),
+		"Union.Variants must name the types the union admits in the order they were listed",
+	)
+	for _, variant := range variants {
+		assert.Nil(t, variant.Discriminator(), "a variant no key tells apart must write itself without one")
+	}
+}
+
+func TestUnion_Direction(t *testing.T) {
+	union := csharp.NewUnion(
+		targettest.Record[ir.Union](t, "replymarkup"),
+		csharp.NewLineage(targettest.Records(t)),
+	)
+	assert.True(t, union.Direction().Outbound(), "a union only a request carries must travel outbound")
+	assert.False(t, union.Direction().Received(), "a union no response carries must refuse to be read")
+}
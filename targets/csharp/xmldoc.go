@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package csharp
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/mitchellh/go-wordwrap"
+)
+
+// width is the room a line has, indentation and comment markers counted in.
+// C# sets no limit of its own; this is the one the editors most C# is written
+// in draw their guide at.
+const width = 120
+
+// indentation is what one level of nesting indents a line by.
+const indentation = "    "
+
+// marker is what every line of a documentation comment opens with.
+const marker = "/// "
+
+// XMLDoc represents a prose passage rendered as a documentation comment: the
+// summary the compiler reads into the XML documentation file, a paragraph per
+// block and a bullet list per list, followed by a link to wherever the
+// definition is documented, when one is given. The first line carries no
+// indentation, since whatever declares the documented name has already
+// written it.
+type XMLDoc struct {
+	passage prose.Passage
+	see     string
+	indent  int
+}
+
+// NewXMLDoc creates an XMLDoc rendering a passage at an indentation depth,
+// closed by a link to see unless see is empty.
+func NewXMLDoc(passage prose.Passage, see string, indent int) XMLDoc {
+	return XMLDoc{passage: passage, see: see, indent: indent}
+}
+
+// NewTypeXMLDoc creates an XMLDoc for a type declared in the namespace itself.
+func NewTypeXMLDoc(passage prose.Passage, see string) XMLDoc {
+	return NewXMLDoc(passage, see, 0)
+}
+
+// NewPropertyXMLDoc creates an XMLDoc for a property declared in a record. A
+// field is described by a table cell, which holds inline prose only, so its
+// one phrase becomes the single paragraph of a passage.
+func NewPropertyXMLDoc(phrase prose.Phrase) XMLDoc {
+	return NewXMLDoc(prose.NewPassage(prose.NewParagraph(phrase.Inlines()...)), "", 1)
+}
+
+// Value returns the comment, empty when the passage writes no prose and no
+// link closes it. A block that writes nothing takes no line, so an empty
+// paragraph leaves no trace rather than an empty paragraph element. A summary
+// folding to a single line is written on that line alone, which is how most
+// properties end up commented.
+func (d XMLDoc) Value() string {
+	room := width - len(indentation)*d.indent - len(marker)
+	lines := make([]string, 0)
+	for _, block := range d.passage.Blocks() {
+		written := d.block(block, room, len(lines) > 0)
+		lines = append(lines, written...)
+	}
+	out := make([]string, 0, len(lines)+3)
+	switch {
+	case len(lines) == 0:
+	case len(lines) == 1 && utf8.RuneCountInString(lines[0]) <= room-len("<summary></summary>"):
+		out = append(out, "<summary>"+lines[0]+"</summary>")
+	default:
+		out = append(out, "<summary>")
+		out = append(out, lines...)
+		out = append(out, "</summary>")
+	}
+	if d.see != "" {
+		out = append(out, `<seealso href="`+escape(d.see)+`"/>`)
+	}
+	return d.comment(out)
+}
+
+// block returns the lines one block occupies within room. The first paragraph
+// is the summary itself; every later one is set apart as a paragraph element,
+// since the compiler joins lines the comment leaves bare into one paragraph.
+func (d XMLDoc) block(block prose.Block, room int, later bool) []string {
+	switch block := block.(type) {
+	case prose.Paragraph:
+		lines := wrap(text(block.Inlines()), room, "", "")
+		if len(lines) == 0 || !later {
+			return lines
+		}
+		return append(append([]string{"<para>"}, lines...), "</para>")
+	case prose.List:
+		lines := make([]string, 0, len(block.Items())+2)
+		for _, item := range block.Items() {
+			written := wrap(text(item.Inlines()), room-len("<item><description>"), "<item><description>", "")
+			if len(written) == 0 {
+				continue
+			}
+			written[len(written)-1] += "</description></item>"
+			lines = append(lines, written...)
+		}
+		if len(lines) == 0 {
+			return nil
+		}
+		return append(append([]string{`<list type="bullet">`}, lines...), "</list>")
+	default:
+		return nil
+	}
+}
+
+// comment returns the lines each opened by the comment marker, every line
+// after the first indented to the depth the declaration sits at.
+func (d XMLDoc) comment(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	pad := strings.Repeat(indentation, d.indent)
+	out := make([]string, 0, len(lines))
+	for at, line := range lines {
+		prefix := pad
+		if at == 0 {
+			prefix = ""
+		}
+		out = append(out, strings.TrimRight(prefix+marker+line, " "))
+	}
+	return strings.Join(out, "\n")
+}
+
+// text returns the plain text of inline content, escaped for the XML the
+// comment is read as. A link contributes its text alone: the anchor it
+// addresses is not yet resolved to the name a cref would have to spell.
+func text(inlines []prose.Inline) string {
+	var out strings.Builder
+	for _, inline := range inlines {
+		switch inline := inline.(type) {
+		case prose.Text:
+			out.WriteString(inline.Content())
+		case prose.Link:
+			out.WriteString(inline.Content())
+		case prose.LineBreak:
+			out.WriteString("\n")
+		}
+	}
+	return escape(out.String())
+}
+
+// escape returns content with the characters XML reserves written as the
+// entities standing for them. The documentation writes placeholders in angle
+// brackets — attach://<file_attach_name> — which would otherwise be read as
+// elements nothing closes.
+func escape(content string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(content)
+}
+
+// wrap returns content folded to the given width, opening with first and
+// continuing with rest. A forced line break in the content starts a new line of
+// its own. Content with nothing to read folds to no lines at all.
+func wrap(content string, width int, first, rest string) []string {
+	if strings.TrimSpace(content) == "" {
+		return nil
+	}
+	out := make([]string, 0)
+	for segment := range strings.SplitSeq(content, "\n") {
+		folded := wordwrap.WrapString(segment, uint(width))
+		for line := range strings.SplitSeq(folded, "\n") {
+			out = append(out, rest+line)
+		}
+	}
+	out[0] = first + strings.TrimPrefix(out[0], rest)
+	return out
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package targets
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// FileField represents what every file field holds whatever hands its file over
+// and whatever language hands it: the key of the body it is written back under,
+// and the template handing it over. Whatever hands the file over edits the body
+// the declaration was encoded into, so a method and an object write the same
+// statements and differ only in what a file is handed over as. The Kotlin, C#
+// and Swift targets name those templates alike and spell only the name of the
+// property their own way.
+type FileField struct {
+	inner ir.FileField
+}
+
+// NewFileField creates a FileField from the record of a field reaching a file.
+func NewFileField(f ir.FileField) FileField {
+	return FileField{inner: f}
+}
+
+// Key returns the encoded key of the property.
+func (f FileField) Key() string {
+	return string(f.inner.Key)
+}
+
+// Array reports whether the property holds a list, each element of which hands
+// its own file over.
+func (f FileField) Array() bool {
+	return f.inner.Type.Dimensionality() > 0
+}
+
+// Optional reports whether the caller may leave the property unset, which
+// leaves nothing to hand over.
+func (f FileField) Optional() bool {
+	return bool(f.inner.Optionality)
+}
+
+// Placement returns the name of the template handing the file over from a
+// parameter of a method. A parameter owns a key of the request, so its file
+// travels in a part named by that key and leaves behind whatever the file
+// places there, if anything.
+func (f FileField) Placement() string {
+	if f.file() {
+		return "file_place"
+	}
+	return f.carrying()
+}
+
+// Attachment returns the name of the template handing the file over from a
+// field of an object. An object sits inside a value of the request and owns no
+// key of it, so its file travels in a part under a generated key and leaves
+// behind a reference to that key.
+func (f FileField) Attachment() string {
+	if f.file() {
+		return "file_attach"
+	}
+	return f.carrying()
+}
+
+// file reports whether the property is the file itself rather than something
+// holding one inside.
+func (f FileField) file() bool {
+	return f.inner.Kind == model.FileKindFile
+}
+
+// carrying returns the name of the template handing over the file a property
+// holds inside. Such a property rewrites itself into JSON wherever it sits, so
+// where it sits does not change the answer.
+func (f FileField) carrying() string {
+	if f.Array() {
+		return "file_resolve_array"
+	}
+	return "file_resolve"
+}
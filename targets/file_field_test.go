@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package targets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/typebound"
+	"github.com/andreychh/tgen/model/typeform"
+	"github.com/andreychh/tgen/targets"
+)
+
+func TestFileField_Template(t *testing.T) {
+	cases := []struct {
+		name       string
+		kind       model.FileKind
+		atom       typebound.Atom
+		dims       typeform.Dimensionality
+		placement  string
+		attachment string
+	}{
+		{
+			name:       "hands a file over in a part of its own",
+			kind:       model.FileKindFile,
+			atom:       typebound.NewObject("InputFile"),
+			placement:  "file_place",
+			attachment: "file_attach",
+		},
+		{
+			name:       "resolves the file something holds inside",
+			kind:       model.FileKindCarrier,
+			atom:       typebound.NewUnion("InputMedia"),
+			placement:  "file_resolve",
+			attachment: "file_resolve",
+		},
+		{
+			name:       "resolves the file each element of a list holds inside",
+			kind:       model.FileKindCarrier,
+			atom:       typebound.NewUnion("InputMedia"),
+			dims:       1,
+			placement:  "file_resolve_array",
+			attachment: "file_resolve_array",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := targets.NewFileField(ir.FileField{
+				Field: ir.Field{Key: "media", Type: typebound.NewType(tc.atom, tc.dims)},
+				Kind:  tc.kind,
+			})
+			assert.Equal(t, tc.placement, f.Placement(),
+				"FileField.Placement must hand the file of a parameter over the way the field holds it")
+			assert.Equal(t, tc.attachment, f.Attachment(),
+				"FileField.Attachment must hand the file of an object over the way the field holds it")
+		})
+	}
+}
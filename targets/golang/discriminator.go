@@ -27,6 +27,12 @@ func (d Discriminator) Name() string {
 	return NewNameFromKey(d.inner.Key).Value()
 }
 
+// Key returns the JSON key the field is written under, quoted as a Go string
+// literal.
+func (d Discriminator) Key() string {
+	return fmt.Sprintf("%q", d.inner.Key)
+}
+
 // Type returns the Go type of the field. A discriminating value is a keyword
 // the documentation writes as text, so Go declares it a string.
 func (d Discriminator) Type() string {
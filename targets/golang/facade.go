@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package golang
+
+import (
+	"github.com/andreychh/tgen/output"
+)
+
+// Facade is the Go stage that writes, beside the files of a [Pass], a Bot
+// calling every method as a Go method of its own, the BotAPI interface it
+// satisfies, and a MockBot satisfying the same interface for tests.
+type Facade struct {
+	pass Pass
+}
+
+// NewFacade creates a Facade adding the Bot to what pass renders.
+func NewFacade(pass Pass) Facade {
+	return Facade{pass: pass}
+}
+
+// Artifacts returns the files of the package with bot.go and mock.go added.
+//
+// The facade is optional because it adds nothing a method cannot do on its
+// own: Bot.SendMessage is SendMessageMethod.Call with the Connection held
+// rather than passed. What it buys is an interface a service can depend on,
+// which the method structs cannot offer, each having a Call of its own
+// signature. It fails when a template is malformed.
+func (f Facade) Artifacts() (output.Artifacts, error) {
+	artifacts, err := f.pass.Artifacts()
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := f.pass.template()
+	if err != nil {
+		return nil, err
+	}
+	artifacts["bot.go"] = output.NewTemplateView(tmpl, "bot", f.pass.gen)
+	artifacts["mock.go"] = output.NewTemplateView(tmpl, "mock", f.pass.gen)
+	return artifacts, nil
+}
@@ -28,6 +28,29 @@ func (f Field) Name() string {
 	return NewNameFromKey(f.inner.Key).Value()
 }
 
+// Param returns the name the field is taken under as a parameter of a
+// constructor or a setter.
+func (f Field) Param() string {
+	return NewNameFromKey(f.inner.Key).Local()
+}
+
+// Optional reports whether the field may be left unset.
+func (f Field) Optional() bool {
+	return bool(f.inner.Optionality)
+}
+
+// Pointer reports whether the field holds its value behind a pointer, which a
+// setter takes the address of rather than asking the caller to.
+func (f Field) Pointer() bool {
+	return NewType(f.inner.Type, f.inner.Optionality).Pointer()
+}
+
+// Argument returns the Go type a setter takes the field as: the type of the
+// field without the pointer an optional single value is held behind.
+func (f Field) Argument() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Pointee()
+}
+
 // Type returns the Go type expression of the field.
 func (f Field) Type() string {
 	return NewType(f.inner.Type, f.inner.Optionality).Value()
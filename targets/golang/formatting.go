@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package golang
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/formatting"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// builderMethods are the names FormattedText declares whatever the page lists,
+// which no kind of entity may be spelled as.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var builderMethods = map[string]bool{"Text": true, "Entities": true, "Plain": true, "Append": true}
+
+// Formatting represents the Go view of format.go: the builder marking a text
+// with entities of every kind MessageEntity lists, and the escapers of the two
+// parse modes that spell the same marks in the text itself.
+type Formatting struct {
+	gen    Generation
+	entity formatting.Entity
+}
+
+// NewFormatting creates a Formatting rendered within gen from entity.
+func NewFormatting(gen Generation, entity formatting.Entity) Formatting {
+	return Formatting{gen: gen, entity: entity}
+}
+
+// Generation returns the run the file is rendered within.
+func (f Formatting) Generation() Generation {
+	return f.gen
+}
+
+// Entity returns the Go name MessageEntity is declared under.
+func (f Formatting) Entity() string {
+	return NewName(f.entity.Name()).Value()
+}
+
+// Kinds returns the kinds of entity the builder marks a text with, in the order
+// the page lists them. It fails when the page cannot be read as a list of kinds,
+// or a kind is spelled as a method the builder declares on its own.
+func (f Formatting) Kinds() ([]EntityKind, error) {
+	kinds, err := f.entity.Kinds()
+	if err != nil {
+		return nil, fmt.Errorf("reading kinds of entity: %w", err)
+	}
+	out := slices.NewMapped(kinds, NewEntityKind)
+	for _, kind := range out {
+		if builderMethods[kind.Method()] {
+			return nil, fmt.Errorf("kind of entity %q is spelled %s, which FormattedText declares already", kind.inner.Value, kind.Method())
+		}
+	}
+	return out, nil
+}
+
+// EntityKind represents one kind of entity as the builder method marking a text
+// with it.
+type EntityKind struct {
+	inner formatting.Kind
+}
+
+// NewEntityKind creates an EntityKind from a kind of entity.
+func NewEntityKind(kind formatting.Kind) EntityKind {
+	return EntityKind{inner: kind}
+}
+
+// Value returns the value the type of the entity takes, quoted as a Go string
+// literal.
+func (k EntityKind) Value() string {
+	return fmt.Sprintf("%q", k.inner.Value)
+}
+
+// Label returns the value as the documentation quotes it, for the doc comment.
+func (k EntityKind) Label() string {
+	return "“" + k.inner.Value + "”"
+}
+
+// Method returns the name of the builder method marking a text with the kind.
+func (k EntityKind) Method() string {
+	return NewName(model.Name(k.inner.Value)).Value()
+}
+
+// Fields returns the fields of MessageEntity reserved to the kind, which the
+// builder method takes after the text it marks.
+func (k EntityKind) Fields() []Field {
+	return slices.NewMapped(k.inner.Fields, NewField)
+}
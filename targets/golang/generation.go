@@ -8,19 +8,20 @@ import (
 )
 
 // Generation is one run that writes a Go package: the specification the files
-// are rendered from, the package they declare, and the tgen that wrote them. It
-// is the root every template renders against, and reaches the specification
-// through Spec.
+// are rendered from, the package they declare, how they marshal JSON, and the
+// tgen that wrote them. It is the root every template renders against, and
+// reaches the specification through Spec.
 type Generation struct {
-	spec     Specification
-	pkg      string
-	snapshot targets.Snapshot
+	spec       Specification
+	pkg        string
+	marshaling Marshaling
+	snapshot   targets.Snapshot
 }
 
 // NewGeneration creates a Generation rendering spec into the package named
-// pkg, stamped with snapshot.
-func NewGeneration(spec Specification, pkg string, snapshot targets.Snapshot) Generation {
-	return Generation{spec: spec, pkg: pkg, snapshot: snapshot}
+// pkg, marshaling JSON the way marshaling says, stamped with snapshot.
+func NewGeneration(spec Specification, pkg string, marshaling Marshaling, snapshot targets.Snapshot) Generation {
+	return Generation{spec: spec, pkg: pkg, marshaling: marshaling, snapshot: snapshot}
 }
 
 // Spec returns the specification the files are rendered from.
@@ -33,6 +34,11 @@ func (g Generation) Package() string {
 	return g.pkg
 }
 
+// Marshaling returns how the generated declarations marshal JSON.
+func (g Generation) Marshaling() Marshaling {
+	return g.marshaling
+}
+
 // Snapshot returns the metadata of the run: when it happened and which tgen
 // performed it.
 func (g Generation) Snapshot() targets.Snapshot {
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package golang
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model/keyboard"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/typebound"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Keyboards represents the Go view of keyboard.go: the builders laying buttons
+// out in the keyboards ReplyMarkup admits, and the constructors of every kind
+// of button whose record demands exactly one of its fields.
+type Keyboards struct {
+	gen       Generation
+	keyboards []keyboard.Keyboard
+}
+
+// NewKeyboards creates a Keyboards rendered within gen from keyboards.
+func NewKeyboards(gen Generation, keyboards []keyboard.Keyboard) Keyboards {
+	return Keyboards{gen: gen, keyboards: keyboards}
+}
+
+// Generation returns the run the file is rendered within.
+func (k Keyboards) Generation() Generation {
+	return k.gen
+}
+
+// Values returns the keyboards, in the order ReplyMarkup lists them.
+func (k Keyboards) Values() []Keyboard {
+	return slices.NewMapped(k.keyboards, NewKeyboard)
+}
+
+// Kinds returns the constructors of every kind of button the keyboards lay
+// out, each button taken once however many keyboards hold it. It fails when a
+// record cannot be read as the kinds it stands for, or a constructor would be
+// spelled as a builder the file declares already.
+func (k Keyboards) Kinds() ([]ButtonKind, error) {
+	taken := make(map[string]bool)
+	for _, kb := range k.Values() {
+		taken[kb.Constructor()] = true
+		taken[kb.Grid()] = true
+		taken[kb.Row()] = true
+	}
+	seen := make(map[string]bool)
+	var out []ButtonKind
+	for _, kb := range k.Values() {
+		if seen[kb.Button()] {
+			continue
+		}
+		seen[kb.Button()] = true
+		kinds, err := kb.kinds()
+		if err != nil {
+			return nil, err
+		}
+		for _, kind := range kinds {
+			if taken[kind.Name()] {
+				return nil, fmt.Errorf("kind of button %s is spelled %s, which keyboard.go declares already", kind.field.inner.Key, kind.Name())
+			}
+		}
+		out = append(out, kinds...)
+	}
+	return out, nil
+}
+
+// Keyboard represents one keyboard as the builders laying its buttons out.
+type Keyboard struct {
+	inner keyboard.Keyboard
+}
+
+// NewKeyboard creates a Keyboard from the record of a keyboard.
+func NewKeyboard(kb keyboard.Keyboard) Keyboard {
+	return Keyboard{inner: kb}
+}
+
+// Markup returns the Go name the keyboard is declared under.
+func (k Keyboard) Markup() string {
+	return NewName(k.inner.Markup.Name).Value()
+}
+
+// Rows returns the field the keyboard holds its rows of buttons in.
+func (k Keyboard) Rows() Field {
+	return NewField(k.inner.Rows)
+}
+
+// Button returns the Go name the buttons of the keyboard are declared under.
+func (k Keyboard) Button() string {
+	return NewName(k.inner.Button.Name()).Value()
+}
+
+// Constructor returns the name of the builder laying out rows as given.
+func (k Keyboard) Constructor() string {
+	return "New" + k.Markup()
+}
+
+// Grid returns the name of the builder laying out buttons in rows of a width.
+func (k Keyboard) Grid() string {
+	return k.Constructor() + "Grid"
+}
+
+// Row returns the name of the builder gathering buttons into one row.
+func (k Keyboard) Row() string {
+	return "New" + k.Button() + "Row"
+}
+
+// kinds returns the constructors of the kinds of the keyboard's button, and
+// none when its record demands no field of it.
+func (k Keyboard) kinds() ([]ButtonKind, error) {
+	fields, ok, err := k.inner.Button.Kinds()
+	if err != nil {
+		return nil, fmt.Errorf("reading kinds of button: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	required := slices.NewMapped(k.inner.Button.Required(), NewField)
+	out := make([]ButtonKind, 0, len(fields))
+	for _, field := range fields {
+		out = append(out, NewButtonKind(k.Button(), required, NewField(field)))
+	}
+	return out, nil
+}
+
+// ButtonKind represents the constructor of one kind of button: it takes the
+// fields every button requires and the one field choosing the kind, and sets
+// no other field choosing one, which is what makes the button it returns one
+// the API accepts.
+type ButtonKind struct {
+	button   string
+	required []Field
+	field    Field
+}
+
+// NewButtonKind creates a ButtonKind for the button named button, requiring
+// required, of the kind field chooses.
+func NewButtonKind(button string, required []Field, field Field) ButtonKind {
+	return ButtonKind{button: button, required: required, field: field}
+}
+
+// Button returns the Go name of the button the constructor returns.
+func (k ButtonKind) Button() string {
+	return k.button
+}
+
+// Name returns the name of the constructor: the button's own, followed by the
+// field choosing the kind.
+func (k ButtonKind) Name() string {
+	return "New" + k.button + k.field.Name()
+}
+
+// Required returns the fields every button requires whatever its kind.
+func (k ButtonKind) Required() []Field {
+	return k.required
+}
+
+// Field returns the field choosing the kind.
+func (k ButtonKind) Field() Field {
+	return k.field
+}
+
+// Flag reports whether the field is a boolean a kind is chosen by setting,
+// which the constructor sets to true rather than taking as a parameter: a
+// button with the flag false would be of no kind at all.
+func (k ButtonKind) Flag() bool {
+	atom, ok := k.field.inner.Type.Atom().(typebound.Primitive)
+	if !ok || k.field.inner.Type.Dimensionality() != 0 {
+		return false
+	}
+	return atom.Kind() == primitive.Boolean || atom.Kind() == primitive.True
+}
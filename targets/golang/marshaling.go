@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package golang
+
+import (
+	"fmt"
+)
+
+// Marshaling is how the generated declarations are turned into JSON and back.
+// The declarations themselves, the transport and everything client.go holds are
+// the same either way: a marshaling is a directory of templates spelling the
+// codec names the shared templates invoke beside every declaration, and the
+// views those templates read are the same for both.
+type Marshaling string
+
+const (
+	// Reflection leaves a declaration to encoding/json wherever encoding/json
+	// can read and write it on its own, and writes by hand only what it cannot:
+	// the constant a discriminated object carries and the variant a union holds.
+	// It is the default, and the smaller of the two packages.
+	Reflection Marshaling = "reflection"
+	// Explicit writes MarshalJSON and UnmarshalJSON out for every object and
+	// union that travels, reading a payload token by token and writing it byte
+	// by byte, so that encoding/json never walks a generated type by reflection.
+	// It is meant for a bot decoding updates faster than reflection keeps up.
+	Explicit Marshaling = "explicit"
+)
+
+// NewMarshaling returns the marshaling named name. It fails on a name no
+// marshaling answers to.
+func NewMarshaling(name string) (Marshaling, error) {
+	switch marshaling := Marshaling(name); marshaling {
+	case Reflection, Explicit:
+		return marshaling, nil
+	default:
+		return "", fmt.Errorf("unknown marshaling %q, want reflection or explicit", name)
+	}
+}
+
+// dir returns the directory of templates spelling the marshaling.
+func (m Marshaling) dir() string {
+	return string(m)
+}
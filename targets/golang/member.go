@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package golang
+
+import (
+	"fmt"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Member represents a field as an explicit codec reads and writes it: the key
+// it travels under and the Go statements moving its value between the struct
+// and the JSON. The statements are written against the names the codec
+// templates declare — the object as o, the reader as r, the writer as w — and
+// mean nothing anywhere else.
+type Member struct {
+	inner ir.Field
+}
+
+// NewMember creates a Member from the record of a field.
+func NewMember(f ir.Field) Member {
+	return Member{inner: f}
+}
+
+// Key returns the JSON key the field travels under, quoted as a Go string
+// literal.
+func (m Member) Key() string {
+	return fmt.Sprintf("%q", m.inner.Key)
+}
+
+// Optional reports whether the field may be left out of the JSON, which the
+// writer does whenever it is not Present.
+func (m Member) Optional() bool {
+	return bool(m.inner.Optionality)
+}
+
+// Present returns the Go condition telling whether an optional field holds
+// something to write. It answers what omitempty answers for the same field: a
+// pointer or a union is absent when nil, and a slice when it holds nothing.
+func (m Member) Present() string {
+	if m.typ().Array() {
+		return fmt.Sprintf("len(%s) > 0", m.field())
+	}
+	return m.field() + " != nil"
+}
+
+// Read returns the Go statement reading the value at the reader into the
+// field. An optional single value is read behind a pointer, which stays nil
+// when the key holds null; an object, optional or not, is read in place rather
+// than copied in once read.
+func (m Member) Read() string {
+	c := newCoding(m.inner.Type)
+	switch {
+	case m.typ().Pointer() && c.object():
+		return fmt.Sprintf("%s = readOptionalObject[%s](r)", m.field(), c.name())
+	case m.typ().Pointer():
+		return fmt.Sprintf("%s = readPointer(r, %s)", m.field(), c.reader())
+	case c.object():
+		return m.field() + ".readJSON(r)"
+	default:
+		return fmt.Sprintf("%s = %s", m.field(), c.read())
+	}
+}
+
+// Write returns the Go statement writing the field at the writer. It is only
+// ever run for a field that is Present, so a pointer is dereferenced without a
+// check; an object behind one writes itself through it.
+func (m Member) Write() string {
+	c := newCoding(m.inner.Type)
+	switch {
+	case m.typ().Pointer() && c.object():
+		return c.write(m.field())
+	case m.typ().Pointer():
+		return c.write("*" + m.field())
+	default:
+		return c.write(m.field())
+	}
+}
+
+// field returns the Go expression naming the field on the object.
+func (m Member) field() string {
+	return "o." + NewNameFromKey(m.inner.Key).Value()
+}
+
+// typ returns the Go type the field is declared with.
+func (m Member) typ() Type {
+	return NewType(m.inner.Type, m.inner.Optionality)
+}
+
+// coding spells how the explicit codec reads and writes a value of one
+// resolved type, as both a Go expression and a Go function value. The two are
+// needed apart: a field is read by an expression, while the element of a slice
+// is read by a function the slice helpers call once per element, and a slice
+// of slices nests one such function inside another.
+//
+// A built-in is read and written by the method of the reader or the writer
+// named after it, an object by the readJSON and writeJSON it declares, a union
+// by its read function and the writer's dynamic fallback, and an alias by what
+// it stands for, converted on the way.
+type coding struct {
+	typ typebound.Type
+}
+
+// newCoding creates a coding for a value of typ.
+func newCoding(typ typebound.Type) coding {
+	return coding{typ: typ}
+}
+
+// object reports whether the value is a single object, which is read and
+// written through methods of its own rather than by a helper.
+func (c coding) object() bool {
+	_, object := c.typ.Atom().(typebound.Object)
+	return object && c.typ.Dimensionality() == 0
+}
+
+// objects reports whether the value is a slice of objects, which is read with
+// every element in place.
+func (c coding) objects() bool {
+	return c.typ.Dimensionality() == 1 && c.element().object()
+}
+
+// read returns the Go expression reading the value from r.
+func (c coding) read() string {
+	if c.objects() {
+		return fmt.Sprintf("readObjects[%s](r)", c.element().name())
+	}
+	if c.typ.Dimensionality() > 0 {
+		return fmt.Sprintf("readSlice(r, %s)", c.element().reader())
+	}
+	switch atom := c.typ.Atom().(type) {
+	case typebound.Primitive:
+		return fmt.Sprintf("r.%s()", builtin(atom.Kind()))
+	case typebound.Object:
+		return fmt.Sprintf("readObject[%s](r)", c.name())
+	case typebound.Union:
+		return fmt.Sprintf("read%s(r)", c.name())
+	case typebound.Alias:
+		return fmt.Sprintf("%s(%s)", c.name(), newCoding(atom.Under()).read())
+	default:
+		panic(fmt.Sprintf("golang: unknown atom %T", atom))
+	}
+}
+
+// reader returns the Go function value reading the value from a reader it is
+// handed, the form a slice helper calls.
+func (c coding) reader() string {
+	if c.objects() {
+		return fmt.Sprintf("readObjects[%s]", c.element().name())
+	}
+	if c.typ.Dimensionality() == 0 {
+		switch atom := c.typ.Atom().(type) {
+		case typebound.Primitive:
+			return "(*jsonReader)." + builtin(atom.Kind())
+		case typebound.Object:
+			return fmt.Sprintf("readObject[%s]", c.name())
+		case typebound.Union:
+			return "read" + c.name()
+		}
+	}
+	return fmt.Sprintf("func(r *jsonReader) %s { return %s }", c.name(), c.read())
+}
+
+// write returns the Go statement writing value to w.
+func (c coding) write(value string) string {
+	if c.typ.Dimensionality() > 0 {
+		return fmt.Sprintf("writeSlice(w, %s, %s)", value, c.element().writer())
+	}
+	switch atom := c.typ.Atom().(type) {
+	case typebound.Primitive:
+		return fmt.Sprintf("w.%s(%s)", builtin(atom.Kind()), value)
+	case typebound.Object:
+		return value + ".writeJSON(w)"
+	case typebound.Union:
+		return fmt.Sprintf("w.any(%s)", value)
+	case typebound.Alias:
+		under := newCoding(atom.Under())
+		return under.write(fmt.Sprintf("%s(%s)", under.name(), value))
+	default:
+		panic(fmt.Sprintf("golang: unknown atom %T", atom))
+	}
+}
+
+// writer returns the Go function value writing the value to a writer it is
+// handed, the form a slice helper calls.
+func (c coding) writer() string {
+	if c.typ.Dimensionality() == 0 {
+		switch atom := c.typ.Atom().(type) {
+		case typebound.Primitive:
+			return "(*jsonWriter)." + builtin(atom.Kind())
+		case typebound.Object:
+			return fmt.Sprintf("writeObject[%s]", c.name())
+		case typebound.Union:
+			return fmt.Sprintf("writeUnion[%s]", c.name())
+		}
+	}
+	return fmt.Sprintf("func(w *jsonWriter, v %s) { %s }", c.name(), c.write("v"))
+}
+
+// element returns the coding of what the slice holds, one dimension down.
+func (c coding) element() coding {
+	return newCoding(typebound.NewType(c.typ.Atom(), c.typ.Dimensionality()-1))
+}
+
+// name returns the Go type expression of the value.
+func (c coding) name() string {
+	return NewRequiredType(c.typ).Value()
+}
@@ -43,6 +43,13 @@ func (m Method) Name() string {
 	return NewName(m.inner.Name).Value() + "Method"
 }
 
+// Operation returns the documented name as Go spells an exported one, without
+// the suffix Name adds: the name the Bot facade calls the method by, where
+// nothing else is declared to keep it apart from.
+func (m Method) Operation() string {
+	return NewName(m.inner.Name).Value()
+}
+
 // Wire returns the name the endpoint is called by.
 func (m Method) Wire() string {
 	return string(m.inner.Name)
@@ -54,6 +61,30 @@ func (m Method) Fields() []Field {
 	return slices.NewMapped(m.inner.Params, NewField)
 }
 
+// Required returns the parameters the method cannot be called without, which
+// its constructor takes, in the order the documentation listed them.
+func (m Method) Required() []Field {
+	var required []Field
+	for _, field := range m.Fields() {
+		if !field.Optional() {
+			required = append(required, field)
+		}
+	}
+	return required
+}
+
+// Setters returns a setter for every parameter the method may be called
+// without, in the order the documentation listed them.
+func (m Method) Setters() []Setter {
+	var setters []Setter
+	for _, field := range m.Fields() {
+		if field.Optional() {
+			setters = append(setters, NewSetter(m.Name(), field))
+		}
+	}
+	return setters
+}
+
 // Return returns the response slot of the method.
 func (m Method) Return() Return {
 	return NewResult(m.inner.Result).Return()
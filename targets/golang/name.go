@@ -4,6 +4,7 @@
 package golang
 
 import (
+	"go/token"
 	"strings"
 
 	"github.com/andreychh/tgen/model"
@@ -42,3 +43,17 @@ func (n Name) Value() string {
 	}
 	return camel
 }
+
+// Local returns the name in Go's unexported camel case, the way a parameter is
+// spelled, with the acronyms past its first word restored as Value restores
+// them. A name that is a Go keyword, such as type, takes a trailing underscore.
+func (n Name) Local() string {
+	camel := strcase.ToLowerCamel(string(n.inner))
+	for wrong, right := range acronyms {
+		camel = strings.ReplaceAll(camel, wrong, right)
+	}
+	if token.IsKeyword(camel) {
+		return camel + "_"
+	}
+	return camel
+}
@@ -45,6 +45,13 @@ func (o Object) Fields() []Field {
 	return slices.NewMapped(o.inner.Fields, NewField)
 }
 
+// Members returns the fields as an explicit codec reads and writes them, in
+// the order the documentation listed them, which is the order they are
+// written in.
+func (o Object) Members() []Member {
+	return slices.NewMapped(o.inner.Fields, NewMember)
+}
+
 // Unions returns the fields the object has to decode by hand, empty when
 // encoding/json decodes it on its own.
 func (o Object) Unions() []UnionField {
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package golang
+
+import (
+	"fmt"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Pages represents the Go view of paging.go: a walker for every method handing
+// a collection out a page at a time.
+type Pages struct {
+	gen     Generation
+	pagings []Paging
+}
+
+// NewPages creates a Pages rendered within gen from pagings.
+func NewPages(gen Generation, pagings []Paging) Pages {
+	return Pages{gen: gen, pagings: pagings}
+}
+
+// Generation returns the run the file is rendered within.
+func (p Pages) Generation() Generation {
+	return p.gen
+}
+
+// Values returns the paging of every paged method, in the order the page lists
+// the methods.
+func (p Pages) Values() []Paging {
+	return p.pagings
+}
+
+// Paging represents one paged method as the walker fetching its pages: which
+// parameter moves from one page to the next, and which fields of a page hold
+// the items and their count.
+type Paging struct {
+	method Method
+	offset Field
+}
+
+// NewPaging creates a Paging for method, which walks its pages by moving
+// offset. It fails when the method is not paged, or takes no such parameter.
+func NewPaging(method ir.Method) (Paging, error) {
+	if !method.Paged {
+		return Paging{}, fmt.Errorf("method %s is not paged", method.Ref)
+	}
+	for _, param := range method.Params {
+		if param.Key == method.Paging.Offset {
+			return Paging{method: NewMethod(method), offset: NewField(param)}, nil
+		}
+	}
+	return Paging{}, fmt.Errorf("method %s takes no %s", method.Ref, method.Paging.Offset)
+}
+
+// Method returns the Go name of the method struct the walker is declared on.
+func (p Paging) Method() string {
+	return p.method.Name()
+}
+
+// Wire returns the name the endpoint is called by.
+func (p Paging) Wire() string {
+	return p.method.Wire()
+}
+
+// Offset returns the parameter the walker moves from one page to the next.
+func (p Paging) Offset() Field {
+	return p.offset
+}
+
+// Setter returns the setter filling the offset in.
+func (p Paging) Setter() Setter {
+	return NewSetter(p.method.Name(), p.offset)
+}
+
+// Item returns the Go type of one item: the type of the field holding the
+// items, one dimension down.
+func (p Paging) Item() string {
+	items := p.method.inner.Paging.Items.Type
+	return NewType(typebound.NewType(items.Atom(), items.Dimensionality()-1), false).Value()
+}
+
+// Items returns the Go name of the field of a page holding its items.
+func (p Paging) Items() string {
+	return NewNameFromKey(p.method.inner.Paging.Items.Key).Value()
+}
+
+// Total returns the Go name of the field of a page counting every item there
+// is, and nothing when a page does not count them.
+func (p Paging) Total() string {
+	if p.method.inner.Paging.Total == "" {
+		return ""
+	}
+	return NewNameFromKey(p.method.inner.Paging.Total).Value()
+}
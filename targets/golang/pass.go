@@ -11,7 +11,7 @@ import (
 	"github.com/andreychh/tgen/output"
 )
 
-//go:embed templates/*.tmpl
+//go:embed templates/*.tmpl templates/*/*.tmpl
 var templates embed.FS
 
 // Pass is the Go generation stage: it renders the records of the pipeline's
@@ -26,14 +26,57 @@ func NewPass(gen Generation) Pass {
 }
 
 // Artifacts returns the files the target writes, each bound to the template
-// rendering it. It fails when a template is malformed.
+// rendering it. An explicit marshaling adds json.go, the reader and writer its
+// codecs are written against, and a page declaring MessageEntity adds
+// format.go, the builder marking a text with the entities it lists; one
+// declaring ReplyMarkup adds keyboard.go, the builders of its keyboards and
+// buttons; and one with a paged method adds paging.go, the walkers fetching
+// its pages. It fails when a template is malformed or the records cannot be
+// read.
 func (p Pass) Artifacts() (output.Artifacts, error) {
-	tmpl, err := output.NewMold(templates, template.FuncMap{}).Template()
+	tmpl, err := p.template()
+	if err != nil {
+		return nil, err
+	}
+	artifacts := output.Artifacts{
+		"api.go":        output.NewTemplateView(tmpl, "api", p.gen),
+		"client.go":     output.NewTemplateView(tmpl, "client", p.gen),
+		"errors.go":     output.NewTemplateView(tmpl, "errors", p.gen),
+		"middleware.go": output.NewTemplateView(tmpl, "middleware", p.gen),
+	}
+	if p.gen.Marshaling() == Explicit {
+		artifacts["json.go"] = output.NewTemplateView(tmpl, "json", p.gen)
+	}
+	entity, ok, err := p.gen.Spec().Entity()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		artifacts["format.go"] = output.NewTemplateView(tmpl, "format", NewFormatting(p.gen, entity))
+	}
+	keyboards, err := p.gen.Spec().Keyboards()
+	if err != nil {
+		return nil, err
+	}
+	if len(keyboards) > 0 {
+		artifacts["keyboard.go"] = output.NewTemplateView(tmpl, "keyboard", NewKeyboards(p.gen, keyboards))
+	}
+	pagings, err := p.gen.Spec().Pagings()
+	if err != nil {
+		return nil, err
+	}
+	if len(pagings) > 0 {
+		artifacts["paging.go"] = output.NewTemplateView(tmpl, "paging", NewPages(p.gen, pagings))
+	}
+	return artifacts, nil
+}
+
+// template returns the templates every file of the target is rendered through:
+// the shared ones and the layer spelling the marshaling.
+func (p Pass) template() (*template.Template, error) {
+	tmpl, err := output.NewMold(templates, template.FuncMap{}).Layered(p.gen.Marshaling().dir()).Template()
 	if err != nil {
 		return nil, fmt.Errorf("preparing template: %w", err)
 	}
-	return output.Artifacts{
-		"api.go":    output.NewTemplateView(tmpl, "api", p.gen),
-		"client.go": output.NewTemplateView(tmpl, "client", p.gen),
-	}, nil
+	return tmpl, nil
 }
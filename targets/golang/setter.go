@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package golang
+
+// Setter represents the method filling in one optional parameter of a method
+// struct: it takes the value as the caller holds it, stores it the way the
+// field holds it, and returns the struct, so that setters chain off the
+// constructor.
+type Setter struct {
+	owner string
+	field Field
+}
+
+// NewSetter creates a Setter for field on the struct named owner.
+func NewSetter(owner string, field Field) Setter {
+	return Setter{owner: owner, field: field}
+}
+
+// Owner returns the name of the struct the setter is declared on.
+func (s Setter) Owner() string {
+	return s.owner
+}
+
+// Name returns the name of the setter: the field's own, prefixed with With.
+func (s Setter) Name() string {
+	return "With" + s.field.Name()
+}
+
+// Field returns the field the setter fills in.
+func (s Setter) Field() Field {
+	return s.field
+}
@@ -9,7 +9,9 @@ package golang
 import (
 	"fmt"
 
+	"github.com/andreychh/tgen/model/formatting"
 	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/keyboard"
 	"github.com/andreychh/tgen/pkg/slices"
 )
 
@@ -42,3 +44,65 @@ func (s Specification) Definitions() ([]Declaration, error) {
 	}
 	return slices.NewMapped(records, NewDeclaration), nil
 }
+
+// Methods returns the methods among the declarations, in the order the page
+// lists them. It fails when a record cannot be read as a declaration.
+func (s Specification) Methods() ([]Method, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	var methods []Method
+	for _, record := range records {
+		if method, ok := record.(ir.Method); ok {
+			methods = append(methods, NewMethod(method))
+		}
+	}
+	return methods, nil
+}
+
+// Entity returns the record of MessageEntity, which format.go is written from,
+// and false when the specification declares no such object. It fails when a
+// record cannot be read.
+func (s Specification) Entity() (formatting.Entity, bool, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return formatting.Entity{}, false, fmt.Errorf("reading definitions: %w", err)
+	}
+	entity, ok := formatting.Lookup(records)
+	return entity, ok, nil
+}
+
+// Keyboards returns the variants of ReplyMarkup laying buttons out in rows,
+// which keyboard.go is written from, and none when the specification declares
+// no ReplyMarkup. It fails when a record cannot be read.
+func (s Specification) Keyboards() ([]keyboard.Keyboard, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	return keyboard.Lookup(records), nil
+}
+
+// Pagings returns the paging of every method handing a collection out a page
+// at a time, which paging.go is written from, in the order the page lists the
+// methods. It fails when a record cannot be read.
+func (s Specification) Pagings() ([]Paging, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	var out []Paging
+	for _, record := range records {
+		method, ok := record.(ir.Method)
+		if !ok || !method.Paged {
+			continue
+		}
+		paging, err := NewPaging(method)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, paging)
+	}
+	return out, nil
+}
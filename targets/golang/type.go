@@ -34,9 +34,15 @@ func NewRequiredType(typ typebound.Type) Type {
 // slice per dimension, or behind a pointer when an optional single value needs
 // one to tell an absent field from a zero one.
 func (t Type) Value() string {
-	if t.pointer() {
+	if t.Pointer() {
 		return "*" + t.Name()
 	}
+	return t.Pointee()
+}
+
+// Pointee returns the Go type expression without the pointer an optional single
+// value renders behind: what a caller hands over for the pointer to point at.
+func (t Type) Pointee() string {
 	return strings.Repeat("[]", int(t.typ.Dimensionality())) + t.Name()
 }
 
@@ -61,7 +67,7 @@ func (t Type) Name() string {
 // literal of a built-in, an empty composite of an object, what an alias stands
 // for, and nil for everything Go already gives a nil.
 func (t Type) Zero() string {
-	if t.pointer() || t.Array() {
+	if t.Pointer() || t.Array() {
 		return "nil"
 	}
 	switch atom := t.typ.Atom().(type) {
@@ -89,9 +95,9 @@ func (t Type) Array() bool {
 	return t.typ.Dimensionality() > 0
 }
 
-// pointer reports whether the type renders behind a pointer: only an optional
+// Pointer reports whether the type renders behind a pointer: only an optional
 // single value does, since a slice and a union interface already carry nil.
-func (t Type) pointer() bool {
+func (t Type) Pointer() bool {
 	if !t.opt {
 		return false
 	}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+)
+
+// DOT is the [output.View] writing a graph in the language of Graphviz. The fill
+// of a node tells its direction and the outline tells its file kind: the type a
+// file is sent as is drawn as a note, and a definition carrying one somewhere
+// inside has a double border.
+type DOT struct {
+	graph Graph
+}
+
+// NewDOT creates a DOT writing graph.
+func NewDOT(graph Graph) DOT {
+	return DOT{graph: graph}
+}
+
+// Render implements [output.View].
+func (d DOT) Render(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph tgen {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=filled, fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+	for _, node := range d.graph.Nodes() {
+		fmt.Fprintf(&b, "  %s [label=%s, fillcolor=%s%s];\n",
+			d.quoted(string(node.Ref)), d.quoted(string(node.Name)), d.quoted(color(node)), d.outline(node.File))
+	}
+	for _, edge := range d.graph.Edges() {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n",
+			d.quoted(string(edge.From)), d.quoted(string(edge.To)), d.quoted(string(edge.Relation)))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// outline returns the attributes drawing the file kind of a node, empty for a
+// node no file is found in.
+func (d DOT) outline(kind model.FileKind) string {
+	switch kind {
+	case model.FileKindFile:
+		return ", shape=note"
+	case model.FileKindCarrier:
+		return ", peripheries=2"
+	default:
+		return ""
+	}
+}
+
+// quoted returns text as a DOT string. Inside quotes DOT knows one escape, the
+// backslash before a quote, and keeps every other character as it is — Go's own
+// quoting would have spelled the non-ASCII ones as escapes DOT prints verbatim.
+// A backslash is doubled so that it never escapes the character after it.
+func (d DOT) quoted(text string) string {
+	return `"` + dotEscaper.Replace(text) + `"`
+}
+
+// dotEscaper escapes the two characters a DOT string cannot hold as they are.
+//
+//nolint:gochecknoglobals
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+// Package graph renders the relations the pipeline travels along — a field to
+// its type, a union to its variants, an alias to what it stands for, a method
+// to what it returns — as a graph a person can look at. It decides nothing of
+// its own: every node carries the direction and the file kind the pipeline
+// already settled, and the picture is only there to show why it settled them.
+package graph
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline/directed"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+	"github.com/andreychh/tgen/model/result"
+	"github.com/andreychh/tgen/model/typeform"
+)
+
+// Relation names what puts an edge in the graph.
+type Relation string
+
+const (
+	// RelationField is the edge from the definition owning a field, or the method
+	// taking a parameter, to the definition that field is typed as.
+	RelationField Relation = "field"
+	// RelationVariant is the edge from a union to a variant it admits.
+	RelationVariant Relation = "variant"
+	// RelationAlias is the edge from an alias to the definition it stands for.
+	RelationAlias Relation = "alias"
+	// RelationReturn is the edge from a method to the definition it returns. The
+	// directed stage reads it as a seed rather than a rule, but a reader asking why
+	// a definition is inbound asks about this edge first.
+	RelationReturn Relation = "return"
+)
+
+// Node is one definition of the graph, with what the pipeline decided about it.
+// Direction is empty for a method, which the directed stage gives none, and File
+// is empty for a definition no file is ever found in.
+type Node struct {
+	Ref       model.Reference
+	Name      model.Name
+	Kind      model.DefinitionKind
+	Position  model.Position
+	Direction model.Direction
+	File      model.FileKind
+}
+
+// Edge is one relation between two definitions, the source first.
+type Edge struct {
+	From     model.Reference
+	To       model.Reference
+	Relation Relation
+}
+
+// Graph is the set of definitions of a specification and the relations between
+// them. Nodes are ordered by the position the page gave them and edges by the
+// position of their source and then their target, so two runs over one page
+// draw one picture.
+type Graph struct {
+	nodes []Node
+	edges []Edge
+}
+
+// NewGraph constructs the Graph of a separated specification. An edge leading to
+// no definition the specification holds is dropped, which is what a primitive
+// would have been had the rules not dropped it already.
+func NewGraph(spec separated.Specification) Graph {
+	nodes := make([]Node, 0, spec.Definitions.Count())
+	index := make(map[model.Reference]Node, spec.Definitions.Count())
+	for ref, definition := range spec.Definitions.All() {
+		node := Node{
+			Ref:      ref,
+			Name:     definition.Name,
+			Kind:     definition.Kind,
+			Position: definition.Position,
+		}
+		if direction, ok := spec.Directions.Lookup(ref); ok {
+			node.Direction = direction
+		}
+		if file, ok := spec.Files.Lookup(ref); ok {
+			node.File = file.Kind
+		}
+		nodes = append(nodes, node)
+		index[ref] = node
+	}
+	seen := make(map[Edge]bool)
+	var edges []Edge
+	add := func(relation Relation, rule directed.Rule) {
+		for from, to := range rule.Edges() {
+			edge := Edge{From: from, To: to, Relation: relation}
+			_, fromOK := index[from]
+			_, toOK := index[to]
+			if !fromOK || !toOK || seen[edge] {
+				continue
+			}
+			seen[edge] = true
+			edges = append(edges, edge)
+		}
+	}
+	add(RelationField, directed.NewFieldRule(spec.Fields))
+	add(RelationVariant, directed.NewVariantRule(spec.Variants))
+	add(RelationAlias, directed.NewAliasRule(spec.Aliases))
+	add(RelationReturn, returnRule{methods: spec.Methods})
+	return newGraph(nodes, edges, index)
+}
+
+// newGraph constructs a Graph over nodes and edges, putting both in the order
+// the page dictates.
+func newGraph(nodes []Node, edges []Edge, index map[model.Reference]Node) Graph {
+	slices.SortFunc(nodes, func(a, b Node) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.Ref, b.Ref))
+	})
+	slices.SortFunc(edges, func(a, b Edge) int {
+		return cmp.Or(
+			cmp.Compare(index[a.From].Position, index[b.From].Position),
+			cmp.Compare(index[a.To].Position, index[b.To].Position),
+			cmp.Compare(a.From, b.From),
+			cmp.Compare(a.To, b.To),
+			cmp.Compare(a.Relation, b.Relation),
+		)
+	})
+	return Graph{nodes: nodes, edges: edges}
+}
+
+// Nodes returns the definitions of the graph, in the order the page gave them.
+func (g Graph) Nodes() []Node {
+	return g.nodes
+}
+
+// Edges returns the relations of the graph, ordered by source and then target.
+func (g Graph) Edges() []Edge {
+	return g.edges
+}
+
+// Resolve returns the reference of the definition term names, matching it
+// against references first and names second, so that both inputmediaphoto and
+// InputMediaPhoto find the same definition. It fails when no definition goes by
+// term either way.
+func (g Graph) Resolve(term string) (model.Reference, error) {
+	for _, node := range g.nodes {
+		if string(node.Ref) == term {
+			return node.Ref, nil
+		}
+	}
+	for _, node := range g.nodes {
+		if string(node.Name) == term {
+			return node.Ref, nil
+		}
+	}
+	return "", fmt.Errorf("no definition %q in the graph", term)
+}
+
+// Focus returns the part of the graph within depth edges of ref, edges being
+// walked either way: what a definition reaches explains what it carries, and
+// what reaches it explains why it travels the way it does. A depth of zero
+// leaves the definition alone. It fails when the graph holds no definition
+// under ref.
+func (g Graph) Focus(ref model.Reference, depth int) (Graph, error) {
+	index := make(map[model.Reference]Node, len(g.nodes))
+	for _, node := range g.nodes {
+		index[node.Ref] = node
+	}
+	if _, ok := index[ref]; !ok {
+		return Graph{}, fmt.Errorf("no definition %q in the graph", ref)
+	}
+	neighbours := make(map[model.Reference][]model.Reference)
+	for _, edge := range g.edges {
+		neighbours[edge.From] = append(neighbours[edge.From], edge.To)
+		neighbours[edge.To] = append(neighbours[edge.To], edge.From)
+	}
+	kept := map[model.Reference]bool{ref: true}
+	frontier := []model.Reference{ref}
+	for range depth {
+		var next []model.Reference
+		for _, current := range frontier {
+			for _, neighbour := range neighbours[current] {
+				if kept[neighbour] {
+					continue
+				}
+				kept[neighbour] = true
+				next = append(next, neighbour)
+			}
+		}
+		frontier = next
+	}
+	var nodes []Node
+	for _, node := range g.nodes {
+		if kept[node.Ref] {
+			nodes = append(nodes, node)
+		}
+	}
+	var edges []Edge
+	for _, edge := range g.edges {
+		if kept[edge.From] && kept[edge.To] {
+			edges = append(edges, edge)
+		}
+	}
+	return newGraph(nodes, edges, index), nil
+}
+
+// returnRule reads the return of every method carrying a value as an edge, the
+// same way [directed.FieldRule] reads a field. A method only confirming success
+// returns no definition and so puts no edge in the graph.
+type returnRule struct {
+	methods separated.Methods
+}
+
+// Edges implements [directed.Rule].
+func (r returnRule) Edges() iter.Seq2[model.Reference, model.Reference] {
+	return func(yield func(model.Reference, model.Reference) bool) {
+		for ref, method := range r.methods.All() {
+			value, ok := method.Result.(result.Value)
+			if !ok {
+				continue
+			}
+			named, ok := value.Type().Atom().(typeform.Named)
+			if !ok {
+				continue
+			}
+			if !yield(ref, named.Ref()) {
+				return
+			}
+		}
+	}
+}
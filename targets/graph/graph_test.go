@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package graph_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/pipeline"
+	"github.com/andreychh/tgen/model/pipeline/attached"
+	"github.com/andreychh/tgen/model/pipeline/classified"
+	"github.com/andreychh/tgen/model/pipeline/corrected"
+	"github.com/andreychh/tgen/model/pipeline/flattened"
+	"github.com/andreychh/tgen/model/pipeline/parsed"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+	"github.com/andreychh/tgen/model/typeform"
+	"github.com/andreychh/tgen/targets/graph"
+)
+
+// specification builds a separated specification laid out as a chain — a
+// method taking a union, the union admitting a keyboard, the keyboard holding
+// buttons, a button opening a web app — and one object nothing reaches. Only
+// the keyboard is given a direction, so that both kinds of node left without
+// one are drawn.
+func specification() separated.Specification {
+	definitions := pipeline.NewMapTable[model.Reference, corrected.Definition]()
+	for at, definition := range []corrected.Definition{
+		{Ref: "sendmessage", Name: "sendMessage", Kind: model.DefinitionKindMethod},
+		{Ref: "replymarkup", Name: "ReplyMarkup", Kind: model.DefinitionKindUnion},
+		{Ref: "inlinekeyboardmarkup", Name: "InlineKeyboardMarkup", Kind: model.DefinitionKindObject},
+		{Ref: "inlinekeyboardbutton", Name: "InlineKeyboardButton", Kind: model.DefinitionKindObject},
+		{Ref: "webappinfo", Name: `WebApp "Info" \ Café`, Kind: model.DefinitionKindObject},
+		{Ref: "user", Name: "User", Kind: model.DefinitionKindObject},
+	} {
+		definition.Position = model.Position(at)
+		definitions.Insert(definition.Ref, definition)
+	}
+	fields := pipeline.NewMapTable[model.FieldKey, flattened.Field]()
+	for key, ref := range map[model.FieldKey]model.Reference{
+		{Owner: "sendmessage", Key: "reply_markup"}:             "replymarkup",
+		{Owner: "inlinekeyboardmarkup", Key: "inline_keyboard"}: "inlinekeyboardbutton",
+		{Owner: "inlinekeyboardbutton", Key: "web_app"}:         "webappinfo",
+	} {
+		fields.Insert(key, flattened.Field{Key: key.Key, Type: typeform.NewType(typeform.NewNamed(ref), 0)})
+	}
+	variants := pipeline.NewMapTable[model.VariantKey, parsed.Variant]()
+	variants.Insert(model.VariantKey{Owner: "replymarkup", Ref: "inlinekeyboardmarkup"}, parsed.Variant{Ref: "inlinekeyboardmarkup"})
+	directions := pipeline.NewMapTable[model.Reference, model.Direction]()
+	directions.Insert("inlinekeyboardmarkup", model.DirectionOutbound)
+	return separated.Specification{
+		Definitions:    definitions,
+		Methods:        pipeline.NewMapTable[model.Reference, separated.Method](),
+		Fields:         fields,
+		Files:          pipeline.NewMapTable[model.Reference, attached.File](),
+		Directions:     directions,
+		Discriminators: pipeline.NewMapTable[model.Reference, classified.Discriminator](),
+		Variants:       variants,
+		Aliases:        pipeline.NewMapTable[model.Reference, flattened.Alias](),
+		Release:        parsed.Release{},
+	}
+}
+
+// refs returns the references of the nodes of g, in the order it keeps them.
+func refs(g graph.Graph) []model.Reference {
+	out := make([]model.Reference, 0, len(g.Nodes()))
+	for _, node := range g.Nodes() {
+		out = append(out, node.Ref)
+	}
+	return out
+}
+
+func TestGraph_Focus(t *testing.T) {
+	cases := []struct {
+		name  string
+		ref   model.Reference
+		depth int
+		want  []model.Reference
+		edges int
+	}{
+		{
+			name:  "keeps the definition alone at depth zero",
+			ref:   "inlinekeyboardmarkup",
+			depth: 0,
+			want:  []model.Reference{"inlinekeyboardmarkup"},
+			edges: 0,
+		},
+		{
+			name:  "keeps what the definition reaches and what reaches it at depth one",
+			ref:   "inlinekeyboardmarkup",
+			depth: 1,
+			want:  []model.Reference{"replymarkup", "inlinekeyboardmarkup", "inlinekeyboardbutton"},
+			edges: 2,
+		},
+		{
+			name:  "walks as many edges as the depth allows",
+			ref:   "inlinekeyboardmarkup",
+			depth: 2,
+			want: []model.Reference{
+				"sendmessage", "replymarkup", "inlinekeyboardmarkup", "inlinekeyboardbutton", "webappinfo",
+			},
+			edges: 4,
+		},
+		{
+			name:  "stops at what is connected however deep it is asked to go",
+			ref:   "webappinfo",
+			depth: 10,
+			want: []model.Reference{
+				"sendmessage", "replymarkup", "inlinekeyboardmarkup", "inlinekeyboardbutton", "webappinfo",
+			},
+			edges: 4,
+		},
+		{
+			name:  "keeps a definition nothing reaches alone",
+			ref:   "user",
+			depth: 3,
+			want:  []model.Reference{"user"},
+			edges: 0,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			focused, err := graph.NewGraph(specification()).Focus(tc.ref, tc.depth)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, refs(focused), "Graph.Focus must keep the definitions within depth edges, in page order")
+			assert.Len(t, focused.Edges(), tc.edges, "Graph.Focus must keep the edges between the definitions it keeps")
+		})
+	}
+}
+
+func TestGraph_Focus_unknown(t *testing.T) {
+	_, err := graph.NewGraph(specification()).Focus("chat", 1)
+	assert.Error(t, err, "Graph.Focus must fail on a reference the graph holds no definition under")
+}
+
+func TestGraph_Resolve(t *testing.T) {
+	cases := []struct {
+		name string
+		term string
+		want model.Reference
+	}{
+		{name: "finds a definition by its reference", term: "inlinekeyboardmarkup", want: "inlinekeyboardmarkup"},
+		{name: "finds a definition by its name", term: "InlineKeyboardMarkup", want: "inlinekeyboardmarkup"},
+		{name: "finds a method by its name", term: "sendMessage", want: "sendmessage"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := graph.NewGraph(specification()).Resolve(tc.term)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got, "Graph.Resolve must find a definition by its reference or its name")
+		})
+	}
+}
+
+func TestGraph_Resolve_unknown(t *testing.T) {
+	_, err := graph.NewGraph(specification()).Resolve("Chat")
+	assert.Error(t, err, "Graph.Resolve must fail on a term no definition goes by")
+}
+
+func TestDOT_Render(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, graph.NewDOT(graph.NewGraph(specification())).Render(&b))
+	cases := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "escapes only the quote and the backslash of a label",
+			want: `"webappinfo" [label="WebApp \"Info\" \\ Café", fillcolor="#ffffff"];`,
+		},
+		{
+			name: "fills a method as a method",
+			want: `"sendmessage" [label="sendMessage", fillcolor="#e0e0e0"];`,
+		},
+		{
+			name: "fills a definition by its direction",
+			want: `"inlinekeyboardmarkup" [label="InlineKeyboardMarkup", fillcolor="#a6cee3"];`,
+		},
+		{
+			name: "quotes both ends and the relation of an edge",
+			want: `"replymarkup" -> "inlinekeyboardmarkup" [label="variant"];`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, b.String(), tc.want, "DOT.Render must write every node and edge as DOT reads it")
+		})
+	}
+}
+
+func TestMermaid_Render(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, graph.NewMermaid(graph.NewGraph(specification())).Render(&b))
+	cases := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "spells a quote of a label as an entity and keeps the rest",
+			want: `d_webappinfo["WebApp #quot;Info#quot; \ Café"]:::undirected`,
+		},
+		{
+			name: "classes a method as a method",
+			want: `d_sendmessage["sendMessage"]:::method`,
+		},
+		{
+			name: "classes a definition without a direction apart from a method",
+			want: `d_user["User"]:::undirected`,
+		},
+		{
+			name: "classes a definition by its direction",
+			want: `d_inlinekeyboardmarkup["InlineKeyboardMarkup"]:::outbound`,
+		},
+		{
+			name: "declares the class of a definition without a direction",
+			want: "classDef undirected fill:#ffffff",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, b.String(), tc.want, "Mermaid.Render must write every node as Mermaid reads it")
+		})
+	}
+}
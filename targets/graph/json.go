@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSON is the [output.View] writing a graph as a JSON document, for tools that
+// would rather lay the graph out themselves. Fields the pipeline left empty — a
+// method's direction, the file kind of a definition holding no file — are
+// omitted rather than written empty.
+type JSON struct {
+	graph Graph
+}
+
+// NewJSON creates a JSON writing graph.
+func NewJSON(graph Graph) JSON {
+	return JSON{graph: graph}
+}
+
+// Render implements [output.View].
+func (j JSON) Render(w io.Writer) error {
+	type node struct {
+		Ref       string `json:"ref"`
+		Name      string `json:"name"`
+		Kind      string `json:"kind"`
+		Direction string `json:"direction,omitempty"`
+		File      string `json:"file,omitempty"`
+	}
+	type edge struct {
+		From     string `json:"from"`
+		To       string `json:"to"`
+		Relation string `json:"relation"`
+	}
+	document := struct {
+		Nodes []node `json:"nodes"`
+		Edges []edge `json:"edges"`
+	}{
+		Nodes: make([]node, 0, len(j.graph.Nodes())),
+		Edges: make([]edge, 0, len(j.graph.Edges())),
+	}
+	for _, n := range j.graph.Nodes() {
+		document.Nodes = append(document.Nodes, node{
+			Ref:       string(n.Ref),
+			Name:      string(n.Name),
+			Kind:      string(n.Kind),
+			Direction: string(n.Direction),
+			File:      string(n.File),
+		})
+	}
+	for _, e := range j.graph.Edges() {
+		document.Edges = append(document.Edges, edge{
+			From:     string(e.From),
+			To:       string(e.To),
+			Relation: string(e.Relation),
+		})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(document)
+	if err != nil {
+		return fmt.Errorf("encoding graph: %w", err)
+	}
+	return nil
+}
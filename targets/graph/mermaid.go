@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+)
+
+// Mermaid is the [output.View] writing a graph as a Mermaid flowchart, which
+// renders inline wherever the project's documents are read. Mermaid gives a node
+// one class, so the class tells its direction and the shape tells its file kind:
+// the type a file is sent as is a subroutine, and a definition carrying one
+// somewhere inside is a stadium.
+type Mermaid struct {
+	graph Graph
+}
+
+// NewMermaid creates a Mermaid writing graph.
+func NewMermaid(graph Graph) Mermaid {
+	return Mermaid{graph: graph}
+}
+
+// Render implements [output.View].
+func (m Mermaid) Render(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, node := range m.graph.Nodes() {
+		fmt.Fprintf(&b, "  %s%s:::%s\n", m.id(node.Ref), m.shape(node), class(node))
+	}
+	for _, edge := range m.graph.Edges() {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", m.id(edge.From), edge.Relation, m.id(edge.To))
+	}
+	for _, direction := range []model.Direction{
+		model.DirectionOutbound,
+		model.DirectionInbound,
+		model.DirectionBidirectional,
+	} {
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", direction, colors[direction])
+	}
+	fmt.Fprintf(&b, "  classDef %s fill:%s\n", classMethod, methodColor)
+	fmt.Fprintf(&b, "  classDef %s fill:%s\n", classUndirected, undirectedColor)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// id returns the identifier a node is declared under. References are lowercase
+// letters and digits, which Mermaid takes as they are, save for the keywords of
+// the language itself; the prefix keeps a reference from ever being one.
+func (m Mermaid) id(ref model.Reference) string {
+	return "d_" + string(ref)
+}
+
+// shape returns the node declaration around its label, drawn after the file
+// kind of the node.
+func (m Mermaid) shape(node Node) string {
+	label := m.quoted(string(node.Name))
+	switch node.File {
+	case model.FileKindFile:
+		return "[[" + label + "]]"
+	case model.FileKindCarrier:
+		return "([" + label + "])"
+	default:
+		return "[" + label + "]"
+	}
+}
+
+// quoted returns text as a Mermaid string. Mermaid reads a backslash as itself
+// and ends the string at the first quote, which it spells as an entity instead;
+// Go's own quoting would have written escapes Mermaid prints verbatim.
+func (m Mermaid) quoted(text string) string {
+	return `"` + strings.ReplaceAll(text, `"`, "#quot;") + `"`
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package graph
+
+import (
+	"github.com/andreychh/tgen/model"
+)
+
+// colors holds the fill each direction is drawn with. A method has no direction
+// and is drawn in [methodColor], which sets it apart from every definition a
+// transport carries; a definition no method reaches has none either, and is
+// drawn in [undirectedColor].
+//
+//nolint:gochecknoglobals
+var colors = map[model.Direction]string{
+	model.DirectionOutbound:      "#a6cee3",
+	model.DirectionInbound:       "#b2df8a",
+	model.DirectionBidirectional: "#fdbf6f",
+}
+
+// methodColor is the fill of a method, which the directed stage gives no
+// direction.
+const methodColor = "#e0e0e0"
+
+// undirectedColor is the fill of a definition no method takes or returns, which
+// the directed stage leaves without a direction. It is not a method, and a
+// reader wondering why it travels nowhere should not be told it is one.
+const undirectedColor = "#ffffff"
+
+// color returns the fill of a node, read off its kind before its direction.
+func color(node Node) string {
+	if node.Kind == model.DefinitionKindMethod {
+		return methodColor
+	}
+	fill, ok := colors[node.Direction]
+	if !ok {
+		return undirectedColor
+	}
+	return fill
+}
+
+// class returns the name the fill of a node is declared under, for formats that
+// style nodes by class rather than one by one.
+func class(node Node) string {
+	if node.Kind == model.DefinitionKindMethod {
+		return classMethod
+	}
+	if node.Direction == "" {
+		return classUndirected
+	}
+	return string(node.Direction)
+}
+
+// The classes of the nodes no direction names.
+const (
+	classMethod     = "method"
+	classUndirected = "undirected"
+)
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Alias represents the Kotlin declaration of a name tgen gives a type the
+// documentation leaves unnamed: a value class wrapping the type, which encodes
+// as the type alone. A type alias would be shorter, but it names nothing a
+// sealed interface can be implemented by, and every alias tgen introduces today
+// exists to be a variant of a union.
+type Alias struct {
+	inner   ir.Alias
+	lineage Lineage
+}
+
+// NewAlias creates an Alias from the record of an alias and the lineage of the
+// sequence it stands in.
+func NewAlias(a ir.Alias, lineage Lineage) Alias {
+	return Alias{inner: a, lineage: lineage}
+}
+
+// Doc returns the KDoc of the declaration. An alias carries no link back to
+// the documentation: tgen introduces it, so no section documents it.
+func (a Alias) Doc() string {
+	return NewTypeKDoc(a.inner.Description).Value()
+}
+
+// Ref implements [Declaration].
+func (a Alias) Ref() string {
+	return string(a.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (a Alias) Template() string {
+	return "alias"
+}
+
+// Name returns the Kotlin name the alias declares.
+func (a Alias) Name() string {
+	return NewName(a.inner.Name).Value()
+}
+
+// Supertypes returns the clause naming the unions admitting the alias.
+func (a Alias) Supertypes() string {
+	return a.lineage.Supertypes(a.inner.Name)
+}
+
+// Type returns the Kotlin type expression the value class wraps.
+func (a Alias) Type() string {
+	return NewRequiredType(a.inner.Type).Value()
+}
+
+// Direction returns which way the alias travels. The declaration itself is the
+// same either way; what a block written by hand puts beside it is not.
+func (a Alias) Direction() Direction {
+	return NewDirection(a.inner.Direction)
+}
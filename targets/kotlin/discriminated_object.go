@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// DiscriminatedObject represents the Kotlin declaration of an object a union
+// tells apart by a discriminator. The fixed value it is told apart by is its
+// serial name rather than a property: kotlinx.serialization writes the name
+// under the key the sealed interface declares whenever the object travels as
+// that interface, so no instance can be built claiming to be another.
+type DiscriminatedObject struct {
+	inner   ir.DiscriminatedObject
+	lineage Lineage
+}
+
+// NewDiscriminatedObject creates a DiscriminatedObject from the record of a
+// discriminated object and the lineage of the sequence it stands in.
+func NewDiscriminatedObject(o ir.DiscriminatedObject, lineage Lineage) DiscriminatedObject {
+	return DiscriminatedObject{inner: o, lineage: lineage}
+}
+
+// Doc returns the KDoc of the declaration, closing with a link back to the
+// section the object was read from.
+func (o DiscriminatedObject) Doc() string {
+	return NewDefinitionDoc(o.inner.Ref, o.inner.Description, o.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (o DiscriminatedObject) Ref() string {
+	return string(o.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (o DiscriminatedObject) Template() string {
+	return "discriminated_object"
+}
+
+// Name returns the Kotlin name the object declares.
+func (o DiscriminatedObject) Name() string {
+	return NewName(o.inner.Name).Value()
+}
+
+// SerialName returns the value the object is told apart by, which is the name
+// the class is encoded by.
+func (o DiscriminatedObject) SerialName() string {
+	return string(o.inner.Discriminator.Value)
+}
+
+// Supertypes returns the clause naming the unions admitting the object.
+func (o DiscriminatedObject) Supertypes() string {
+	return o.lineage.Supertypes(o.inner.Name)
+}
+
+// Fields returns the fields the object declares, in the order the documentation
+// listed them. The discriminating field is not among them.
+func (o DiscriminatedObject) Fields() []Field {
+	return slices.NewMapped(o.inner.Fields, NewField)
+}
+
+// Files returns the fields the object has to hand a file over for, empty when
+// it holds none.
+func (o DiscriminatedObject) Files() []Attached {
+	return slices.NewMapped(o.inner.Files, NewAttached)
+}
+
+// Rewrites reports whether the object has to rewrite itself into JSON because a
+// union reaching a file admits it. An object holding a file of its own rewrites
+// itself anyway; this is what obliges the ones holding none.
+func (o DiscriminatedObject) Rewrites() bool {
+	return o.inner.Rewrites
+}
+
+// Discriminator returns the field the object is told apart by. A rewrite writes
+// it back by hand, since the rewrite encodes the class as itself and not as the
+// interface, and the serializer writes the serial name only for the interface.
+func (o DiscriminatedObject) Discriminator() Discriminator {
+	return NewDiscriminator(o.inner.Discriminator)
+}
+
+// Direction returns which way the object travels.
+func (o DiscriminatedObject) Direction() Direction {
+	return NewDirection(o.inner.Direction)
+}
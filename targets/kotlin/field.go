@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Field represents the Kotlin declaration of a field an object owns or a
+// parameter a method takes: a property of the primary constructor.
+type Field struct {
+	inner ir.Field
+}
+
+// NewField creates a Field from the record of a field.
+func NewField(f ir.Field) Field {
+	return Field{inner: f}
+}
+
+// Doc returns the KDoc of the property.
+func (f Field) Doc() string {
+	return NewPropertyKDoc(f.inner.Description).Value()
+}
+
+// Name returns the Kotlin name the property declares.
+func (f Field) Name() string {
+	return NewPropertyName(f.inner.Key).Value()
+}
+
+// SerialName returns the key the property is encoded under, empty when the
+// property is spelled as the key already and needs no annotation saying so.
+func (f Field) SerialName() string {
+	if !NewPropertyName(f.inner.Key).Renamed() {
+		return ""
+	}
+	return string(f.inner.Key)
+}
+
+// Type returns the Kotlin type expression of the property.
+func (f Field) Type() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Value()
+}
+
+// Default returns what the property defaults to, empty when it has to be named.
+func (f Field) Default() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Default()
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/mitchellh/go-wordwrap"
+)
+
+// width is the room a line has, indentation and comment markers counted in. It
+// is the limit the Kotlin style guide puts on a line.
+const width = 100
+
+// indentation is what one level of nesting indents a line by.
+const indentation = "    "
+
+// KDoc represents a prose passage rendered as a KDoc comment: a paragraph per
+// block, a list as one dashed line per item, and the whole enclosed in the
+// markers a block comment opens and closes with. The first line carries no
+// indentation, since whatever declares the documented name has already written
+// it.
+type KDoc struct {
+	passage prose.Passage
+	indent  int
+}
+
+// NewKDoc creates a KDoc rendering a passage at an indentation depth.
+func NewKDoc(passage prose.Passage, indent int) KDoc {
+	return KDoc{passage: passage, indent: indent}
+}
+
+// NewTypeKDoc creates a KDoc for a name declared at file scope.
+func NewTypeKDoc(passage prose.Passage) KDoc {
+	return NewKDoc(passage, 0)
+}
+
+// NewPropertyKDoc creates a KDoc for a property declared in a primary
+// constructor. A field is described by a table cell, which holds inline prose
+// only, so its one phrase becomes the single paragraph of a passage.
+func NewPropertyKDoc(phrase prose.Phrase) KDoc {
+	return NewKDoc(prose.NewPassage(prose.NewParagraph(phrase.Inlines()...)), 1)
+}
+
+// Value returns the comment, empty when the passage writes no prose. A block
+// that writes nothing takes no line and earns no blank line beside it, so an
+// empty paragraph leaves no trace rather than an empty comment. A comment
+// folding to a single line is written on that line alone, which is how a
+// property is commented in the primary constructor declaring it.
+func (d KDoc) Value() string {
+	room := width - len(indentation)*d.indent - len(" * ")
+	lines := make([]string, 0)
+	for _, block := range d.passage.Blocks() {
+		written := d.block(block, room)
+		if len(written) == 0 {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, written...)
+	}
+	switch {
+	case len(lines) == 0:
+		return ""
+	case len(lines) == 1 && utf8.RuneCountInString(lines[0]) <= room-len(" */"):
+		return "/** " + lines[0] + " */"
+	default:
+		return d.comment(lines)
+	}
+}
+
+// block returns the lines one block occupies within room.
+func (d KDoc) block(block prose.Block, room int) []string {
+	switch block := block.(type) {
+	case prose.Paragraph:
+		return wrap(text(block.Inlines()), room, "", "")
+	case prose.List:
+		lines := make([]string, 0, len(block.Items()))
+		for _, item := range block.Items() {
+			lines = append(lines, wrap(text(item.Inlines()), room-2, "- ", "  ")...)
+		}
+		return lines
+	default:
+		return nil
+	}
+}
+
+// comment returns the lines enclosed in the markers of a block comment, every
+// line after the first indented to the depth the declaration sits at.
+func (d KDoc) comment(lines []string) string {
+	pad := strings.Repeat(indentation, d.indent)
+	out := make([]string, 0, len(lines)+2)
+	out = append(out, "/**")
+	for _, line := range lines {
+		out = append(out, strings.TrimRight(pad+" * "+line, " "))
+	}
+	out = append(out, pad+" */")
+	return strings.Join(out, "\n")
+}
+
+// text returns the plain text of inline content. A link contributes its text
+// alone: the anchor it addresses is not yet resolved to the name a KDoc link
+// would have to spell. A marker closing the comment is broken apart, since the
+// comment would end wherever the prose happened to write one.
+func text(inlines []prose.Inline) string {
+	var out strings.Builder
+	for _, inline := range inlines {
+		switch inline := inline.(type) {
+		case prose.Text:
+			out.WriteString(inline.Content())
+		case prose.Link:
+			out.WriteString(inline.Content())
+		case prose.LineBreak:
+			out.WriteString("\n")
+		}
+	}
+	return strings.ReplaceAll(out.String(), "*/", "* /")
+}
+
+// wrap returns content folded to the given width, opening with first and
+// continuing with rest. A forced line break in the content starts a new line of
+// its own. Content with nothing to read folds to no lines at all.
+func wrap(content string, width int, first, rest string) []string {
+	if strings.TrimSpace(content) == "" {
+		return nil
+	}
+	out := make([]string, 0)
+	for segment := range strings.SplitSeq(content, "\n") {
+		folded := wordwrap.WrapString(segment, uint(width))
+		for line := range strings.SplitSeq(folded, "\n") {
+			out = append(out, rest+line)
+		}
+	}
+	out[0] = first + strings.TrimPrefix(out[0], rest)
+	return out
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets"
+)
+
+// Lineage represents what the declarations of the package know about one
+// another and Kotlin needs said at the declaration itself: the unions admitting
+// each type, and the value each object a union tells apart is told apart by. A
+// sealed interface is implemented by naming it on the class, so the class has
+// to know.
+type Lineage struct {
+	inner targets.Lineage
+}
+
+// NewLineage creates a Lineage from every record of the pipeline's exit.
+func NewLineage(records []ir.Definition) Lineage {
+	return Lineage{inner: targets.NewLineage(records)}
+}
+
+// Supertypes returns the clause naming the unions admitting the type, in the
+// order their records came, empty when no union admits it.
+func (l Lineage) Supertypes(name model.Name) string {
+	unions := l.inner.Unions(name)
+	if len(unions) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(unions))
+	for _, union := range unions {
+		names = append(names, NewName(union).Value())
+	}
+	return " : " + strings.Join(names, ", ")
+}
+
+// Discriminator returns the field the type is told apart by, and false when it
+// is not an object a union tells apart.
+func (l Lineage) Discriminator(name model.Name) (Discriminator, bool) {
+	d, ok := l.inner.Discriminator(name)
+	if !ok {
+		return Discriminator{}, false
+	}
+	return NewDiscriminator(d), true
+}
+
+// Discriminator represents the field an object is told apart by and the fixed
+// value it holds there.
+type Discriminator struct {
+	inner ir.Discriminator
+}
+
+// NewDiscriminator creates a Discriminator from the record of one.
+func NewDiscriminator(d ir.Discriminator) Discriminator {
+	return Discriminator{inner: d}
+}
+
+// Key returns the JSON key the value is written under.
+func (d Discriminator) Key() string {
+	return string(d.inner.Key)
+}
+
+// Value returns the value the object is told apart by.
+func (d Discriminator) Value() string {
+	return string(d.inner.Value)
+}
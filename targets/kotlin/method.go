@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Method represents the Kotlin declaration of a documented method: the class
+// holding its parameters, the payload that class is sent as, and the suspending
+// call decoding the response.
+type Method struct {
+	inner ir.Method
+}
+
+// NewMethod creates a Method from the record of a method.
+func NewMethod(m ir.Method) Method {
+	return Method{inner: m}
+}
+
+// Doc returns the KDoc of the declaration, closing with a link back to the
+// section the method was read from.
+func (m Method) Doc() string {
+	return NewDefinitionDoc(m.inner.Ref, m.inner.Description, m.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (m Method) Ref() string {
+	return string(m.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (m Method) Template() string {
+	return "method"
+}
+
+// Name returns the Kotlin name the method declares: the documented name
+// suffixed with Method, which keeps the class holding a request apart from the
+// object the request answers with.
+func (m Method) Name() string {
+	return NewName(m.inner.Name).Value() + "Method"
+}
+
+// SerialName returns the name the class is encoded by, empty since a request
+// is never told apart from another.
+func (m Method) SerialName() string {
+	return ""
+}
+
+// Supertypes returns the clause naming the unions admitting the class, empty
+// since no union admits a request.
+func (m Method) Supertypes() string {
+	return ""
+}
+
+// Wire returns the name the endpoint is called by.
+func (m Method) Wire() string {
+	return string(m.inner.Name)
+}
+
+// Fields returns the parameters the method takes, in the order the
+// documentation listed them.
+func (m Method) Fields() []Field {
+	return slices.NewMapped(m.inner.Params, NewField)
+}
+
+// Return returns the response slot of the method.
+func (m Method) Return() Return {
+	return NewResult(m.inner.Result).Return()
+}
+
+// Payload returns the request slot of the method: nothing to send when it takes
+// no parameter, a multipart body when a parameter reaches a file, and plain
+// JSON otherwise.
+func (m Method) Payload() Payload {
+	if len(m.inner.Params) == 0 {
+		return NewEmpty()
+	}
+	if len(m.inner.Files) > 0 {
+		return NewForm(slices.NewMapped(m.inner.Files, NewPlaced))
+	}
+	return NewJSON()
+}
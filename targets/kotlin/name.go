@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/iancoleman/strcase"
+)
+
+// acronyms holds the initialisms a capitalization leaves half-spelled, each
+// mapped to the spelling the other targets give it. Kotlin's own conventions
+// would write the longer ones as words — Url, Api — but a class is what a
+// reader of one target looks the same class up by in another, so the spelling
+// follows theirs rather than the style guide.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var acronyms = map[string]string{
+	"Id":  "ID",
+	"Url": "URL",
+	"Api": "API",
+	"Ip":  "IP",
+}
+
+// keywords holds the hard keywords of Kotlin, which is what a property may not
+// be spelled as without backticks. A soft or a modifier keyword — data, value,
+// open, field — is a name the grammar reads as a keyword only where one can
+// stand, and remains a legal property name everywhere else.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var keywords = map[string]bool{
+	"as": true, "break": true, "class": true, "continue": true, "do": true,
+	"else": true, "false": true, "for": true, "fun": true, "if": true,
+	"in": true, "interface": true, "is": true, "null": true, "object": true,
+	"package": true, "return": true, "super": true, "this": true, "throw": true,
+	"true": true, "try": true, "typealias": true, "typeof": true, "val": true,
+	"var": true, "when": true, "while": true,
+}
+
+// Name represents a documentation name rendered as the name of a Kotlin class.
+type Name struct {
+	inner model.Name
+}
+
+// NewName creates a Name from a documentation name.
+func NewName(n model.Name) Name {
+	return Name{inner: n}
+}
+
+// Value returns the name in the capitalized words a class is declared by, with
+// the acronyms spelled in capitals.
+func (n Name) Value() string {
+	camel := strcase.ToCamel(string(n.inner))
+	for wrong, right := range acronyms {
+		camel = strings.ReplaceAll(camel, wrong, right)
+	}
+	return camel
+}
+
+// PropertyName represents a field key rendered as the name of a Kotlin
+// property. It stands apart from [Name] because the two are spelled by
+// different rules: a property opens in lowercase and writes an initialism as a
+// word, chatId rather than chatID, which is what every Kotlin API a reader
+// calls beside this one does.
+type PropertyName struct {
+	key model.Key
+}
+
+// NewPropertyName creates a PropertyName from a field key.
+func NewPropertyName(k model.Key) PropertyName {
+	return PropertyName{key: k}
+}
+
+// Value returns the key in lower camel case, enclosed in backticks where it is
+// a word Kotlin reserves.
+func (n PropertyName) Value() string {
+	camel := strcase.ToLowerCamel(string(n.key))
+	if keywords[camel] {
+		return "`" + camel + "`"
+	}
+	return camel
+}
+
+// Renamed reports whether the property is spelled apart from the key it is
+// encoded under, which is what obliges the declaration to name the key.
+func (n PropertyName) Renamed() bool {
+	return strcase.ToLowerCamel(string(n.key)) != string(n.key)
+}
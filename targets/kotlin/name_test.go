@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/targets/kotlin"
+)
+
+func TestName_Value(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Name
+		want  string
+	}{
+		{name: "capitalizes a method name", input: "getMe", want: "GetMe"},
+		{name: "keeps an object name as documented", input: "ReplyKeyboardRemove", want: "ReplyKeyboardRemove"},
+		{name: "spells an initialism in capitals", input: "WebhookInfoUrl", want: "WebhookInfoURL"},
+		{name: "spells an identifier in capitals", input: "ChatId", want: "ChatID"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, kotlin.NewName(tc.input).Value(),
+				"Name.Value must spell a class the way the other targets spell it")
+		})
+	}
+}
+
+func TestPropertyName_Value(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Key
+		want  string
+	}{
+		{name: "keeps a single word key", input: "text", want: "text"},
+		{name: "writes a snake case key in lower camel case", input: "first_name", want: "firstName"},
+		{name: "writes an identifier as a word", input: "chat_id", want: "chatId"},
+		{name: "encloses a hard keyword in backticks", input: "in", want: "`in`"},
+		{name: "leaves a soft keyword bare", input: "data", want: "data"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, kotlin.NewPropertyName(tc.input).Value(),
+				"PropertyName.Value must spell a property the way Kotlin reads it")
+		})
+	}
+}
+
+func TestPropertyName_Renamed(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Key
+		want  bool
+	}{
+		{name: "reports a key spelled as its property", input: "username", want: false},
+		{name: "reports a key spelled apart from its property", input: "first_name", want: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, kotlin.NewPropertyName(tc.input).Renamed(),
+				"PropertyName.Renamed must report whether the key has to be named beside the property")
+		})
+	}
+}
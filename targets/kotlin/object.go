@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Object represents the Kotlin declaration of a documented object: a data
+// class, or a data object when the documentation gives it no field to hold.
+type Object struct {
+	inner   ir.Object
+	lineage Lineage
+}
+
+// NewObject creates an Object from the record of an object and the lineage of
+// the sequence it stands in.
+func NewObject(o ir.Object, lineage Lineage) Object {
+	return Object{inner: o, lineage: lineage}
+}
+
+// Doc returns the KDoc of the declaration, closing with a link back to the
+// section the object was read from.
+func (o Object) Doc() string {
+	return NewDefinitionDoc(o.inner.Ref, o.inner.Description, o.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (o Object) Ref() string {
+	return string(o.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (o Object) Template() string {
+	return "object"
+}
+
+// Name returns the Kotlin name the object declares.
+func (o Object) Name() string {
+	return NewName(o.inner.Name).Value()
+}
+
+// SerialName returns the name the class is encoded by, empty since nothing
+// tells the object apart by one.
+func (o Object) SerialName() string {
+	return ""
+}
+
+// Supertypes returns the clause naming the unions admitting the object.
+func (o Object) Supertypes() string {
+	return o.lineage.Supertypes(o.inner.Name)
+}
+
+// Fields returns the fields the object declares, in the order the documentation
+// listed them.
+func (o Object) Fields() []Field {
+	return slices.NewMapped(o.inner.Fields, NewField)
+}
+
+// Files returns the fields the object has to hand a file over for, empty when
+// it holds none and so encodes the way its serializer says.
+func (o Object) Files() []Attached {
+	return slices.NewMapped(o.inner.Files, NewAttached)
+}
+
+// Rewrites reports whether the object has to rewrite itself into JSON because a
+// union reaching a file admits it. An object holding a file of its own rewrites
+// itself anyway; this is what obliges the ones holding none.
+func (o Object) Rewrites() bool {
+	return o.inner.Rewrites
+}
+
+// Direction returns which way the object travels.
+func (o Object) Direction() Direction {
+	return NewDirection(o.inner.Direction)
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+	"github.com/andreychh/tgen/targets/kotlin"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+// property is what a declaration says of one of its properties.
+type property struct {
+	name   string
+	serial string
+	typ    string
+	def    string
+}
+
+// properties returns what the declaration says of each of fields.
+func properties(fields []kotlin.Field) []property {
+	return slices.NewMapped(fields, func(f kotlin.Field) property {
+		return property{name: f.Name(), serial: f.SerialName(), typ: f.Type(), def: f.Default()}
+	})
+}
+
+func TestObject_Fields(t *testing.T) {
+	object := kotlin.NewObject(
+		targettest.Record[ir.Object](t, "user"),
+		kotlin.NewLineage(targettest.Records(t)),
+	)
+	assert.Equal(
+		t,
+		[]property{
+			{name: "id", serial: "", typ: "Long", def: ""},
+			{name: "firstName", serial: "first_name", typ: "String", def: ""},
+			{name: "username", serial: "", typ: "String?", def: " = null"},
+		},
+		properties(object.Fields()),
+		"Object.Fields must rename a snake case field and default an optional one to null",
+	)
+}
+
+func TestObject_Supertypes(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  model.Reference
+		want string
+	}{
+		{name: "names nothing for an object no union admits", ref: "user", want: ""},
+		{name: "names the union admitting a variant", ref: "forcereply", want: " : ReplyMarkup"},
+	}
+	lineage := kotlin.NewLineage(targettest.Records(t))
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			object := kotlin.NewObject(targettest.Record[ir.Object](t, tc.ref), lineage)
+			assert.Equal(t, tc.want, object.Supertypes(),
+				"Object.Supertypes must tie an object to the unions admitting it")
+		})
+	}
+}
+
+func TestObject_Direction(t *testing.T) {
+	object := kotlin.NewObject(
+		targettest.Record[ir.Object](t, "user"),
+		kotlin.NewLineage(targettest.Records(t)),
+	)
+	assert.True(t, object.Direction().Received(), "an object a response carries must read itself")
+	assert.False(t, object.Direction().Sent(), "an object no request carries must not write itself")
+}
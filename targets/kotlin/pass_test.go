@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets/kotlin"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+func TestPass_Artifacts(t *testing.T) {
+	artifacts, err := kotlin.NewPass(
+		kotlin.NewGeneration(
+			kotlin.NewSpecification(ir.NewSpecification(targettest.Specification())),
+			"org.example.bot",
+			targettest.Snapshot(),
+		),
+	).Artifacts()
+	require.NoError(t, err, "Pass must write the test bot")
+	files := targettest.Render(t, artifacts)
+	assert.ElementsMatch(
+		t,
+		[]string{"Api.kt", "Client.kt"},
+		slices.Collect(maps.Keys(files)),
+		"Pass must write the declarations and the transport as two files",
+	)
+	cases := []struct {
+		name string
+		file string
+		want string
+	}{
+		{name: "declares the package it is given", file: "Client.kt", want: "package org.example.bot\n"},
+		{name: "stamps the release the specification was read from", file: "Api.kt", want: "//     Bot API 10.2\n"},
+		{name: "declares every record", file: "Api.kt", want: "data object LogOutMethod {"},
+		{
+			name: "refuses to read a union only a request carries",
+			file: "Api.kt",
+			want: "throw SerializationException(\"ReplyMarkup is only ever sent, never read\")",
+		},
+		{name: "writes the transport every method is called through", file: "Client.kt", want: "class HttpConnection("},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, files[tc.file], tc.want, "Pass must write %s as Kotlin reads it", tc.file)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Type represents a Kotlin type expression rendered from a resolved type and
+// the optionality of whatever carries it.
+type Type struct {
+	typ typebound.Type
+	opt model.Optionality
+}
+
+// NewType creates a Type from a resolved type and its optionality.
+func NewType(typ typebound.Type, opt model.Optionality) Type {
+	return Type{typ: typ, opt: opt}
+}
+
+// NewRequiredType creates a Type from a resolved type no optionality applies
+// to, such as the return of a method.
+func NewRequiredType(typ typebound.Type) Type {
+	return NewType(typ, false)
+}
+
+// Value returns the Kotlin type expression: the name of the atom, enclosed in
+// one List per dimension, and nullable when the caller may leave it unset.
+// Kotlin tells an absent value from a zero one by the type alone, so a single
+// value and a list are made optional the same way.
+func (t Type) Value() string {
+	value := t.Name()
+	for range t.typ.Dimensionality() {
+		value = "List<" + value + ">"
+	}
+	if t.opt {
+		return value + "?"
+	}
+	return value
+}
+
+// Default returns what the property defaults to in the primary constructor,
+// empty when the caller has to name it: an optional property defaults to null,
+// which leaves it out of the encoded JSON too.
+func (t Type) Default() string {
+	if t.opt {
+		return " = null"
+	}
+	return ""
+}
+
+// Name returns the Kotlin name of the atom the type holds, without the lists
+// enclosing it.
+func (t Type) Name() string {
+	switch atom := t.typ.Atom().(type) {
+	case typebound.Primitive:
+		return builtin(atom.Kind())
+	case typebound.Object:
+		return NewName(atom.Name()).Value()
+	case typebound.Union:
+		return NewName(atom.Name()).Value()
+	case typebound.Alias:
+		return NewName(atom.Name()).Value()
+	default:
+		panic(fmt.Sprintf("kotlin: unknown atom %T", atom))
+	}
+}
+
+// builtin returns the Kotlin type a built-in of the documentation renders as.
+// An integer is a Long, since the identifiers the Bot API hands out outgrow 32
+// bits.
+func builtin(kind primitive.Kind) string {
+	switch kind {
+	case primitive.Integer:
+		return "Long"
+	case primitive.Float:
+		return "Double"
+	case primitive.String:
+		return "String"
+	case primitive.Boolean, primitive.True:
+		return "Boolean"
+	default:
+		panic(fmt.Sprintf("kotlin: unknown primitive %q", kind))
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Union represents the Kotlin declaration of a union nothing tells the variants
+// of apart: the sealed interface, and the serializer it is read and written
+// with. The serializer writes whichever variant it holds as that variant, but
+// it reads nothing on its own. Which variant a payload holds is not written
+// anywhere the specification can be read from, so a union any response carries
+// is decoded by a function written by hand, in the block claiming this
+// reference, and one none carries refuses to be read at all.
+type Union struct {
+	inner   ir.Union
+	lineage Lineage
+}
+
+// NewUnion creates a Union from the record of a union and the lineage of the
+// sequence it stands in.
+func NewUnion(u ir.Union, lineage Lineage) Union {
+	return Union{inner: u, lineage: lineage}
+}
+
+// Doc returns the KDoc of the declaration, closing with a link back to the
+// section the union was read from.
+func (u Union) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u Union) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u Union) Template() string {
+	return "union"
+}
+
+// Name returns the Kotlin name the union declares.
+func (u Union) Name() string {
+	return NewName(u.inner.Name).Value()
+}
+
+// Supertypes returns the clause naming the unions admitting this one.
+func (u Union) Supertypes() string {
+	return u.lineage.Supertypes(u.inner.Name)
+}
+
+// Carrier reports whether a file is reached through the union, which obliges
+// every variant to answer for the rewrite the union dispatches.
+func (u Union) Carrier() bool {
+	return u.inner.Carrier
+}
+
+// Variants returns the types the union stands for, in the order the
+// documentation listed them.
+func (u Union) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.Variant) Variant {
+		return NewVariant(v.Name, u.lineage)
+	})
+}
+
+// Direction returns which way the union travels, which is what decides whether
+// its serializer reads anything at all.
+func (u Union) Direction() Direction {
+	return NewDirection(u.inner.Direction)
+}
+
+// Variant represents one variant of a union: the type it names, and how the
+// serializer of the union writes it.
+type Variant struct {
+	name    model.Name
+	lineage Lineage
+}
+
+// NewVariant creates a Variant naming a type, knowing the lineage of the
+// sequence it stands in.
+func NewVariant(name model.Name, lineage Lineage) Variant {
+	return Variant{name: name, lineage: lineage}
+}
+
+// Name returns the Kotlin name of the type the variant names.
+func (v Variant) Name() string {
+	return NewName(v.name).Value()
+}
+
+// Discriminator returns the field the type is told apart by, nil when it is
+// not an object a union tells apart. A union nothing tells apart may still
+// admit such an object, and writing it as itself would lose the value telling
+// it apart, since its serializer writes the value only for the interface of a
+// union that declares the key.
+func (v Variant) Discriminator() *Discriminator {
+	d, ok := v.lineage.Discriminator(v.name)
+	if !ok {
+		return nil
+	}
+	return &d
+}
+
+// DiscriminatedUnion represents the Kotlin declaration of a union one key tells
+// every variant of apart: a sealed interface kotlinx.serialization reads and
+// writes on its own, told which key the variants are told apart under.
+type DiscriminatedUnion struct {
+	inner   ir.DiscriminatedUnion
+	lineage Lineage
+}
+
+// NewDiscriminatedUnion creates a DiscriminatedUnion from the record of a
+// discriminated union and the lineage of the sequence it stands in.
+func NewDiscriminatedUnion(u ir.DiscriminatedUnion, lineage Lineage) DiscriminatedUnion {
+	return DiscriminatedUnion{inner: u, lineage: lineage}
+}
+
+// Doc returns the KDoc of the declaration, closing with a link back to the
+// section the union was read from.
+func (u DiscriminatedUnion) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u DiscriminatedUnion) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u DiscriminatedUnion) Template() string {
+	return "discriminated_union"
+}
+
+// Name returns the Kotlin name the union declares.
+func (u DiscriminatedUnion) Name() string {
+	return NewName(u.inner.Name).Value()
+}
+
+// Supertypes returns the clause naming the unions admitting this one.
+func (u DiscriminatedUnion) Supertypes() string {
+	return u.lineage.Supertypes(u.inner.Name)
+}
+
+// Key returns the JSON key the variants are told apart under.
+func (u DiscriminatedUnion) Key() string {
+	return string(u.inner.Key)
+}
+
+// Carrier reports whether a file is reached through the union, which obliges
+// every variant to answer for the rewrite the union dispatches.
+func (u DiscriminatedUnion) Carrier() bool {
+	return u.inner.Carrier
+}
+
+// Variants returns the types the union stands for, in the order the
+// documentation listed them.
+func (u DiscriminatedUnion) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.DiscriminatedVariant) Variant {
+		return NewVariant(v.Name, u.lineage)
+	})
+}
+
+// Direction returns which way the union travels. The declaration is the same
+// either way, since kotlinx.serialization derives both halves of the
+// serializer from it.
+func (u DiscriminatedUnion) Direction() Direction {
+	return NewDirection(u.inner.Direction)
+}
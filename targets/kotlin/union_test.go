@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package kotlin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+	"github.com/andreychh/tgen/targets/kotlin"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+func TestUnion_Variants(t *testing.T) {
+	union := kotlin.NewUnion(
+		targettest.Record[ir.Union](t, "replymarkup"),
+		kotlin.NewLineage(targettest.Records(t)),
+	)
+	assert.Equal(
+		t,
+		[]string{"ForceReply", "ReplyKeyboardRemove"},
+		slices.NewMapped(union.Variants(), kotlin.Variant.Name),
+		"Union.Variants must name the types the union admits in the order they were listed",
+	)
+}
+
+func TestUnion_Direction(t *testing.T) {
+	union := kotlin.NewUnion(
+		targettest.Record[ir.Union](t, "replymarkup"),
+		kotlin.NewLineage(targettest.Records(t)),
+	)
+	assert.True(t, union.Direction().Outbound(), "a union only a request carries must travel outbound")
+	assert.False(t, union.Direction().Received(), "a union no response carries must refuse to be read")
+}
+
+func TestVariant_Discriminator(t *testing.T) {
+	variant := kotlin.NewVariant("ForceReply", kotlin.NewLineage(targettest.Records(t)))
+	assert.Nil(t, variant.Discriminator(), "a variant no key tells apart must write itself without one")
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package targets
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Lineage represents what the records of the pipeline's exit know about one
+// another the other way round from how they say it: the unions admitting each
+// type, and the value each object a union tells apart is told apart by.
+//
+// A union lists its variants and a variant says nothing of the unions listing
+// it, which is all Go and Python need, since both attach a type to a union from
+// outside it. Kotlin, C# and Swift do not: a sealed interface, an interface and
+// a discriminated enum are all tied to at the declaration of the variant, so
+// the variant has to know.
+type Lineage struct {
+	unions         map[model.Name][]model.Name
+	discriminators map[model.Name]ir.Discriminator
+}
+
+// NewLineage creates a Lineage from every record of the pipeline's exit.
+func NewLineage(records []ir.Definition) Lineage {
+	l := Lineage{
+		unions:         make(map[model.Name][]model.Name),
+		discriminators: make(map[model.Name]ir.Discriminator),
+	}
+	for _, record := range records {
+		switch record := record.(type) {
+		case ir.Union:
+			for _, variant := range record.Variants {
+				l.unions[variant.Name] = append(l.unions[variant.Name], record.Name)
+			}
+		case ir.DiscriminatedUnion:
+			for _, variant := range record.Variants {
+				l.unions[variant.Name] = append(l.unions[variant.Name], record.Name)
+			}
+		case ir.DiscriminatedObject:
+			l.discriminators[record.Name] = record.Discriminator
+		}
+	}
+	return l
+}
+
+// Unions returns the names of the unions admitting the type, in the order their
+// records came, empty when no union admits it.
+func (l Lineage) Unions(name model.Name) []model.Name {
+	return l.unions[name]
+}
+
+// Discriminator returns the record of the field the type is told apart by, and
+// false when it is not an object a union tells apart.
+func (l Lineage) Discriminator(name model.Name) (ir.Discriminator, bool) {
+	d, ok := l.discriminators[name]
+	return d, ok
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package targets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets"
+)
+
+// records returns a message origin told apart by its type and a reply markup
+// admitting a keyboard, with the keyboard admitted by a second union too.
+func records() []ir.Definition {
+	return []ir.Definition{
+		ir.DiscriminatedUnion{
+			Ref:      "messageorigin",
+			Name:     "MessageOrigin",
+			Key:      "type",
+			Variants: []ir.DiscriminatedVariant{{Name: "MessageOriginUser", Value: "user"}},
+		},
+		ir.DiscriminatedObject{
+			Ref:           "messageoriginuser",
+			Name:          "MessageOriginUser",
+			Discriminator: ir.Discriminator{Key: "type", Value: "user"},
+		},
+		ir.Union{Ref: "replymarkup", Name: "ReplyMarkup", Variants: []ir.Variant{{Name: "InlineKeyboardMarkup"}}},
+		ir.Union{Ref: "markup", Name: "Markup", Variants: []ir.Variant{{Name: "InlineKeyboardMarkup"}}},
+		ir.Object{Ref: "inlinekeyboardmarkup", Name: "InlineKeyboardMarkup"},
+	}
+}
+
+func TestLineage_Unions(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Name
+		want  []model.Name
+	}{
+		{name: "names the union telling a variant apart", input: "MessageOriginUser", want: []model.Name{"MessageOrigin"}},
+		{
+			name:  "names every union admitting a type in the order they came",
+			input: "InlineKeyboardMarkup",
+			want:  []model.Name{"ReplyMarkup", "Markup"},
+		},
+		{name: "names nothing for a type no union admits", input: "MessageOrigin", want: nil},
+	}
+	lineage := targets.NewLineage(records())
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, lineage.Unions(tc.input), "Lineage.Unions must name the unions admitting the type")
+		})
+	}
+}
+
+func TestLineage_Discriminator(t *testing.T) {
+	lineage := targets.NewLineage(records())
+	d, ok := lineage.Discriminator("MessageOriginUser")
+	assert.True(t, ok, "Lineage.Discriminator must find the field an object a union tells apart is told apart by")
+	assert.Equal(t, ir.Discriminator{Key: "type", Value: "user"}, d,
+		"Lineage.Discriminator must hand the key and the value the object is told apart by")
+	_, ok = lineage.Discriminator("InlineKeyboardMarkup")
+	assert.False(t, ok, "Lineage.Discriminator must find nothing for an object no key tells apart")
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Alias represents the protobuf declaration of a name tgen gives a type the
+// documentation leaves unnamed: a message with the type as its one field. A
+// oneof holds messages and scalars alike, but two of its fields holding a
+// string would be told apart by nothing but their names, and every alias tgen
+// introduces today exists to be told apart from its siblings as the variant of
+// a union — so it names a message of its own, as it names a type of its own in
+// every other target.
+type Alias struct {
+	inner ir.Alias
+}
+
+// NewAlias creates an Alias from the record of an alias.
+func NewAlias(a ir.Alias) Alias {
+	return Alias{inner: a}
+}
+
+// Doc returns the documentation comment of the message, opening with the name
+// of the message. An alias carries no link back to the documentation: tgen
+// introduces it, so no section documents it.
+func (a Alias) Doc() string {
+	return NewMessageDoc(named(a.inner.Description, a.inner.Name), "").Value()
+}
+
+// Ref implements [Declaration].
+func (a Alias) Ref() string {
+	return string(a.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (a Alias) Template() string {
+	return "alias"
+}
+
+// Name returns the name the message declares.
+func (a Alias) Name() string {
+	return NewName(a.inner.Name).Message()
+}
+
+// Label returns the label the one field is declared with, followed by a space,
+// or nothing when it takes none.
+func (a Alias) Label() string {
+	return NewRequiredType(a.inner.Type).Label()
+}
+
+// Type returns the name of the type the one field holds.
+func (a Alias) Type() string {
+	return NewRequiredType(a.inner.Type).Name()
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/registry"
+)
+
+// Catalog represents what a message has to look up beyond its own record: the
+// number each of its fields holds, the numbers it holds reserved, and, for a
+// oneof, the reference each of its variants is numbered by. It is built over
+// the whole sequence at once, since a number handed out to one field depends
+// on every number its owner has handed out before, and a number is reserved by
+// the field a release no longer declares anywhere.
+type Catalog struct {
+	registry registry.Registry
+	refs     map[model.Name]model.Reference
+}
+
+// NewCatalog creates a Catalog over records, bringing the registry up to the
+// release named release. It fails when the registry refuses the release.
+func NewCatalog(records []ir.Definition, reg registry.Registry, release model.ReleaseVersion) (Catalog, error) {
+	refs := references(records)
+	fields := make([]model.FieldKey, 0)
+	variants := make([]model.VariantKey, 0)
+	for _, record := range records {
+		switch record := record.(type) {
+		case ir.Object:
+			for _, f := range record.Fields {
+				fields = append(fields, model.FieldKey{Owner: record.Ref, Key: f.Key})
+			}
+		case ir.DiscriminatedObject:
+			for _, f := range record.Fields {
+				fields = append(fields, model.FieldKey{Owner: record.Ref, Key: f.Key})
+			}
+		case ir.Union:
+			for _, v := range record.Variants {
+				variants = append(variants, model.VariantKey{Owner: record.Ref, Ref: refs[v.Name]})
+			}
+		case ir.DiscriminatedUnion:
+			for _, v := range record.Variants {
+				variants = append(variants, model.VariantKey{Owner: record.Ref, Ref: refs[v.Name]})
+			}
+		}
+	}
+	updated, err := reg.Updated(fields, variants, release)
+	if err != nil {
+		return Catalog{}, fmt.Errorf("numbering fields: %w", err)
+	}
+	return Catalog{registry: updated, refs: refs}, nil
+}
+
+// Registry returns the registry the catalog reads numbers from, holding one for
+// every field and every variant of the sequence it was built over.
+func (c Catalog) Registry() registry.Registry {
+	return c.registry
+}
+
+// Field returns the number of the field key declared by owner. Every field of
+// the sequence was numbered when the catalog was built, so a field holding no
+// number is one the catalog was never shown, which is a fault of the target.
+func (c Catalog) Field(owner model.Reference, key model.Key) int {
+	entry, ok := c.registry.Field(model.FieldKey{Owner: owner, Key: key})
+	if !ok {
+		panic(fmt.Sprintf("proto: field %s.%s was never numbered", owner, key))
+	}
+	return entry.Number
+}
+
+// Variant returns the number of the variant named name within the union
+// owner, under the same guarantee [Catalog.Field] gives.
+func (c Catalog) Variant(owner model.Reference, name model.Name) int {
+	entry, ok := c.registry.Variant(model.VariantKey{Owner: owner, Ref: c.refs[name]})
+	if !ok {
+		panic(fmt.Sprintf("proto: variant %s of %s was never numbered", name, owner))
+	}
+	return entry.Number
+}
+
+// Reserved returns what owner holds reserved: the numbers of the fields and
+// variants a release removed, ordered by number.
+func (c Catalog) Reserved(owner model.Reference) Reserved {
+	return NewReserved(c.registry.RetiredFields(owner), c.registry.RetiredVariants(owner))
+}
+
+// references returns the reference of every record a union may list as a
+// variant, keyed by the name a union lists it by. A variant is numbered by its
+// reference rather than its name, since the reference is what the rest of the
+// pipeline keys a variant by.
+func references(records []ir.Definition) map[model.Name]model.Reference {
+	out := make(map[model.Name]model.Reference, len(records))
+	for _, record := range records {
+		switch record := record.(type) {
+		case ir.Object:
+			out[record.Name] = record.Ref
+		case ir.DiscriminatedObject:
+			out[record.Name] = record.Ref
+		case ir.Union:
+			out[record.Name] = record.Ref
+		case ir.DiscriminatedUnion:
+			out[record.Name] = record.Ref
+		case ir.Alias:
+			out[record.Name] = record.Ref
+		}
+	}
+	return out
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"fmt"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Declaration represents one message of the generated schema. The file walking
+// the sequence knows only two things about it: the template rendering its
+// shape and the reference a block written by hand claims it by. What that
+// template reads is the business of the view behind it.
+type Declaration interface {
+	// Ref returns the reference the declaration is addressed by. A block written
+	// by hand claims the declaration by that reference, which no target respells.
+	Ref() string
+	// Template returns the name of the template rendering the declaration.
+	Template() string
+}
+
+// NewDeclaration creates the declaration one record of the pipeline's exit is
+// rendered as, numbered by catalog. A method is no message, and is not passed
+// here.
+func NewDeclaration(record ir.Definition, catalog Catalog) Declaration {
+	switch record := record.(type) {
+	case ir.Object:
+		return NewObject(record, catalog)
+	case ir.DiscriminatedObject:
+		return NewDiscriminatedObject(record, catalog)
+	case ir.Union:
+		return NewUnion(record, catalog)
+	case ir.DiscriminatedUnion:
+		return NewDiscriminatedUnion(record, catalog)
+	case ir.Alias:
+		return NewAlias(record)
+	default:
+		panic(fmt.Sprintf("proto: unknown definition %T", record))
+	}
+}
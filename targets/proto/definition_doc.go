@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/andreychh/tgen/targets"
+)
+
+// DefinitionDoc represents the documentation comment of a definition: the prose
+// describing it, followed by a link to the section of the documentation page it
+// stands at, where it stands at one.
+type DefinitionDoc struct {
+	ref        model.Reference
+	name       model.Name
+	passage    prose.Passage
+	introduced bool
+}
+
+// NewDefinitionDoc creates a DefinitionDoc for the definition at ref named name
+// from the prose describing it and whether tgen introduced it.
+func NewDefinitionDoc(ref model.Reference, name model.Name, passage prose.Passage, introduced bool) DefinitionDoc {
+	return DefinitionDoc{ref: ref, name: name, passage: passage, introduced: introduced}
+}
+
+// Value returns the comment. The link is left out when tgen introduced the
+// definition, since the page never named it and so gave no section to address,
+// and the prose tgen wrote for it opens with the name of the message it sits
+// above.
+func (d DefinitionDoc) Value() string {
+	if d.introduced {
+		return NewMessageDoc(named(d.passage, d.name), "").Value()
+	}
+	return NewMessageDoc(d.passage, targets.NewTelegramURL(d.ref).Value()).Value()
+}
+
+// named returns passage opening with the name of the message a definition named
+// name declares. The prose tgen writes for a definition it introduces opens with
+// the name of the definition spelled the way Go spells it — ID for the message
+// Id, True for the message TrueValue — so the first word is taken for the name
+// whenever it spells the name in any case, and replaced. A passage opening with
+// anything else is returned as it is.
+func named(passage prose.Passage, name model.Name) prose.Passage {
+	blocks := passage.Blocks()
+	if len(blocks) == 0 {
+		return passage
+	}
+	paragraph, ok := blocks[0].(prose.Paragraph)
+	if !ok || len(paragraph.Inlines()) == 0 {
+		return passage
+	}
+	text, ok := paragraph.Inlines()[0].(prose.Text)
+	if !ok {
+		return passage
+	}
+	word, rest, found := strings.Cut(text.Content(), " ")
+	if !found || !strings.EqualFold(word, string(name)) {
+		return passage
+	}
+	inlines := append(
+		[]prose.Inline{prose.NewText(NewName(name).Message()+" "+rest, text.Style())},
+		paragraph.Inlines()[1:]...,
+	)
+	return prose.NewPassage(append([]prose.Block{prose.NewParagraph(inlines...)}, blocks[1:]...)...)
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/andreychh/tgen/targets/proto"
+)
+
+func TestDefinitionDoc_Value(t *testing.T) {
+	cases := []struct {
+		name       string
+		ref        model.Reference
+		input      model.Name
+		text       string
+		introduced bool
+		want       string
+	}{
+		{
+			name:       "names the message an introduced definition declares",
+			ref:        "id",
+			input:      "Id",
+			text:       "ID represents a numeric Telegram chat or user identifier.",
+			introduced: true,
+			want:       "// Id represents a numeric Telegram chat or user identifier.",
+		},
+		{
+			name:       "names the message a literal is declared as",
+			ref:        "true",
+			input:      "True",
+			text:       "True represents the boolean true value in Telegram API responses.",
+			introduced: true,
+			want:       "// TrueValue represents the boolean true value in Telegram API responses.",
+		},
+		{
+			name:       "keeps prose opening with anything but the name",
+			ref:        "upload",
+			input:      "Upload",
+			text:       "A file sent with the request.",
+			introduced: true,
+			want:       "// A file sent with the request.",
+		},
+		{
+			name:  "links a documented definition back to its section",
+			ref:   "user",
+			input: "User",
+			text:  "This object represents a Telegram user or bot.",
+			want: "// This object represents a Telegram user or bot.\n" +
+				"//\n" +
+				"// See https://core.telegram.org/bots/api#user",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			passage := prose.NewPassage(prose.NewParagraph(prose.NewText(tc.text, prose.StylePlain)))
+			assert.Equal(t, tc.want, proto.NewDefinitionDoc(tc.ref, tc.input, passage, tc.introduced).Value(),
+				"DefinitionDoc.Value must open with the name of the message the comment sits above")
+		})
+	}
+}
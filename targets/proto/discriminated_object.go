@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// DiscriminatedObject represents the protobuf declaration of an object some
+// union tells apart by the value of a key. It is a message like any other, and
+// declares no field for the key: the oneof holding it says which variant it is
+// by the field it sets, and the message says which it is by being the one it
+// is, so the value would be written only to be read back as itself.
+type DiscriminatedObject struct {
+	inner   ir.DiscriminatedObject
+	catalog Catalog
+}
+
+// NewDiscriminatedObject creates a DiscriminatedObject from the record of a
+// discriminated object, numbered by catalog.
+func NewDiscriminatedObject(o ir.DiscriminatedObject, catalog Catalog) DiscriminatedObject {
+	return DiscriminatedObject{inner: o, catalog: catalog}
+}
+
+// Doc returns the documentation comment of the message, closing with a link
+// back to the section the object was read from.
+func (o DiscriminatedObject) Doc() string {
+	return NewDefinitionDoc(o.inner.Ref, o.inner.Name, o.inner.Description, o.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (o DiscriminatedObject) Ref() string {
+	return string(o.inner.Ref)
+}
+
+// Template implements [Declaration]. The message is rendered through the shape
+// every object is, since nothing of the discriminator reaches it.
+func (o DiscriminatedObject) Template() string {
+	return "object"
+}
+
+// Name returns the name the message declares.
+func (o DiscriminatedObject) Name() string {
+	return NewName(o.inner.Name).Message()
+}
+
+// Fields returns the fields the message declares, the discriminating key left
+// out, in the order the documentation listed them.
+func (o DiscriminatedObject) Fields() []Field {
+	return slices.NewMapped(o.inner.Fields, func(f ir.Field) Field {
+		return NewField(f, o.catalog.Field(o.inner.Ref, f.Key))
+	})
+}
+
+// Reserved returns the numbers and names the message holds reserved for the
+// fields a release removed.
+func (o DiscriminatedObject) Reserved() Reserved {
+	return o.catalog.Reserved(o.inner.Ref)
+}
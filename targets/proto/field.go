@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Field represents the protobuf declaration of a field an object owns: its
+// label, its type, its name and the number it is known by on the wire. The
+// name is the key the documentation gives it, which is already in the snake
+// case protobuf names fields in, so the JSON mapping of the message reads and
+// writes the same keys the Bot API does once told to keep field names.
+type Field struct {
+	inner  ir.Field
+	number int
+}
+
+// NewField creates a Field from the record of a field and the number it holds.
+func NewField(f ir.Field, number int) Field {
+	return Field{inner: f, number: number}
+}
+
+// Doc returns the documentation comment of the field.
+func (f Field) Doc() string {
+	return NewFieldDoc(f.inner.Description).Value()
+}
+
+// Label returns the label the field is declared with, followed by a space, or
+// nothing when it takes none.
+func (f Field) Label() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Label()
+}
+
+// Type returns the name of the type the field holds.
+func (f Field) Type() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Name()
+}
+
+// Name returns the name the field declares.
+func (f Field) Name() string {
+	return string(f.inner.Key)
+}
+
+// Number returns the number the field is known by on the wire.
+func (f Field) Number() int {
+	return f.number
+}
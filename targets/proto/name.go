@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"slices"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/iancoleman/strcase"
+)
+
+// literals are the words protobuf reads as values wherever a value may stand:
+// the booleans and the floating point specials. A field is named after the
+// message a oneof holds, so a message spelling one of them would name a field
+// the text format and every option reading a value take for the literal.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var literals = []string{"true", "false", "inf", "nan"}
+
+// Name represents the name a message is declared under. It is the name of the
+// definition, unless that name is a literal once spelled as a field, in which
+// case Value is appended to it. The True the MaybeMessage union holds is the
+// one such message today: it is declared as TrueValue and held as true_value.
+type Name struct {
+	name model.Name
+}
+
+// NewName creates the Name of the message a definition named name declares.
+func NewName(name model.Name) Name {
+	return Name{name: name}
+}
+
+// Message returns the name the message is declared and referred to under.
+func (n Name) Message() string {
+	if slices.Contains(literals, strcase.ToSnake(string(n.name))) {
+		return string(n.name) + "Value"
+	}
+	return string(n.name)
+}
+
+// Field returns the name of a field holding the message, the name of the
+// message in the snake case a field is spelled in.
+func (n Name) Field() string {
+	return strcase.ToSnake(n.Message())
+}
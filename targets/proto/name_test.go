@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/targets/proto"
+)
+
+func TestName(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   model.Name
+		message string
+		field   string
+	}{
+		{
+			name:    "keeps the name of an object",
+			input:   "ReplyKeyboardRemove",
+			message: "ReplyKeyboardRemove",
+			field:   "reply_keyboard_remove",
+		},
+		{name: "keeps the name of an alias tgen introduces", input: "FileId", message: "FileId", field: "file_id"},
+		{name: "appends Value to a name spelling a literal", input: "True", message: "TrueValue", field: "true_value"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.message, proto.NewName(tc.input).Message(),
+				"Name.Message must declare a message no literal is spelled as")
+			assert.Equal(t, tc.field, proto.NewName(tc.input).Field(),
+				"Name.Field must hold the message in the snake case of its name")
+		})
+	}
+}
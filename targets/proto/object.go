@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Object represents the protobuf declaration of a documented object: a message
+// with a field per field of the object.
+type Object struct {
+	inner   ir.Object
+	catalog Catalog
+}
+
+// NewObject creates an Object from the record of an object, numbered by
+// catalog.
+func NewObject(o ir.Object, catalog Catalog) Object {
+	return Object{inner: o, catalog: catalog}
+}
+
+// Doc returns the documentation comment of the message, closing with a link
+// back to the section the object was read from.
+func (o Object) Doc() string {
+	return NewDefinitionDoc(o.inner.Ref, o.inner.Name, o.inner.Description, o.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (o Object) Ref() string {
+	return string(o.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (o Object) Template() string {
+	return "object"
+}
+
+// Name returns the name the message declares.
+func (o Object) Name() string {
+	return NewName(o.inner.Name).Message()
+}
+
+// Fields returns the fields the message declares, in the order the
+// documentation listed them, which is not necessarily the order of their
+// numbers: a field a release adds between two others takes the next number
+// its owner has, and stands where the page put it.
+func (o Object) Fields() []Field {
+	return slices.NewMapped(o.inner.Fields, func(f ir.Field) Field {
+		return NewField(f, o.catalog.Field(o.inner.Ref, f.Key))
+	})
+}
+
+// Reserved returns the numbers and names the message holds reserved for the
+// fields a release removed.
+func (o Object) Reserved() Reserved {
+	return o.catalog.Reserved(o.inner.Ref)
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"embed"
+	"fmt"
+	"text/template"
+
+	"github.com/andreychh/tgen/output"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// RegistryFile is the name of the file the registry is written to, beside the
+// schema. A run reads it back from the same directory before numbering
+// anything, which is how a number handed out once survives every release.
+const RegistryFile = "registry.json"
+
+// Pass is the protobuf generation stage: it renders the records of the
+// pipeline's exit into a schema, and the registry the schema was numbered by
+// into the file the next run starts from.
+type Pass struct {
+	gen Generation
+}
+
+// NewPass creates a Pass rendering the given generation.
+func NewPass(gen Generation) Pass {
+	return Pass{gen: gen}
+}
+
+// Artifacts returns the files the target writes. The schema is bound to the
+// template rendering it; the registry is brought up to the release here rather
+// than while the schema renders, so a release the registry refuses writes
+// nothing, and the file holds exactly the numbers the schema was written with.
+// It fails when a template is malformed, the records cannot be read, or the
+// registry refuses the release.
+func (p Pass) Artifacts() (output.Artifacts, error) {
+	tmpl, err := output.NewMold(templates, template.FuncMap{}).Template()
+	if err != nil {
+		return nil, fmt.Errorf("preparing template: %w", err)
+	}
+	catalog, err := p.gen.Spec().Catalog()
+	if err != nil {
+		return nil, err
+	}
+	return output.Artifacts{
+		"telegram.proto": output.NewTemplateView(tmpl, "schema", p.gen),
+		RegistryFile:     catalog.Registry(),
+	}, nil
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/registry"
+	"github.com/andreychh/tgen/targets/proto"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+// render returns the files the pass writes for the test bot, numbered by the
+// registry an earlier run left as document, or from scratch when it is empty.
+func render(t *testing.T, document string) map[string]string {
+	t.Helper()
+	reg := registry.New()
+	if document != "" {
+		var err error
+		reg, err = registry.Parse([]byte(document))
+		require.NoError(t, err, "the registry an earlier run left must be readable")
+	}
+	artifacts, err := proto.NewPass(
+		proto.NewGeneration(
+			proto.NewSpecification(ir.NewSpecification(targettest.Specification()), reg),
+			"example.bot",
+			targettest.Snapshot(),
+		),
+	).Artifacts()
+	require.NoError(t, err, "Pass must write the test bot")
+	return targettest.Render(t, artifacts)
+}
+
+func TestPass_Artifacts(t *testing.T) {
+	files := render(t, "")
+	assert.ElementsMatch(
+		t,
+		[]string{"telegram.proto", "registry.json"},
+		slices.Collect(maps.Keys(files)),
+		"Pass must write the schema and the registry it was numbered by",
+	)
+	cases := []struct {
+		name string
+		want string
+	}{
+		{name: "declares the package it is given", want: "package example.bot;\n"},
+		{name: "stamps the release the specification was read from", want: "//     Bot API 10.2\n"},
+		{
+			name: "numbers the fields of a message in the order the page lists them",
+			want: "message User {\n" +
+				"  int64 id = 1;\n" +
+				"  string first_name = 2;\n" +
+				"  optional string username = 3;\n" +
+				"}\n",
+		},
+		{
+			name: "holds the variants of a union in a oneof named after their messages",
+			want: "message ReplyMarkup {\n" +
+				"  oneof value {\n" +
+				"    ForceReply force_reply = 1;\n" +
+				"    ReplyKeyboardRemove reply_keyboard_remove = 2;\n" +
+				"  }\n" +
+				"}\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, files["telegram.proto"], tc.want, "Pass must write the schema as protoc reads it")
+		})
+	}
+	assert.NotContains(t, files["telegram.proto"], "GetMe", "Pass must leave the methods out of the schema")
+}
+
+func TestPass_Artifacts_registry(t *testing.T) {
+	files := render(t, `{
+		"fields": {"user": {"id": {"number": 1}, "last_name": {"number": 2}}},
+		"variants": {"replymarkup": {"replykeyboardremove": {"number": 1}}}
+	}`)
+	cases := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "numbers a new field after the highest its message held and reserves a dropped one",
+			want: "message User {\n" +
+				"  reserved 2;\n" +
+				"  reserved \"last_name\";\n" +
+				"  int64 id = 1;\n" +
+				"  string first_name = 3;\n" +
+				"  optional string username = 4;\n" +
+				"}\n",
+		},
+		{
+			name: "keeps the number a variant held whatever the order of the oneof",
+			want: "  oneof value {\n" +
+				"    ForceReply force_reply = 2;\n" +
+				"    ReplyKeyboardRemove reply_keyboard_remove = 1;\n" +
+				"  }\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, files["telegram.proto"], tc.want,
+				"Pass must number the schema by the registry an earlier run left")
+		})
+	}
+}
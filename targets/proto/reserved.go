@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/andreychh/tgen/registry"
+)
+
+// Reserved represents the reserved statements of a message: the numbers of
+// every field and variant a release removed, and the names of the fields. A
+// field name is reserved as well as its number, since the JSON mapping of a
+// message reads a field by name; a variant is reserved by number alone, since
+// the registry knows it by the reference of the message it held rather than
+// the name of the field holding it.
+type Reserved struct {
+	fields   []registry.Slot
+	variants []registry.Slot
+}
+
+// NewReserved creates a Reserved from the retired fields and variants of one
+// message.
+func NewReserved(fields, variants []registry.Slot) Reserved {
+	return Reserved{fields: fields, variants: variants}
+}
+
+// Numbers returns the reserved numbers, ordered and separated by commas, or
+// nothing when the message holds none reserved.
+func (r Reserved) Numbers() string {
+	numbers := make([]int, 0, len(r.fields)+len(r.variants))
+	for _, slot := range slices.Concat(r.fields, r.variants) {
+		numbers = append(numbers, slot.Number)
+	}
+	slices.Sort(numbers)
+	out := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		out = append(out, strconv.Itoa(number))
+	}
+	return strings.Join(out, ", ")
+}
+
+// Names returns the reserved field names, quoted and separated by commas in
+// the order of their numbers, or nothing when the message holds none
+// reserved.
+func (r Reserved) Names() string {
+	out := make([]string, 0, len(r.fields))
+	for _, slot := range r.fields {
+		out = append(out, strconv.Quote(slot.Name))
+	}
+	return strings.Join(out, ", ")
+}
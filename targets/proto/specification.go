@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+// Package proto renders the records of the pipeline's exit as a Protocol
+// Buffers schema: every object becomes a message, every union a message
+// holding its variants in a oneof, and every array of arrays a message wrapping
+// the inner array, since a repeated field cannot repeat itself. Methods are not
+// rendered: the schema describes what Telegram sends and takes, for systems
+// passing it along, and leaves calling the API to the clients the other
+// targets write.
+package proto
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/typebound"
+	"github.com/andreychh/tgen/model/typeform"
+	"github.com/andreychh/tgen/registry"
+)
+
+// Specification represents the protobuf view of the specification: the
+// messages the schema is rendered from, the release they were read from, and
+// the registry they are numbered by. The registry is the one thing an earlier
+// run decides for this one, and it is read against the records rather than
+// beside them, so it belongs here and not to [Generation].
+type Specification struct {
+	inner    ir.Specification
+	registry registry.Registry
+}
+
+// NewSpecification creates a Specification over the records of the pipeline's
+// exit, numbered by reg wherever it has numbered them already.
+func NewSpecification(inner ir.Specification, reg registry.Registry) Specification {
+	return Specification{inner: inner, registry: reg}
+}
+
+// Release returns the Bot API release the specification was read from.
+func (s Specification) Release() Release {
+	return NewRelease(s.inner.Release())
+}
+
+// Catalog returns the catalog the messages look their numbers up in. It fails
+// when the records cannot be read, or when the registry refuses the release
+// they were read from.
+func (s Specification) Catalog() (Catalog, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return Catalog{}, fmt.Errorf("reading definitions: %w", err)
+	}
+	return NewCatalog(records, s.registry, s.inner.Release().Version)
+}
+
+// Definitions returns the messages the schema declares, ordered by the position
+// the source of each record gave it. Methods are skipped, since the schema
+// describes no service. It fails when the records cannot be read, or when the
+// registry refuses the release they were read from.
+func (s Specification) Definitions() ([]Declaration, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	catalog, err := NewCatalog(records, s.registry, s.inner.Release().Version)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Declaration, 0, len(records))
+	for _, record := range records {
+		if _, ok := record.(ir.Method); ok {
+			continue
+		}
+		out = append(out, NewDeclaration(record, catalog))
+	}
+	return out, nil
+}
+
+// Wrappers returns the messages wrapping an inner array, one per depth of
+// every array of arrays the messages hold, ordered by name. A wrapper is named
+// after what it wraps rather than where it is used, so two fields holding the
+// same array of arrays share one. It fails when the records cannot be read.
+func (s Specification) Wrappers() ([]Wrapper, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	wrappers := make(map[string]Wrapper)
+	for _, typ := range held(records) {
+		for depth := typeform.Dimensionality(1); depth < typ.Dimensionality(); depth++ {
+			wrapper := NewWrapper(typ.Atom(), depth)
+			wrappers[wrapper.Name()] = wrapper
+		}
+	}
+	out := make([]Wrapper, 0, len(wrappers))
+	for _, name := range slices.Sorted(maps.Keys(wrappers)) {
+		out = append(out, wrappers[name])
+	}
+	return out, nil
+}
+
+// held returns every type a message of the schema holds: the fields of the
+// objects and the types the aliases stand for.
+func held(records []ir.Definition) []typebound.Type {
+	out := make([]typebound.Type, 0)
+	for _, record := range records {
+		switch record := record.(type) {
+		case ir.Object:
+			for _, f := range record.Fields {
+				out = append(out, f.Type)
+			}
+		case ir.DiscriminatedObject:
+			for _, f := range record.Fields {
+				out = append(out, f.Type)
+			}
+		case ir.Alias:
+			out = append(out, record.Type)
+		}
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Type represents the protobuf type of a field rendered from a resolved type
+// and the optionality of whatever carries it: the label the field is declared
+// with and the name of the type it holds.
+type Type struct {
+	typ typebound.Type
+	opt model.Optionality
+}
+
+// NewType creates a Type from a resolved type and its optionality.
+func NewType(typ typebound.Type, opt model.Optionality) Type {
+	return Type{typ: typ, opt: opt}
+}
+
+// NewRequiredType creates a Type from a resolved type no optionality applies
+// to, such as the one an alias stands for.
+func NewRequiredType(typ typebound.Type) Type {
+	return NewType(typ, false)
+}
+
+// Label returns the label the field is declared with, followed by the space
+// separating it from the type, or nothing when it takes none. An array is
+// repeated whether or not the caller may leave it unset, since proto3 reads an
+// empty repeated field and a missing one alike and admits no label besides.
+// Anything else the caller may leave unset is optional, which is what keeps
+// its absence apart from its zero value.
+func (t Type) Label() string {
+	if t.typ.Dimensionality() > 0 {
+		return "repeated "
+	}
+	if t.opt {
+		return "optional "
+	}
+	return ""
+}
+
+// Name returns the name of the type the field holds. An array of arrays holds
+// the wrapper of its inner array, which is named after the atom it ends in and
+// the arrays enclosing it.
+func (t Type) Name() string {
+	if t.typ.Dimensionality() > 1 {
+		return NewWrapper(t.typ.Atom(), t.typ.Dimensionality()-1).Name()
+	}
+	return atom(t.typ.Atom())
+}
+
+// atom returns the protobuf name of an atom.
+func atom(a typebound.Atom) string {
+	switch a := a.(type) {
+	case typebound.Primitive:
+		return builtin(a.Kind())
+	case typebound.Object:
+		return NewName(a.Name()).Message()
+	case typebound.Union:
+		return NewName(a.Name()).Message()
+	case typebound.Alias:
+		return NewName(a.Name()).Message()
+	default:
+		panic(fmt.Sprintf("proto: unknown atom %T", a))
+	}
+}
+
+// builtin returns the protobuf scalar a built-in of the documentation renders
+// as. An integer is an int64, since the identifiers the Bot API hands out
+// outgrow 32 bits.
+func builtin(kind primitive.Kind) string {
+	switch kind {
+	case primitive.Integer:
+		return "int64"
+	case primitive.Float:
+		return "double"
+	case primitive.String:
+		return "string"
+	case primitive.Boolean, primitive.True:
+		return "bool"
+	default:
+		panic(fmt.Sprintf("proto: unknown primitive %q", kind))
+	}
+}
+
+// wrapped returns the name of the wrapper holding depth arrays of the atom
+// named element.
+func wrapped(element string, depth int) string {
+	return element + strings.Repeat("List", depth)
+}
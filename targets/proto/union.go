@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Union represents the protobuf declaration of a union nothing tells the
+// variants of apart: a message holding a oneof with a field per variant. The
+// field set is what tells the variants apart on the wire, which is the one
+// thing the JSON the union stands for could not.
+type Union struct {
+	inner   ir.Union
+	catalog Catalog
+}
+
+// NewUnion creates a Union from the record of a union, numbered by catalog.
+func NewUnion(u ir.Union, catalog Catalog) Union {
+	return Union{inner: u, catalog: catalog}
+}
+
+// Doc returns the documentation comment of the message, closing with a link
+// back to the section the union was read from.
+func (u Union) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Name, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u Union) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u Union) Template() string {
+	return "union"
+}
+
+// Name returns the name the message declares.
+func (u Union) Name() string {
+	return NewName(u.inner.Name).Message()
+}
+
+// Reserved returns the numbers the message holds reserved for the variants a
+// release removed.
+func (u Union) Reserved() Reserved {
+	return u.catalog.Reserved(u.inner.Ref)
+}
+
+// Variants returns the fields of the oneof, in the order the documentation
+// listed the variants.
+func (u Union) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.Variant) Variant {
+		return NewVariant(v.Name, u.catalog.Variant(u.inner.Ref, v.Name))
+	})
+}
+
+// DiscriminatedUnion represents the protobuf declaration of a union a key tells
+// the variants of apart. It is declared the way any union is: the field of the
+// oneof set already says what the value of the key would, so the key is not
+// carried.
+type DiscriminatedUnion struct {
+	inner   ir.DiscriminatedUnion
+	catalog Catalog
+}
+
+// NewDiscriminatedUnion creates a DiscriminatedUnion from the record of a
+// discriminated union, numbered by catalog.
+func NewDiscriminatedUnion(u ir.DiscriminatedUnion, catalog Catalog) DiscriminatedUnion {
+	return DiscriminatedUnion{inner: u, catalog: catalog}
+}
+
+// Doc returns the documentation comment of the message, closing with a link
+// back to the section the union was read from.
+func (u DiscriminatedUnion) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Name, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u DiscriminatedUnion) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u DiscriminatedUnion) Template() string {
+	return "union"
+}
+
+// Name returns the name the message declares.
+func (u DiscriminatedUnion) Name() string {
+	return NewName(u.inner.Name).Message()
+}
+
+// Reserved returns the numbers the message holds reserved for the variants a
+// release removed.
+func (u DiscriminatedUnion) Reserved() Reserved {
+	return u.catalog.Reserved(u.inner.Ref)
+}
+
+// Variants returns the fields of the oneof, in the order the documentation
+// listed the variants.
+func (u DiscriminatedUnion) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.DiscriminatedVariant) Variant {
+		return NewVariant(v.Name, u.catalog.Variant(u.inner.Ref, v.Name))
+	})
+}
+
+// Variant represents one field of the oneof a union is declared with: the
+// message it holds, the name it is set by and the number it is known by.
+type Variant struct {
+	name   model.Name
+	number int
+}
+
+// NewVariant creates a Variant holding the message named name under number.
+func NewVariant(name model.Name, number int) Variant {
+	return Variant{name: name, number: number}
+}
+
+// Type returns the name of the message the field holds.
+func (v Variant) Type() string {
+	return NewName(v.name).Message()
+}
+
+// Name returns the name of the field, the name of the message it holds in the
+// snake case a field is spelled in.
+func (v Variant) Name() string {
+	return NewName(v.name).Field()
+}
+
+// Number returns the number the field is known by on the wire.
+func (v Variant) Number() int {
+	return v.number
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package proto
+
+import (
+	"github.com/andreychh/tgen/model/typebound"
+	"github.com/andreychh/tgen/model/typeform"
+)
+
+// Wrapper represents the message standing for an array nested in another: a
+// repeated field cannot hold a repeated field, so an array of arrays is a
+// repeated field of messages, each holding one inner array. A wrapper is known
+// by the atom the arrays end in and how many arrays it encloses, so a keyboard
+// of buttons is a repeated InlineKeyboardButtonList and the list is a message
+// holding a repeated InlineKeyboardButton.
+type Wrapper struct {
+	atom  typebound.Atom
+	depth typeform.Dimensionality
+}
+
+// NewWrapper creates the Wrapper of depth arrays of atom.
+func NewWrapper(atom typebound.Atom, depth typeform.Dimensionality) Wrapper {
+	return Wrapper{atom: atom, depth: depth}
+}
+
+// Name returns the name the wrapper declares.
+func (w Wrapper) Name() string {
+	return wrapped(atom(w.atom), int(w.depth))
+}
+
+// Item returns the name of the type the wrapper repeats: the atom itself for
+// the innermost wrapper, and the wrapper one array shallower for any other.
+func (w Wrapper) Item() string {
+	return wrapped(atom(w.atom), int(w.depth)-1)
+}
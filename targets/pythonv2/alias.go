@@ -24,6 +24,13 @@ func (a Alias) Doc() string {
 	return NewClassDocstring(a.inner.Description).Value()
 }
 
+// StatementDoc returns the docstring of the declaration where a backend
+// declares the alias by a statement at the margin rather than as a class, which
+// leaves nothing to indent it.
+func (a Alias) StatementDoc() string {
+	return NewStatementDocstring(a.inner.Description).Value()
+}
+
 // Ref implements [Declaration].
 func (a Alias) Ref() string {
 	return string(a.inner.Ref)
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pythonv2
+
+import (
+	"fmt"
+)
+
+// Backend is the library the generated models are declared with, which decides
+// how a model is read out of a response and written into a request. Everything
+// else the package holds — the transport, the payloads, the fake — is the same
+// whichever backend declares the models, and so is every view the declarations
+// are rendered from: a backend is a directory of templates spelling the same
+// names the shared ones invoke, and nothing on the Go side turns on it.
+type Backend string
+
+const (
+	// Pydantic declares every model as a pydantic BaseModel, which validates
+	// what it reads and tells a union apart on its own. It is the default, and
+	// the one the rest of the package was written against.
+	Pydantic Backend = "pydantic"
+	// Dataclasses declares every model as a standard library dataclass and
+	// writes the decoder reading one out of JSON into the package itself, for a
+	// bot that may install nothing beside httpx.
+	Dataclasses Backend = "dataclasses"
+	// Msgspec declares every model as a msgspec Struct, the object a union
+	// tells apart by a key declared as a tagged one, so msgspec decodes it in a
+	// single pass.
+	Msgspec Backend = "msgspec"
+)
+
+// NewBackend returns the backend named name. It fails on a name no backend
+// answers to.
+func NewBackend(name string) (Backend, error) {
+	switch backend := Backend(name); backend {
+	case Pydantic, Dataclasses, Msgspec:
+		return backend, nil
+	default:
+		return "", fmt.Errorf("unknown backend %q, want pydantic, dataclasses or msgspec", name)
+	}
+}
+
+// dir returns the directory of templates spelling the backend.
+func (b Backend) dir() string {
+	return string(b)
+}
+
+// Requirements returns what a package declaring its models with the backend
+// needs installed, as the requirement specifiers a project lists. httpx is
+// needed whichever backend it is, the transport being shared; the floor of the
+// library a backend is named after is its first release reading a union the
+// type statement declares, which is how every union in the package is declared.
+func (b Backend) Requirements() []string {
+	switch b {
+	case Dataclasses:
+		return []string{"httpx>=0.27"}
+	case Msgspec:
+		return []string{"httpx>=0.27", "msgspec>=0.18.5"}
+	default:
+		return []string{"httpx>=0.27", "pydantic>=2.9"}
+	}
+}
@@ -121,5 +121,18 @@ func (d Discriminator) Annotation() string {
 // Assignment returns the value the field defaults to, which spares a caller
 // naming what the class it is calling already says.
 func (d Discriminator) Assignment() string {
+	return d.Value()
+}
+
+// Key returns the key the object is told apart by, quoted as a Python string
+// literal. A backend declaring the value as the tag of its class rather than as
+// a field names the key there.
+func (d Discriminator) Key() string {
+	return fmt.Sprintf("%q", d.inner.Key)
+}
+
+// Value returns the value the object is told apart by, quoted as a Python
+// string literal.
+func (d Discriminator) Value() string {
 	return fmt.Sprintf("%q", d.inner.Value)
 }
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pythonv2
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// distributionPattern matches a project name the packaging specifications
+// accept and Python can import once normalized: letters, digits and the three
+// separators, opening with a letter, since a module cannot open with a digit,
+// and closing with a letter or a digit.
+var distributionPattern = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+
+// separatorPattern matches a run of the separators a project name may hold,
+// which normalization collapses into one.
+var separatorPattern = regexp.MustCompile(`[-_.]+`)
+
+// Distribution is the project the generated package is published as: the name
+// an index lists it under and an installer is asked for, and the module that
+// name is imported as once installed.
+type Distribution struct {
+	name string
+}
+
+// NewDistribution creates a Distribution published as name. It fails on a name
+// no index accepts or Python cannot import.
+func NewDistribution(name string) (Distribution, error) {
+	if !distributionPattern.MatchString(name) {
+		return Distribution{}, fmt.Errorf("distribution name %q is not a project name Python can import", name)
+	}
+	return Distribution{name: name}, nil
+}
+
+// Name returns the name the project is published as, spelled as it was given.
+func (d Distribution) Name() string {
+	return d.name
+}
+
+// Module returns the name the package is imported as: the project name
+// normalized the way an installer normalizes it, with each run of separators
+// an underscore. The two names differ only where the project name holds a dash
+// or a dot, which an import cannot spell.
+func (d Distribution) Module() string {
+	return separatorPattern.ReplaceAllString(strings.ToLower(d.name), "_")
+}
@@ -36,10 +36,20 @@ func (f Field) Annotation() string {
 	return NewAnnotation(f.inner.Type, f.inner.Optionality).Value()
 }
 
-// Assignment returns what the declaration stands equal to: the None an optional
-// field defaults to, and the key it is read from where the attribute it
-// declares stopped spelling that key. It is empty for a required field whose
-// attribute spells its key, which is what leaves the annotation standing alone.
+// Argument returns the annotation a builder takes the field's value under: the
+// annotation of the field without the None an optional one admits, since a
+// builder handed the value has it.
+func (f Field) Argument() string {
+	return NewRequiredAnnotation(f.inner.Type).Value()
+}
+
+// Assignment returns what the declaration stands equal to in a pydantic model:
+// the None an optional field defaults to, and the key it is read from where the
+// attribute it declares stopped spelling that key. It is empty for a required
+// field whose attribute spells its key, which is what leaves the annotation
+// standing alone. The other backends tell their library the same two things in
+// words of its own, and build them in their templates from [Field.Optional] and
+// [Field.Key].
 //
 // The key is written per field rather than derived for every field at once. One
 // key of the documented API — from — is a word Python reserves, so a rule
@@ -60,6 +70,18 @@ func (f Field) Assignment() string {
 	return fmt.Sprintf("Field(alias=%q)", alias)
 }
 
+// Optional reports whether the field may be left out, which every backend
+// answers by defaulting it to None.
+func (f Field) Optional() bool {
+	return bool(f.inner.Optionality)
+}
+
+// Key returns the key the field travels under, quoted as a Python string
+// literal.
+func (f Field) Key() string {
+	return fmt.Sprintf("%q", f.inner.Key)
+}
+
 // Aliased reports whether the field is read from a key its attribute stopped
 // spelling, which is what obliges the class declaring it to admit both names.
 func (f Field) Aliased() bool {
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pythonv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/formatting"
+	"github.com/andreychh/tgen/model/typebound"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// builderMethods are the names FormattedText declares whatever the page lists,
+// which no kind of entity may be spelled as.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var builderMethods = map[string]bool{"text": true, "entities": true, "plain": true, "append": true}
+
+// Formatting represents the Python view of formatting.py: the builder marking
+// a text with entities of every kind MessageEntity lists, and the escapers of
+// the two parse modes that spell the same marks in the text itself.
+type Formatting struct {
+	gen    Generation
+	entity formatting.Entity
+}
+
+// NewFormatting creates a Formatting rendered within gen from entity.
+func NewFormatting(gen Generation, entity formatting.Entity) Formatting {
+	return Formatting{gen: gen, entity: entity}
+}
+
+// Generation returns the run the module is rendered within.
+func (f Formatting) Generation() Generation {
+	return f.gen
+}
+
+// Entity returns the class MessageEntity is declared as.
+func (f Formatting) Entity() string {
+	return NewClassName(f.entity.Name()).Value()
+}
+
+// Imports returns the classes the module takes from api.py, sorted: the entity
+// itself and every class a field reserved to a kind is annotated with.
+func (f Formatting) Imports() ([]string, error) {
+	kinds, err := f.entity.Kinds()
+	if err != nil {
+		return nil, fmt.Errorf("reading kinds of entity: %w", err)
+	}
+	seen := map[string]bool{f.Entity(): true}
+	for _, kind := range kinds {
+		for _, field := range kind.Fields {
+			switch atom := field.Type.Atom().(type) {
+			case typebound.Object:
+				seen[NewClassName(atom.Name()).Value()] = true
+			case typebound.Union:
+				seen[NewClassName(atom.Name()).Value()] = true
+			case typebound.Alias:
+				seen[NewClassName(atom.Name()).Value()] = true
+			}
+		}
+	}
+	out := make([]string, 0, len(seen))
+	for name := range seen {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// Kinds returns the kinds of entity the builder marks a text with, in the order
+// the page lists them. It fails when the page cannot be read as a list of kinds,
+// or a kind is spelled as a method the builder declares on its own.
+func (f Formatting) Kinds() ([]EntityKind, error) {
+	kinds, err := f.entity.Kinds()
+	if err != nil {
+		return nil, fmt.Errorf("reading kinds of entity: %w", err)
+	}
+	out := slices.NewMapped(kinds, NewEntityKind)
+	for _, kind := range out {
+		if builderMethods[kind.Method()] {
+			return nil, fmt.Errorf("kind of entity %q is spelled %s, which FormattedText declares already", kind.inner.Value, kind.Method())
+		}
+	}
+	return out, nil
+}
+
+// EntityKind represents one kind of entity as the builder method marking a text
+// with it.
+type EntityKind struct {
+	inner formatting.Kind
+}
+
+// NewEntityKind creates an EntityKind from a kind of entity.
+func NewEntityKind(kind formatting.Kind) EntityKind {
+	return EntityKind{inner: kind}
+}
+
+// Value returns the value the type of the entity takes, quoted as a Python
+// string literal.
+func (k EntityKind) Value() string {
+	return fmt.Sprintf("%q", k.inner.Value)
+}
+
+// Label returns the value as the documentation quotes it, for the docstring.
+func (k EntityKind) Label() string {
+	return "“" + k.inner.Value + "”"
+}
+
+// Method returns the name of the builder method marking a text with the kind:
+// the value itself, already spelled in the lowercase words Python names a
+// method by, followed by an underscore where it is a word Python reserves.
+func (k EntityKind) Method() string {
+	return NewFieldName(model.Key(k.inner.Value)).Value()
+}
+
+// Fields returns the fields of MessageEntity reserved to the kind, which the
+// builder method takes after the text it marks.
+func (k EntityKind) Fields() []Field {
+	return slices.NewMapped(k.inner.Fields, NewField)
+}
@@ -8,16 +8,19 @@ import (
 )
 
 // Generation is one run that writes a Python package: the specification the
-// files are rendered from and the tgen that wrote them. It is the root every
-// template renders against, and reaches the specification through Spec.
+// files are rendered from, the backend the models are declared with, and the
+// tgen that wrote them. It is the root every template renders against, and
+// reaches the specification through Spec.
 type Generation struct {
 	spec     Specification
+	backend  Backend
 	snapshot targets.Snapshot
 }
 
-// NewGeneration creates a Generation rendering spec, stamped with snapshot.
-func NewGeneration(spec Specification, snapshot targets.Snapshot) Generation {
-	return Generation{spec: spec, snapshot: snapshot}
+// NewGeneration creates a Generation rendering spec with the models declared
+// by backend, stamped with snapshot.
+func NewGeneration(spec Specification, backend Backend, snapshot targets.Snapshot) Generation {
+	return Generation{spec: spec, backend: backend, snapshot: snapshot}
 }
 
 // Spec returns the specification the files are rendered from.
@@ -25,6 +28,11 @@ func (g Generation) Spec() Specification {
 	return g.spec
 }
 
+// Backend returns the backend the models are declared with.
+func (g Generation) Backend() Backend {
+	return g.backend
+}
+
 // Snapshot returns the metadata of the run: when it happened and which tgen
 // performed it.
 func (g Generation) Snapshot() targets.Snapshot {
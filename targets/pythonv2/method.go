@@ -10,16 +10,26 @@ import (
 
 // Method represents the Python declaration of a documented method: the model
 // holding its parameters, the payload that model is sent as, and the response
-// its call reads.
+// its call reads. The call either blocks on the connection it is handed or
+// awaits it; the model and the payload are the same either way, since neither
+// touches the network.
 type Method struct {
-	inner ir.Method
+	inner    ir.Method
+	awaiting bool
 }
 
-// NewMethod creates a Method from the record of a method.
+// NewMethod creates a Method from the record of a method, declared for the
+// blocking package.
 func NewMethod(m ir.Method) Method {
 	return Method{inner: m}
 }
 
+// NewAwaitingMethod creates a Method from the record of a method, declared for
+// the asyncio package.
+func NewAwaitingMethod(m ir.Method) Method {
+	return Method{inner: m, awaiting: true}
+}
+
 // Doc returns the docstring of the declaration, closed by a link to the section
 // the method was read from where the page gave it one.
 func (m Method) Doc() string {
@@ -44,6 +54,12 @@ func (m Method) Name() string {
 	return NewClassName(m.inner.Name).Value() + "Method"
 }
 
+// Awaiting reports whether the call is a coroutine awaiting the connection
+// rather than a function blocking on it.
+func (m Method) Awaiting() bool {
+	return m.awaiting
+}
+
 // Wire returns the name the endpoint is called by.
 func (m Method) Wire() string {
 	return string(m.inner.Name)
@@ -55,6 +71,18 @@ func (m Method) Fields() []Field {
 	return slices.NewMapped(m.inner.Params, NewField)
 }
 
+// Paged reports whether the method hands a collection out a page at a time,
+// which gives its model a generator walking the pages beside its call.
+func (m Method) Paged() bool {
+	return m.inner.Paged
+}
+
+// Paging returns how the method pages, which is the zero Paging for a method
+// that does not.
+func (m Method) Paging() Paging {
+	return NewPaging(m.inner.Paging)
+}
+
 // Return returns the response slot of the method.
 func (m Method) Return() Return {
 	return NewResult(m.inner.Result).Return()
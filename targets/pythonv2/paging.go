@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pythonv2
+
+import (
+	"fmt"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Paging represents a paged method as the generator walking its pages: the
+// parameter moving from one page to the next, and the attributes of a page
+// holding the items and their count.
+type Paging struct {
+	inner ir.Paging
+}
+
+// NewPaging creates a Paging from the record of how a method pages.
+func NewPaging(p ir.Paging) Paging {
+	return Paging{inner: p}
+}
+
+// Offset returns the attribute the method model holds the offset in.
+func (p Paging) Offset() string {
+	return NewFieldName(p.inner.Offset).Value()
+}
+
+// Key returns the key the offset is sent under, quoted as a Python string
+// literal.
+func (p Paging) Key() string {
+	return fmt.Sprintf("%q", p.inner.Offset)
+}
+
+// Item returns the annotation of one item: the annotation of the field
+// holding the items, one dimension down.
+func (p Paging) Item() string {
+	items := p.inner.Items.Type
+	return NewRequiredAnnotation(typebound.NewType(items.Atom(), items.Dimensionality()-1)).Value()
+}
+
+// Items returns the attribute of a page holding its items.
+func (p Paging) Items() string {
+	return NewFieldName(p.inner.Items.Key).Value()
+}
+
+// Total returns the attribute of a page counting every item there is, and
+// nothing when a page does not count them.
+func (p Paging) Total() string {
+	if p.inner.Total == "" {
+		return ""
+	}
+	return NewFieldName(p.inner.Total).Value()
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/andreychh/tgen/output"
 )
 
-//go:embed templates/*.tmpl
+//go:embed templates/*.tmpl templates/*/*.tmpl
 var templates embed.FS
 
 // Pass is the Python generation stage: it renders the records of the
@@ -26,15 +26,38 @@ func NewPass(gen Generation) Pass {
 }
 
 // Artifacts returns the files the target writes: the declarations the page
-// dictates, and the package surface lifting them into the one name a bot
-// imports. It fails when a template is malformed.
+// dictates, the package surface lifting them into the one name a bot imports,
+// and the asyncio package beside it, whose methods await the connection rather
+// than block on it. A page declaring MessageEntity adds formatting.py, the
+// builder marking a text with the entities it lists. It fails when a template
+// is malformed or the records cannot be read.
 func (p Pass) Artifacts() (output.Artifacts, error) {
-	tmpl, err := output.NewMold(templates, template.FuncMap{}).Template()
+	tmpl, err := p.template()
+	if err != nil {
+		return nil, err
+	}
+	artifacts := output.Artifacts{
+		"api.py":              output.NewTemplateView(tmpl, "api", p.gen),
+		"__init__.py":         output.NewTemplateView(tmpl, "init", p.gen),
+		"asyncio/api.py":      output.NewTemplateView(tmpl, "asyncio_api", p.gen),
+		"asyncio/__init__.py": output.NewTemplateView(tmpl, "asyncio_init", p.gen),
+	}
+	entity, ok, err := p.gen.Spec().Entity()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		artifacts["formatting.py"] = output.NewTemplateView(tmpl, "formatting", NewFormatting(p.gen, entity))
+	}
+	return artifacts, nil
+}
+
+// template parses the templates shared by every backend layered with the
+// directory of the one the generation declares its models with.
+func (p Pass) template() (*template.Template, error) {
+	tmpl, err := output.NewMold(templates, template.FuncMap{}).Layered(p.gen.Backend().dir()).Template()
 	if err != nil {
 		return nil, fmt.Errorf("preparing template: %w", err)
 	}
-	return output.Artifacts{
-		"api.py":      output.NewTemplateView(tmpl, "api", p.gen),
-		"__init__.py": output.NewTemplateView(tmpl, "init", p.gen),
-	}, nil
+	return tmpl, nil
 }
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pythonv2
+
+// Project is what pyproject.toml is rendered from: the generation the package
+// is, and the distribution it is published as.
+type Project struct {
+	gen  Generation
+	dist Distribution
+}
+
+// NewProject creates a Project publishing gen as dist.
+func NewProject(gen Generation, dist Distribution) Project {
+	return Project{gen: gen, dist: dist}
+}
+
+// Generation returns the run that wrote the package.
+func (p Project) Generation() Generation {
+	return p.gen
+}
+
+// Distribution returns the project the package is published as.
+func (p Project) Distribution() Distribution {
+	return p.dist
+}
+
+// Version returns the version the project is published under, which is the Bot
+// API release the package was read from. A Bot API version is two numbers and
+// so already a version an index accepts, and a bot pinning the wheel pins the
+// API it speaks. The tgen that wrote it is recorded beside it rather than in
+// it: a release of tgen changes no call a bot makes.
+func (p Project) Version() string {
+	return p.gen.Spec().Release().Version()
+}
+
+// Requirements returns what the package needs installed.
+func (p Project) Requirements() []string {
+	return p.gen.Backend().Requirements()
+}
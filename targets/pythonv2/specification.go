@@ -2,14 +2,15 @@
 // SPDX-License-Identifier: MIT
 
 // Package pythonv2 renders the records of the pipeline's exit as Python source:
-// it spells every type the way a pydantic model spells it and picks, for each
-// method, the template that assembles its request and the one that names what
-// it hands back.
+// it spells every type the way a Python annotation spells it and picks, for
+// each method, the template that assembles its request and the one that names
+// what it hands back. The library the models are declared with is a [Backend].
 package pythonv2
 
 import (
 	"fmt"
 
+	"github.com/andreychh/tgen/model/formatting"
 	ir "github.com/andreychh/tgen/model/ir/v2"
 	"github.com/andreychh/tgen/pkg/slices"
 )
@@ -43,3 +44,73 @@ func (s Specification) Definitions() ([]Declaration, error) {
 	}
 	return slices.NewMapped(records, NewDeclaration), nil
 }
+
+// Types returns the declarations of the generated package that are not
+// methods, in the order [Specification.Definitions] gives them. They are what
+// the asyncio package imports rather than declares: a model holds data, and
+// data neither blocks nor awaits.
+func (s Specification) Types() ([]Declaration, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	out := make([]Declaration, 0, len(records))
+	for _, record := range records {
+		if _, ok := record.(ir.Method); !ok {
+			out = append(out, NewDeclaration(record))
+		}
+	}
+	return out, nil
+}
+
+// AwaitingMethods returns the methods the asyncio package declares, ordered by
+// the position the source of each record gave it. It fails when a record
+// cannot be read.
+func (s Specification) AwaitingMethods() ([]Method, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	out := make([]Method, 0)
+	for _, record := range records {
+		if m, ok := record.(ir.Method); ok {
+			out = append(out, NewAwaitingMethod(m))
+		}
+	}
+	return out, nil
+}
+
+// Entity returns the record of MessageEntity, which formatting.py is written
+// from, and false when the specification declares no such object. It fails when
+// a record cannot be read.
+func (s Specification) Entity() (formatting.Entity, bool, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return formatting.Entity{}, false, fmt.Errorf("reading definitions: %w", err)
+	}
+	entity, ok := formatting.Lookup(records)
+	return entity, ok, nil
+}
+
+// Formatted reports whether the package holds formatting.py, for the package
+// surface to lift its names. It fails when a record cannot be read.
+func (s Specification) Formatted() (bool, error) {
+	_, ok, err := s.Entity()
+	return ok, err
+}
+
+// Paged reports whether a method of the package hands a collection out a page
+// at a time, for the modules declaring methods to import the iterator their
+// generators are annotated with. It fails when a record cannot be read.
+func (s Specification) Paged() (bool, error) {
+	records, err := s.inner.Definitions()
+	if err != nil {
+		return false, fmt.Errorf("reading definitions: %w", err)
+	}
+	for _, record := range records {
+		if m, ok := record.(ir.Method); ok && m.Paged {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package pythonv2
+
+import (
+	"path"
+
+	"github.com/andreychh/tgen/output"
+)
+
+// Wheel is the Python packaging stage: it lays the files of a [Pass] out as the
+// source tree of a distribution, ready for a build frontend to turn into a
+// wheel.
+type Wheel struct {
+	pass Pass
+	dist Distribution
+}
+
+// NewWheel creates a Wheel publishing what pass renders as dist.
+func NewWheel(pass Pass, dist Distribution) Wheel {
+	return Wheel{pass: pass, dist: dist}
+}
+
+// Artifacts returns the files of the source tree: the project file at its root,
+// and the package under the directory its module is imported as, with the marker
+// declaring it typed and a stub beside each module declaring what it holds.
+//
+// The modules are annotated throughout, so the stubs are not what makes the
+// package typed; they are what keeps a type checker reading it exact. A stub
+// states the signature of every call and the fields of every model and nothing
+// else, where the module beside it states the decoder, the payloads and the
+// private names a checker would otherwise follow into. Nothing of the package
+// surface needs a stub of its own: it only imports, and what it imports a
+// checker reads from the stubs. It fails when a template is malformed or the
+// records cannot be read.
+func (w Wheel) Artifacts() (output.Artifacts, error) {
+	files, err := w.pass.Artifacts()
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := w.pass.template()
+	if err != nil {
+		return nil, err
+	}
+	module := w.dist.Module()
+	artifacts := output.Artifacts{
+		"pyproject.toml":                        output.NewTemplateView(tmpl, "pyproject", NewProject(w.pass.gen, w.dist)),
+		path.Join(module, "py.typed"):           output.NewTemplateView(tmpl, "py_typed", w.pass.gen),
+		path.Join(module, "api.pyi"):            output.NewTemplateView(tmpl, "api_stub", w.pass.gen),
+		path.Join(module, "asyncio", "api.pyi"): output.NewTemplateView(tmpl, "asyncio_stub", w.pass.gen),
+	}
+	entity, ok, err := w.pass.gen.Spec().Entity()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		artifacts[path.Join(module, "formatting.pyi")] = output.NewTemplateView(
+			tmpl, "formatting_stub", NewFormatting(w.pass.gen, entity),
+		)
+	}
+	for name, view := range files {
+		artifacts[path.Join(module, name)] = view
+	}
+	return artifacts, nil
+}
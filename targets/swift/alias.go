@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Alias represents the Swift declaration of a name tgen gives a type the
+// documentation leaves unnamed: a struct wrapping the type, encoded as the type
+// alone. A typealias would be shorter, but it names no type of its own, and
+// every alias tgen introduces today exists to be told apart from its siblings
+// as the variant of a union — two aliases of a string would be one type.
+type Alias struct {
+	inner ir.Alias
+}
+
+// NewAlias creates an Alias from the record of an alias.
+func NewAlias(a ir.Alias) Alias {
+	return Alias{inner: a}
+}
+
+// Doc returns the documentation comment of the declaration. An alias carries
+// no link back to the documentation: tgen introduces it, so no section
+// documents it.
+func (a Alias) Doc() string {
+	return NewTypeDoc(a.inner.Description, "").Value()
+}
+
+// Ref implements [Declaration].
+func (a Alias) Ref() string {
+	return string(a.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (a Alias) Template() string {
+	return "alias"
+}
+
+// Name returns the Swift name the alias declares.
+func (a Alias) Name() string {
+	return NewName(a.inner.Name).Value()
+}
+
+// Type returns the Swift type expression the struct wraps.
+func (a Alias) Type() string {
+	return NewRequiredType(a.inner.Type).Value()
+}
+
+// Direction returns which way the alias travels, which is what decides the
+// half of Codable it conforms to.
+func (a Alias) Direction() Direction {
+	return NewDirection(a.inner.Direction)
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/andreychh/tgen/targets"
+)
+
+// DefinitionDoc represents the documentation comment of a definition: the prose
+// describing it, followed by a link to the section of the documentation page it
+// stands at, where it stands at one.
+type DefinitionDoc struct {
+	ref        model.Reference
+	passage    prose.Passage
+	introduced bool
+}
+
+// NewDefinitionDoc creates a DefinitionDoc for the definition at ref from the
+// prose describing it and whether tgen introduced it.
+func NewDefinitionDoc(ref model.Reference, passage prose.Passage, introduced bool) DefinitionDoc {
+	return DefinitionDoc{ref: ref, passage: passage, introduced: introduced}
+}
+
+// Value returns the comment. The link is left out when tgen introduced the
+// definition, since the page never named it and so gave no section to address.
+func (d DefinitionDoc) Value() string {
+	if d.introduced {
+		return NewTypeDoc(d.passage, "").Value()
+	}
+	return NewTypeDoc(d.passage, targets.NewTelegramURL(d.ref).Value()).Value()
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model/prose"
+	"github.com/mitchellh/go-wordwrap"
+)
+
+// width is the room a line has, indentation and comment markers counted in. It
+// is the limit swift-format holds a line to unless told otherwise.
+const width = 100
+
+// indentation is what one level of nesting indents a line by.
+const indentation = "    "
+
+// marker is what every line of a documentation comment opens with.
+const marker = "/// "
+
+// Doc represents a prose passage rendered as a documentation comment in the
+// Markdown Swift reads comments as: a paragraph per block, a list as one dashed
+// line per item, blank comment lines between them, and a SeeAlso callout
+// naming wherever the definition is documented, when one is given. The first
+// line carries no indentation, since whatever declares the documented name has
+// already written it.
+type Doc struct {
+	passage prose.Passage
+	see     string
+	indent  int
+}
+
+// NewDoc creates a Doc rendering a passage at an indentation depth, closed by a
+// link to see unless see is empty.
+func NewDoc(passage prose.Passage, see string, indent int) Doc {
+	return Doc{passage: passage, see: see, indent: indent}
+}
+
+// NewTypeDoc creates a Doc for a type declared at file scope.
+func NewTypeDoc(passage prose.Passage, see string) Doc {
+	return NewDoc(passage, see, 0)
+}
+
+// NewPropertyDoc creates a Doc for a property declared in a struct. A field is
+// described by a table cell, which holds inline prose only, so its one phrase
+// becomes the single paragraph of a passage.
+func NewPropertyDoc(phrase prose.Phrase) Doc {
+	return NewDoc(prose.NewPassage(prose.NewParagraph(phrase.Inlines()...)), "", 1)
+}
+
+// Value returns the comment, empty when the passage writes no prose and no
+// link closes it. A block that writes nothing takes no line and earns no blank
+// line beside it, so an empty paragraph leaves no trace. The link is a callout
+// rather than a sentence of the prose, which is where Xcode's quick help looks
+// for it.
+func (d Doc) Value() string {
+	room := width - len(indentation)*d.indent - len(marker)
+	lines := make([]string, 0)
+	for _, block := range d.passage.Blocks() {
+		written := d.block(block, room)
+		if len(written) == 0 {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, written...)
+	}
+	if d.see != "" {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "- SeeAlso: "+d.see)
+	}
+	return d.comment(lines)
+}
+
+// block returns the lines one block occupies within room.
+func (d Doc) block(block prose.Block, room int) []string {
+	switch block := block.(type) {
+	case prose.Paragraph:
+		return wrap(text(block.Inlines()), room, "", "")
+	case prose.List:
+		lines := make([]string, 0, len(block.Items()))
+		for _, item := range block.Items() {
+			lines = append(lines, wrap(text(item.Inlines()), room-2, "- ", "  ")...)
+		}
+		return lines
+	default:
+		return nil
+	}
+}
+
+// comment returns the lines each opened by the comment marker, every line
+// after the first indented to the depth the declaration sits at.
+func (d Doc) comment(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	pad := strings.Repeat(indentation, d.indent)
+	out := make([]string, 0, len(lines))
+	for at, line := range lines {
+		prefix := pad
+		if at == 0 {
+			prefix = ""
+		}
+		out = append(out, strings.TrimRight(prefix+marker+line, " "))
+	}
+	return strings.Join(out, "\n")
+}
+
+// text returns the plain text of inline content. A link contributes its text
+// alone: the anchor it addresses is not yet resolved to the name a symbol link
+// would have to spell.
+func text(inlines []prose.Inline) string {
+	var out strings.Builder
+	for _, inline := range inlines {
+		switch inline := inline.(type) {
+		case prose.Text:
+			out.WriteString(inline.Content())
+		case prose.Link:
+			out.WriteString(inline.Content())
+		case prose.LineBreak:
+			out.WriteString("\n")
+		}
+	}
+	return out.String()
+}
+
+// wrap returns content folded to the given width, opening with first and
+// continuing with rest. A forced line break in the content starts a new line of
+// its own. Content with nothing to read folds to no lines at all.
+func wrap(content string, width int, first, rest string) []string {
+	if strings.TrimSpace(content) == "" {
+		return nil
+	}
+	out := make([]string, 0)
+	for segment := range strings.SplitSeq(content, "\n") {
+		folded := wordwrap.WrapString(segment, uint(width))
+		for line := range strings.SplitSeq(folded, "\n") {
+			out = append(out, rest+line)
+		}
+	}
+	out[0] = first + strings.TrimPrefix(out[0], rest)
+	return out
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+)
+
+// Field represents the Swift declaration of a field an object owns or a
+// parameter a method takes: a stored property of a struct, its coding key, and
+// the parameter of the initializer setting it. It knows the struct declaring
+// it, since whether it has to be boxed depends on what it leads back to.
+type Field struct {
+	inner   ir.Field
+	owner   model.Name
+	lineage Lineage
+}
+
+// NewField creates a Field from the record of a field declared by the struct
+// named owner, knowing the lineage of the sequence the struct stands in.
+func NewField(f ir.Field, owner model.Name, lineage Lineage) Field {
+	return Field{inner: f, owner: owner, lineage: lineage}
+}
+
+// Doc returns the documentation comment of the property.
+func (f Field) Doc() string {
+	return NewPropertyDoc(f.inner.Description).Value()
+}
+
+// Name returns the Swift name the property declares.
+func (f Field) Name() string {
+	return NewPropertyName(f.inner.Key).Value()
+}
+
+// Key returns the key the property is encoded under.
+func (f Field) Key() string {
+	return string(f.inner.Key)
+}
+
+// Renamed reports whether the coding key of the property has to name the key,
+// which it does wherever the two are spelled apart.
+func (f Field) Renamed() bool {
+	return NewPropertyName(f.inner.Key).Renamed()
+}
+
+// Type returns the Swift type expression of the property.
+func (f Field) Type() string {
+	return NewType(f.inner.Type, f.inner.Optionality).Value()
+}
+
+// Optional reports whether the caller may leave the property unset, which is
+// what lets the initializer default it to nil.
+func (f Field) Optional() bool {
+	return bool(f.inner.Optionality)
+}
+
+// Indirect reports whether the property leads back to the struct declaring it,
+// which obliges it to be boxed by the property wrapper the client declares.
+func (f Field) Indirect() bool {
+	return f.lineage.Recursive(f.owner, f.inner.Type)
+}
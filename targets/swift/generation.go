@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"github.com/andreychh/tgen/targets"
+)
+
+// Generation is one run that writes the sources of a Swift module: the
+// specification the files are rendered from and the tgen that wrote them. A
+// Swift file declares no module of its own — the package manifest names it — so
+// unlike the Kotlin and C# runs this one decides nothing about where the
+// declarations live. It is the root every template renders against, and
+// reaches the specification through Spec.
+type Generation struct {
+	spec     Specification
+	snapshot targets.Snapshot
+}
+
+// NewGeneration creates a Generation rendering spec, stamped with snapshot.
+func NewGeneration(spec Specification, snapshot targets.Snapshot) Generation {
+	return Generation{spec: spec, snapshot: snapshot}
+}
+
+// Spec returns the specification the files are rendered from.
+func (g Generation) Spec() Specification {
+	return g.spec
+}
+
+// Snapshot returns the metadata of the run: when it happened and which tgen
+// performed it.
+func (g Generation) Snapshot() targets.Snapshot {
+	return g.snapshot
+}
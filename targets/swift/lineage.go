@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/typebound"
+	"github.com/andreychh/tgen/targets"
+)
+
+// Lineage represents what the declarations of the module know about one
+// another and Swift needs said at the declaration itself: the value each
+// object a union tells apart is told apart by, and which struct holds which
+// inline.
+//
+// The second is what a struct cannot be declared without. A struct is stored
+// inline in whatever holds it, so a struct holding itself — a message replying
+// to a message — would be infinitely large, and the compiler refuses it. The
+// records say only what each field holds; whether that leads back to the
+// struct holding it is a question of the whole sequence.
+type Lineage struct {
+	inner targets.Lineage
+	holds map[model.Name][]model.Name
+}
+
+// NewLineage creates a Lineage from every record of the pipeline's exit.
+func NewLineage(records []ir.Definition) Lineage {
+	l := Lineage{
+		inner: targets.NewLineage(records),
+		holds: make(map[model.Name][]model.Name),
+	}
+	for _, record := range records {
+		switch record := record.(type) {
+		case ir.Object:
+			for _, field := range record.Fields {
+				l.hold(record.Name, field.Type)
+			}
+		case ir.DiscriminatedObject:
+			for _, field := range record.Fields {
+				l.hold(record.Name, field.Type)
+			}
+		case ir.Alias:
+			l.hold(record.Name, record.Type)
+		}
+	}
+	return l
+}
+
+// hold records that the declaration named owner stores typ inline, when it
+// does. An array stores its elements out of line and an enum boxes what its
+// cases hold, so only a struct held as itself counts.
+func (l Lineage) hold(owner model.Name, typ typebound.Type) {
+	if name, ok := inline(typ); ok {
+		l.holds[owner] = append(l.holds[owner], name)
+	}
+}
+
+// Recursive reports whether a field of the struct named owner, holding typ,
+// leads back to that struct through whatever is stored inline. Such a field
+// has to be boxed; every other field is stored as it is.
+func (l Lineage) Recursive(owner model.Name, typ typebound.Type) bool {
+	name, ok := inline(typ)
+	if !ok {
+		return false
+	}
+	seen := map[model.Name]bool{}
+	pending := []model.Name{name}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if current == owner {
+			return true
+		}
+		if seen[current] {
+			continue
+		}
+		seen[current] = true
+		pending = append(pending, l.holds[current]...)
+	}
+	return false
+}
+
+// Discriminator returns the field the type is told apart by, and false when it
+// is not an object a union tells apart.
+func (l Lineage) Discriminator(name model.Name) (Discriminator, bool) {
+	d, ok := l.inner.Discriminator(name)
+	if !ok {
+		return Discriminator{}, false
+	}
+	return NewDiscriminator(d.Key, d.Value), true
+}
+
+// inline returns the name of the struct typ is stored as, and false when typ is
+// stored out of line or is no struct at all.
+func inline(typ typebound.Type) (model.Name, bool) {
+	if typ.Dimensionality() > 0 {
+		return "", false
+	}
+	switch atom := typ.Atom().(type) {
+	case typebound.Object:
+		return atom.Name(), true
+	case typebound.Alias:
+		return atom.Name(), true
+	default:
+		return "", false
+	}
+}
+
+// Discriminator represents the key an object is told apart under and the fixed
+// value it holds there.
+type Discriminator struct {
+	key   model.Key
+	value model.DiscriminatorValue
+}
+
+// NewDiscriminator creates a Discriminator from a key and the value held under
+// it.
+func NewDiscriminator(key model.Key, value model.DiscriminatorValue) Discriminator {
+	return Discriminator{key: key, value: value}
+}
+
+// Key returns the JSON key the value is written under.
+func (d Discriminator) Key() string {
+	return string(d.key)
+}
+
+// Value returns the value the object is told apart by.
+func (d Discriminator) Value() string {
+	return string(d.value)
+}
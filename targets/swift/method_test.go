@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets/swift"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+// method returns the declaration of the method held under ref.
+func method(t *testing.T, ref model.Reference) swift.Method {
+	t.Helper()
+	return swift.NewMethod(targettest.Record[ir.Method](t, ref), swift.NewLineage(targettest.Records(t)))
+}
+
+func TestMethod_Name(t *testing.T) {
+	assert.Equal(t, "GetMeMethod", method(t, "getme").Name(),
+		"Method.Name must keep a request apart from the object it answers with")
+	assert.Equal(t, "getMe", method(t, "getme").Wire(), "Method.Wire must call the endpoint by its documented name")
+}
+
+func TestMethod_Payload(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  model.Reference
+		want string
+	}{
+		{name: "sends no body for a method taking nothing", ref: "getme", want: "payload_empty"},
+		{name: "encodes a method taking parameters as JSON", ref: "sendmessage", want: "payload_json"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, method(t, tc.ref).Payload().Template(),
+				"Method.Payload must send the parameters the way the method takes them")
+		})
+	}
+}
+
+func TestMethod_Return(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  model.Reference
+		want string
+	}{
+		{name: "decodes the value a method answers with", ref: "getme", want: "return_value"},
+		{name: "discards the answer of a method answering with a confirmation", ref: "logout", want: "return_command"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, method(t, tc.ref).Return().Template(),
+				"Method.Return must decode what the method answers with")
+		})
+	}
+}
+
+func TestMethod_Fields(t *testing.T) {
+	assert.Equal(
+		t,
+		[]property{
+			{name: "chatID", key: "chat_id", renamed: true, typ: "Int64", indirect: false},
+			{name: "text", key: "text", renamed: false, typ: "String", indirect: false},
+			{name: "replyMarkup", key: "reply_markup", renamed: true, typ: "ReplyMarkup?", indirect: false},
+		},
+		properties(method(t, "sendmessage").Fields()),
+		"Method.Fields must declare the parameters the method takes",
+	)
+}
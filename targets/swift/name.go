@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"strings"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/iancoleman/strcase"
+)
+
+// acronyms holds the initialisms a capitalization leaves half-spelled, each
+// mapped to the spelling the other targets give it. The Swift guidelines agree
+// with them for once: an initialism is written in one case throughout, so it is
+// capitals wherever a word would be capitalized.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var acronyms = map[string]string{
+	"Id":  "ID",
+	"Url": "URL",
+	"Api": "API",
+	"Ip":  "IP",
+}
+
+// keywords holds the words Swift reserves in declarations, statements and
+// expressions, which is what a property or a case may not be spelled as without
+// backticks. A word the grammar reads as a keyword only in a particular
+// position — open, type, get, set — remains a legal name everywhere else.
+//
+//nolint:gochecknoglobals // immutable lookup table, not mutable global state
+var keywords = map[string]bool{
+	"associatedtype": true, "class": true, "deinit": true, "enum": true,
+	"extension": true, "fileprivate": true, "func": true, "import": true,
+	"init": true, "inout": true, "internal": true, "let": true,
+	"operator": true, "private": true, "precedencegroup": true,
+	"protocol": true, "public": true, "rethrows": true, "static": true,
+	"struct": true, "subscript": true, "typealias": true, "var": true,
+	"break": true, "case": true, "catch": true, "continue": true,
+	"default": true, "defer": true, "do": true, "else": true,
+	"fallthrough": true, "for": true, "guard": true, "if": true, "in": true,
+	"repeat": true, "return": true, "throw": true, "switch": true,
+	"where": true, "while": true, "as": true, "false": true, "is": true,
+	"nil": true, "self": true, "super": true, "throws": true, "true": true,
+	"try": true,
+}
+
+// Name represents a documentation name rendered as the name of a Swift type.
+type Name struct {
+	inner model.Name
+}
+
+// NewName creates a Name from a documentation name.
+func NewName(n model.Name) Name {
+	return Name{inner: n}
+}
+
+// Value returns the name in the capitalized words a type is declared by, with
+// the acronyms spelled in capitals.
+func (n Name) Value() string {
+	camel := strcase.ToCamel(string(n.inner))
+	for wrong, right := range acronyms {
+		camel = strings.ReplaceAll(camel, wrong, right)
+	}
+	return camel
+}
+
+// PropertyName represents a field key rendered as the name of a Swift
+// property. It stands apart from [Name] because a property opens in lowercase,
+// and an initialism opening it is lowercase whole — id, url — while one further
+// in is capitals whole, chatID rather than chatId. The words are read off the
+// underscores of the key rather than off a capitalization, which would find an
+// initialism inside any word that happens to open with one.
+type PropertyName struct {
+	key model.Key
+}
+
+// NewPropertyName creates a PropertyName from a field key.
+func NewPropertyName(k model.Key) PropertyName {
+	return PropertyName{key: k}
+}
+
+// Value returns the key in lower camel case, enclosed in backticks where it is
+// a word Swift reserves.
+func (n PropertyName) Value() string {
+	return escaped(n.camel())
+}
+
+// Renamed reports whether the property is spelled apart from the key it is
+// encoded under, which is what obliges its coding key to name the key.
+func (n PropertyName) Renamed() bool {
+	return n.camel() != string(n.key)
+}
+
+// camel returns the key in lower camel case, unescaped.
+func (n PropertyName) camel() string {
+	var out strings.Builder
+	for at, word := range strings.Split(string(n.key), "_") {
+		if word == "" {
+			continue
+		}
+		if at == 0 {
+			out.WriteString(strings.ToLower(word))
+			continue
+		}
+		capitalized := strings.ToUpper(word[:1]) + word[1:]
+		if acronym, ok := acronyms[capitalized]; ok {
+			capitalized = acronym
+		}
+		out.WriteString(capitalized)
+	}
+	return out.String()
+}
+
+// CaseName represents the name of a type rendered as the case of an enum
+// holding it. A case is spelled the way a property is, so the type's name is
+// read back into the words a key would have been written in and spelled by the
+// same rules: FileID holds as fileID, ID as id.
+type CaseName struct {
+	inner model.Name
+}
+
+// NewCaseName creates a CaseName from the documentation name of a variant.
+func NewCaseName(n model.Name) CaseName {
+	return CaseName{inner: n}
+}
+
+// Value returns the name of the case, enclosed in backticks where it is a word
+// Swift reserves.
+func (n CaseName) Value() string {
+	return NewPropertyName(model.Key(strcase.ToSnake(string(n.inner)))).Value()
+}
+
+// escaped returns name enclosed in backticks where it is a word Swift reserves,
+// and as it is otherwise.
+func escaped(name string) string {
+	if keywords[name] {
+		return "`" + name + "`"
+	}
+	return name
+}
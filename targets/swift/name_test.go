@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/targets/swift"
+)
+
+func TestName_Value(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Name
+		want  string
+	}{
+		{name: "capitalizes a method name", input: "getMe", want: "GetMe"},
+		{name: "keeps an object name as documented", input: "ReplyKeyboardRemove", want: "ReplyKeyboardRemove"},
+		{name: "spells an initialism in capitals", input: "WebhookInfoUrl", want: "WebhookInfoURL"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, swift.NewName(tc.input).Value(),
+				"Name.Value must spell a type the way the other targets spell it")
+		})
+	}
+}
+
+func TestPropertyName_Value(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Key
+		want  string
+	}{
+		{name: "keeps a single word key", input: "text", want: "text"},
+		{name: "writes a snake case key in lower camel case", input: "first_name", want: "firstName"},
+		{name: "spells an initialism further in in capitals", input: "chat_id", want: "chatID"},
+		{name: "spells an initialism opening the name in lowercase", input: "id", want: "id"},
+		{name: "keeps a word opening with an initialism a word", input: "identity", want: "identity"},
+		{name: "encloses a reserved word in backticks", input: "default", want: "`default`"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, swift.NewPropertyName(tc.input).Value(),
+				"PropertyName.Value must spell a property the way Swift reads it")
+		})
+	}
+}
+
+func TestCaseName_Value(t *testing.T) {
+	cases := []struct {
+		name  string
+		input model.Name
+		want  string
+	}{
+		{name: "opens a variant in lowercase", input: "ForceReply", want: "forceReply"},
+		{name: "keeps an initialism further in in capitals", input: "FileID", want: "fileID"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, swift.NewCaseName(tc.input).Value(),
+				"CaseName.Value must spell a case the way a property is spelled")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Object represents the Swift declaration of a documented object: a struct of
+// stored properties, with the coding keys spelling each one on the wire.
+type Object struct {
+	inner   ir.Object
+	lineage Lineage
+}
+
+// NewObject creates an Object from the record of an object and the lineage of
+// the sequence it stands in.
+func NewObject(o ir.Object, lineage Lineage) Object {
+	return Object{inner: o, lineage: lineage}
+}
+
+// Doc returns the documentation comment of the declaration, closing with a
+// link back to the section the object was read from.
+func (o Object) Doc() string {
+	return NewDefinitionDoc(o.inner.Ref, o.inner.Description, o.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (o Object) Ref() string {
+	return string(o.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (o Object) Template() string {
+	return "object"
+}
+
+// Name returns the Swift name the object declares.
+func (o Object) Name() string {
+	return NewName(o.inner.Name).Value()
+}
+
+// Fields returns the fields the object declares, in the order the documentation
+// listed them.
+func (o Object) Fields() []Field {
+	return slices.NewMapped(o.inner.Fields, func(f ir.Field) Field {
+		return NewField(f, o.inner.Name, o.lineage)
+	})
+}
+
+// Files returns the fields the object has to hand a file over for, empty when
+// it holds none and so encodes the way its synthesized conformance says.
+func (o Object) Files() []Attached {
+	return slices.NewMapped(o.inner.Files, NewAttached)
+}
+
+// Rewrites reports whether the object has to rewrite itself into JSON because a
+// union reaching a file admits it. An object holding a file of its own rewrites
+// itself anyway; this is what obliges the ones holding none.
+func (o Object) Rewrites() bool {
+	return o.inner.Rewrites
+}
+
+// Direction returns which way the object travels, which is what decides the
+// half of Codable it conforms to.
+func (o Object) Direction() Direction {
+	return NewDirection(o.inner.Direction)
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+	"github.com/andreychh/tgen/targets/swift"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+// property is what a struct says of one of its properties.
+type property struct {
+	name     string
+	key      string
+	renamed  bool
+	typ      string
+	indirect bool
+}
+
+// properties returns what the struct says of each of fields.
+func properties(fields []swift.Field) []property {
+	return slices.NewMapped(fields, func(f swift.Field) property {
+		return property{name: f.Name(), key: f.Key(), renamed: f.Renamed(), typ: f.Type(), indirect: f.Indirect()}
+	})
+}
+
+func TestObject_Fields(t *testing.T) {
+	object := swift.NewObject(
+		targettest.Record[ir.Object](t, "user"),
+		swift.NewLineage(targettest.Records(t)),
+	)
+	assert.Equal(
+		t,
+		[]property{
+			{name: "id", key: "id", renamed: false, typ: "Int64", indirect: false},
+			{name: "firstName", key: "first_name", renamed: true, typ: "String", indirect: false},
+			{name: "username", key: "username", renamed: false, typ: "String?", indirect: false},
+		},
+		properties(object.Fields()),
+		"Object.Fields must map a camel case property back to its key and leave an optional one nil",
+	)
+}
+
+func TestObject_Direction(t *testing.T) {
+	object := swift.NewObject(
+		targettest.Record[ir.Object](t, "user"),
+		swift.NewLineage(targettest.Records(t)),
+	)
+	assert.True(t, object.Direction().Received(), "an object a response carries must decode itself")
+	assert.False(t, object.Direction().Sent(), "an object no request carries must not encode itself")
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"embed"
+	"fmt"
+	"text/template"
+
+	"github.com/andreychh/tgen/output"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// Pass is the Swift generation stage: it renders the records of the pipeline's
+// exit into the source files of a Swift module.
+type Pass struct {
+	gen Generation
+}
+
+// NewPass creates a Pass rendering the given generation.
+func NewPass(gen Generation) Pass {
+	return Pass{gen: gen}
+}
+
+// Artifacts returns the files the target writes, each bound to the template
+// rendering it. Swift ties no file to the types it declares, so the two are
+// named after what they hold, the way the Kotlin and C# targets name theirs. It
+// fails when a template is malformed.
+func (p Pass) Artifacts() (output.Artifacts, error) {
+	tmpl, err := output.NewMold(templates, template.FuncMap{}).Template()
+	if err != nil {
+		return nil, fmt.Errorf("preparing template: %w", err)
+	}
+	return output.Artifacts{
+		"Api.swift":    output.NewTemplateView(tmpl, "api", p.gen),
+		"Client.swift": output.NewTemplateView(tmpl, "client", p.gen),
+	}, nil
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/targets/swift"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+func TestPass_Artifacts(t *testing.T) {
+	artifacts, err := swift.NewPass(
+		swift.NewGeneration(
+			swift.NewSpecification(ir.NewSpecification(targettest.Specification())),
+			targettest.Snapshot(),
+		),
+	).Artifacts()
+	require.NoError(t, err, "Pass must write the test bot")
+	files := targettest.Render(t, artifacts)
+	assert.ElementsMatch(
+		t,
+		[]string{"Api.swift", "Client.swift"},
+		slices.Collect(maps.Keys(files)),
+		"Pass must write the declarations and the transport as two files",
+	)
+	cases := []struct {
+		name string
+		file string
+		want string
+	}{
+		{name: "stamps the release the specification was read from", file: "Api.swift", want: "//     Bot API 10.2\n"},
+		{name: "declares every record", file: "Api.swift", want: "public struct LogOutMethod"},
+		{
+			name: "only encodes a union only a request carries",
+			file: "Api.swift",
+			want: "public indirect enum ReplyMarkup: Encodable, Sendable {\n",
+		},
+		{
+			name: "writes the transport every method is called through",
+			file: "Client.swift",
+			want: "public struct HTTPConnection: Connection {",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, files[tc.file], tc.want, "Pass must write %s as Swift reads it", tc.file)
+		})
+	}
+}
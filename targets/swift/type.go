@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"fmt"
+
+	"github.com/andreychh/tgen/model"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/typebound"
+)
+
+// Type represents a Swift type expression rendered from a resolved type and the
+// optionality of whatever carries it.
+type Type struct {
+	typ typebound.Type
+	opt model.Optionality
+}
+
+// NewType creates a Type from a resolved type and its optionality.
+func NewType(typ typebound.Type, opt model.Optionality) Type {
+	return Type{typ: typ, opt: opt}
+}
+
+// NewRequiredType creates a Type from a resolved type no optionality applies
+// to, such as the return of a method.
+func NewRequiredType(typ typebound.Type) Type {
+	return NewType(typ, false)
+}
+
+// Value returns the Swift type expression: the name of the atom, enclosed in
+// one array per dimension, and optional when the caller may leave it unset. An
+// unset optional is what the synthesized encoder leaves out of a request and
+// what the synthesized decoder reads a missing key as, so it takes nothing but
+// the annotation.
+func (t Type) Value() string {
+	value := t.Name()
+	for range t.typ.Dimensionality() {
+		value = "[" + value + "]"
+	}
+	if t.opt {
+		return value + "?"
+	}
+	return value
+}
+
+// Optional reports whether the caller may leave the type unset.
+func (t Type) Optional() bool {
+	return bool(t.opt)
+}
+
+// Name returns the Swift name of the atom the type holds, without the arrays
+// enclosing it.
+func (t Type) Name() string {
+	switch atom := t.typ.Atom().(type) {
+	case typebound.Primitive:
+		return builtin(atom.Kind())
+	case typebound.Object:
+		return NewName(atom.Name()).Value()
+	case typebound.Union:
+		return NewName(atom.Name()).Value()
+	case typebound.Alias:
+		return NewName(atom.Name()).Value()
+	default:
+		panic(fmt.Sprintf("swift: unknown atom %T", atom))
+	}
+}
+
+// builtin returns the Swift type a built-in of the documentation renders as. An
+// integer is an Int64 rather than an Int, since the identifiers the Bot API
+// hands out outgrow 32 bits and a watch still runs a 32-bit Int.
+func builtin(kind primitive.Kind) string {
+	switch kind {
+	case primitive.Integer:
+		return "Int64"
+	case primitive.Float:
+		return "Double"
+	case primitive.String:
+		return "String"
+	case primitive.Boolean, primitive.True:
+		return "Bool"
+	default:
+		panic(fmt.Sprintf("swift: unknown primitive %q", kind))
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift
+
+import (
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+)
+
+// Union represents the Swift declaration of a union nothing tells the variants
+// of apart: an enum holding each variant as the associated value of a case.
+// Writing it is mechanical, since the case held says which variant to write.
+// Reading it is not: which variant a payload holds is not written anywhere the
+// specification can be read from, so a union any response carries is decoded
+// by a function written by hand, in the block claiming this reference, and one
+// none carries is never declared decodable at all.
+type Union struct {
+	inner   ir.Union
+	lineage Lineage
+}
+
+// NewUnion creates a Union from the record of a union and the lineage of the
+// sequence it stands in.
+func NewUnion(u ir.Union, lineage Lineage) Union {
+	return Union{inner: u, lineage: lineage}
+}
+
+// Doc returns the documentation comment of the declaration, closing with a
+// link back to the section the union was read from.
+func (u Union) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u Union) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u Union) Template() string {
+	return "union"
+}
+
+// Name returns the Swift name the union declares.
+func (u Union) Name() string {
+	return NewName(u.inner.Name).Value()
+}
+
+// Carrier reports whether a file is reached through the union, which obliges
+// every variant to answer for the rewrite the union dispatches.
+func (u Union) Carrier() bool {
+	return u.inner.Carrier
+}
+
+// Variants returns the types the union stands for, in the order the
+// documentation listed them. A variant some other union tells apart keeps the
+// value it is told apart by, since writing it as itself would lose the value.
+func (u Union) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.Variant) Variant {
+		d, ok := u.lineage.Discriminator(v.Name)
+		if !ok {
+			return NewVariant(v.Name, nil)
+		}
+		return NewVariant(v.Name, &d)
+	})
+}
+
+// Direction returns which way the union travels, which is what decides the
+// half of Codable it conforms to.
+func (u Union) Direction() Direction {
+	return NewDirection(u.inner.Direction)
+}
+
+// Variant represents one variant of a union: the type it names, the case of
+// the enum holding it, and the value it is told apart by where something tells
+// it apart.
+type Variant struct {
+	name          model.Name
+	discriminator *Discriminator
+}
+
+// NewVariant creates a Variant naming a type, told apart by discriminator
+// unless that is nil.
+func NewVariant(name model.Name, discriminator *Discriminator) Variant {
+	return Variant{name: name, discriminator: discriminator}
+}
+
+// Name returns the Swift name of the type the variant names.
+func (v Variant) Name() string {
+	return NewName(v.name).Value()
+}
+
+// Case returns the name of the case holding the variant.
+func (v Variant) Case() string {
+	return NewCaseName(v.name).Value()
+}
+
+// Discriminator returns the field the type is told apart by, nil when nothing
+// tells it apart.
+func (v Variant) Discriminator() *Discriminator {
+	return v.discriminator
+}
+
+// DiscriminatedUnion represents the Swift declaration of a union one key tells
+// every variant of apart: an enum reading the key before anything else and
+// decoding the variant it names, and writing that key beside whichever variant
+// it holds.
+type DiscriminatedUnion struct {
+	inner ir.DiscriminatedUnion
+}
+
+// NewDiscriminatedUnion creates a DiscriminatedUnion from the record of a
+// discriminated union.
+func NewDiscriminatedUnion(u ir.DiscriminatedUnion) DiscriminatedUnion {
+	return DiscriminatedUnion{inner: u}
+}
+
+// Doc returns the documentation comment of the declaration, closing with a
+// link back to the section the union was read from.
+func (u DiscriminatedUnion) Doc() string {
+	return NewDefinitionDoc(u.inner.Ref, u.inner.Description, u.inner.Introduced).Value()
+}
+
+// Ref implements [Declaration].
+func (u DiscriminatedUnion) Ref() string {
+	return string(u.inner.Ref)
+}
+
+// Template implements [Declaration].
+func (u DiscriminatedUnion) Template() string {
+	return "discriminated_union"
+}
+
+// Name returns the Swift name the union declares.
+func (u DiscriminatedUnion) Name() string {
+	return NewName(u.inner.Name).Value()
+}
+
+// Key returns the JSON key the variants are told apart under.
+func (u DiscriminatedUnion) Key() string {
+	return string(u.inner.Key)
+}
+
+// Carrier reports whether a file is reached through the union, which obliges
+// every variant to answer for the rewrite the union dispatches.
+func (u DiscriminatedUnion) Carrier() bool {
+	return u.inner.Carrier
+}
+
+// Variants returns the types the union stands for, in the order the
+// documentation listed them, each told apart by the value the discriminators
+// the pipeline decoded hold for it under the key of the union.
+func (u DiscriminatedUnion) Variants() []Variant {
+	return slices.NewMapped(u.inner.Variants, func(v ir.DiscriminatedVariant) Variant {
+		d := NewDiscriminator(u.inner.Key, v.Value)
+		return NewVariant(v.Name, &d)
+	})
+}
+
+// Direction returns which way the union travels, which is what decides the
+// half of Codable it conforms to.
+func (u DiscriminatedUnion) Direction() Direction {
+	return NewDirection(u.inner.Direction)
+}
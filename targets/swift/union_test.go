@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+package swift_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/pkg/slices"
+	"github.com/andreychh/tgen/targets/swift"
+	"github.com/andreychh/tgen/targets/targettest"
+)
+
+func TestUnion_Variants(t *testing.T) {
+	union := swift.NewUnion(
+		targettest.Record[ir.Union](t, "replymarkup"),
+		swift.NewLineage(targettest.Records(t)),
+	)
+	variants := union.Variants()
+	assert.Equal(
+		t,
+		[]string{"forceReply", "replyKeyboardRemove"},
+		slices.NewMapped(variants, swift.Variant.Case),
+		"Union.Variants must hold each type the union admits in a case of its own, in the order they were listed",
+	)
+	assert.Equal(
+		t,
+		[]string{"ForceReply", "ReplyKeyboardRemove"},
+		slices.NewMapped(variants, swift.Variant.Name),
+		"Union.Variants must name the types the union admits",
+	)
+}
+
+func TestUnion_Direction(t *testing.T) {
+	union := swift.NewUnion(
+		targettest.Record[ir.Union](t, "replymarkup"),
+		swift.NewLineage(targettest.Records(t)),
+	)
+	assert.True(t, union.Direction().Sent(), "a union a request carries must encode itself")
+	assert.False(t, union.Direction().Received(), "a union no response carries must not decode itself")
+}
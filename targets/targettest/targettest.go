@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2026 Andrey Chernykh
+// SPDX-License-Identifier: MIT
+
+// Package targettest holds what the tests of the targets share: the records of
+// a bot small enough to read whole, the records the pipeline's exit makes of
+// them, and the text of every file a pass renders. Each target reads the same
+// bot its own way, so its tests differ only in what they expect.
+package targettest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreychh/tgen/meta"
+	"github.com/andreychh/tgen/model"
+	ir "github.com/andreychh/tgen/model/ir/v2"
+	"github.com/andreychh/tgen/model/pipeline"
+	"github.com/andreychh/tgen/model/pipeline/attached"
+	"github.com/andreychh/tgen/model/pipeline/classified"
+	"github.com/andreychh/tgen/model/pipeline/corrected"
+	"github.com/andreychh/tgen/model/pipeline/flattened"
+	"github.com/andreychh/tgen/model/pipeline/parsed"
+	"github.com/andreychh/tgen/model/pipeline/separated"
+	"github.com/andreychh/tgen/model/primitive"
+	"github.com/andreychh/tgen/model/result"
+	"github.com/andreychh/tgen/model/typeform"
+	"github.com/andreychh/tgen/output"
+	"github.com/andreychh/tgen/targets"
+)
+
+// Specification builds the records of a bot that reads a user, sends a message
+// with an optional reply markup and logs out, as read from Bot API 10.2. It
+// holds one record of every shape a target declares — an object only a
+// response carries, a union only a request carries with the objects it admits,
+// a method taking nothing, one taking parameters and one answering with a
+// confirmation — and a variant whose only field shares the name of its record,
+// which C# does not let a property do.
+func Specification() separated.Specification {
+	definitions := pipeline.NewMapTable[model.Reference, corrected.Definition]()
+	for at, definition := range []corrected.Definition{
+		{Ref: "user", Name: "User", Kind: model.DefinitionKindObject},
+		{Ref: "replymarkup", Name: "ReplyMarkup", Kind: model.DefinitionKindUnion},
+		{Ref: "forcereply", Name: "ForceReply", Kind: model.DefinitionKindObject},
+		{Ref: "replykeyboardremove", Name: "ReplyKeyboardRemove", Kind: model.DefinitionKindObject},
+		{Ref: "getme", Name: "getMe", Kind: model.DefinitionKindMethod},
+		{Ref: "sendmessage", Name: "sendMessage", Kind: model.DefinitionKindMethod},
+		{Ref: "logout", Name: "logOut", Kind: model.DefinitionKindMethod},
+	} {
+		definition.Position = model.Position(at)
+		definitions.Insert(definition.Ref, definition)
+	}
+	fields := pipeline.NewMapTable[model.FieldKey, flattened.Field]()
+	for at, field := range []struct {
+		owner    model.Reference
+		key      model.Key
+		atom     typeform.Atom
+		optional model.Optionality
+	}{
+		{owner: "user", key: "id", atom: typeform.NewPrimitive(primitive.Integer)},
+		{owner: "user", key: "first_name", atom: typeform.NewPrimitive(primitive.String)},
+		{owner: "user", key: "username", atom: typeform.NewPrimitive(primitive.String), optional: true},
+		{owner: "forcereply", key: "force_reply", atom: typeform.NewPrimitive(primitive.True)},
+		{owner: "replykeyboardremove", key: "remove_keyboard", atom: typeform.NewPrimitive(primitive.True)},
+		{owner: "sendmessage", key: "chat_id", atom: typeform.NewPrimitive(primitive.Integer)},
+		{owner: "sendmessage", key: "text", atom: typeform.NewPrimitive(primitive.String)},
+		{owner: "sendmessage", key: "reply_markup", atom: typeform.NewNamed("replymarkup"), optional: true},
+	} {
+		fields.Insert(model.FieldKey{Owner: field.owner, Key: field.key}, flattened.Field{
+			Key:         field.key,
+			Position:    model.Position(at),
+			Type:        typeform.NewType(field.atom, 0),
+			Optionality: field.optional,
+		})
+	}
+	variants := pipeline.NewMapTable[model.VariantKey, parsed.Variant]()
+	for at, ref := range []model.Reference{"forcereply", "replykeyboardremove"} {
+		key := model.VariantKey{Owner: "replymarkup", Ref: ref}
+		variants.Insert(key, parsed.Variant{Ref: ref, Position: model.Position(at)})
+	}
+	directions := pipeline.NewMapTable[model.Reference, model.Direction]()
+	directions.Insert("user", model.DirectionInbound)
+	for _, ref := range []model.Reference{"replymarkup", "forcereply", "replykeyboardremove"} {
+		directions.Insert(ref, model.DirectionOutbound)
+	}
+	methods := pipeline.NewMapTable[model.Reference, separated.Method]()
+	user := result.NewValue(typeform.NewType(typeform.NewNamed("user"), 0))
+	methods.Insert("getme", separated.Method{Ref: "getme", Result: user})
+	methods.Insert("sendmessage", separated.Method{Ref: "sendmessage", Result: user})
+	methods.Insert("logout", separated.Method{Ref: "logout", Result: result.NewConfirmation()})
+	return separated.Specification{
+		Definitions:    definitions,
+		Methods:        methods,
+		Fields:         fields,
+		Files:          pipeline.NewMapTable[model.Reference, attached.File](),
+		Directions:     directions,
+		Discriminators: pipeline.NewMapTable[model.Reference, classified.Discriminator](),
+		Variants:       variants,
+		Aliases:        pipeline.NewMapTable[model.Reference, flattened.Alias](),
+		Release:        parsed.Release{Version: "10.2"},
+	}
+}
+
+// Records returns the records the pipeline's exit makes of [Specification], in
+// the order a target declares them.
+func Records(t *testing.T) []ir.Definition {
+	t.Helper()
+	records, err := ir.NewSpecification(Specification()).Definitions()
+	require.NoError(t, err, "the records of the test bot must be readable")
+	return records
+}
+
+// Record returns the record of [Records] held under ref, as the shape D it is
+// expected to have.
+func Record[D ir.Definition](t *testing.T, ref model.Reference) D {
+	t.Helper()
+	for _, record := range Records(t) {
+		if reference(record) != ref {
+			continue
+		}
+		shaped, ok := record.(D)
+		require.True(t, ok, "the record of %s must be a %T, not a %T", ref, shaped, record)
+		return shaped
+	}
+	require.FailNow(t, "no record of "+string(ref))
+	panic("unreachable")
+}
+
+// Snapshot returns the moment and the build the files are rendered as of,
+// which is the build running the tests.
+func Snapshot() targets.Snapshot {
+	return targets.NewSnapshot(meta.NewSnapshot(meta.NewMeta(meta.NewLDFlagsSource())))
+}
+
+// Render returns the text of every file of artifacts, keyed by the name of the
+// file.
+func Render(t *testing.T, artifacts output.Artifacts) map[string]string {
+	t.Helper()
+	files := make(map[string]string, len(artifacts))
+	for name, view := range artifacts {
+		var b bytes.Buffer
+		require.NoError(t, view.Render(&b), "the pass must render %s", name)
+		files[name] = b.String()
+	}
+	return files
+}
+
+// reference returns the reference record is addressed by.
+func reference(record ir.Definition) model.Reference {
+	switch record := record.(type) {
+	case ir.Object:
+		return record.Ref
+	case ir.DiscriminatedObject:
+		return record.Ref
+	case ir.Union:
+		return record.Ref
+	case ir.DiscriminatedUnion:
+		return record.Ref
+	case ir.Alias:
+		return record.Ref
+	case ir.Method:
+		return record.Ref
+	default:
+		return ""
+	}
+}